@@ -1,31 +1,403 @@
+-- Running this script again upgrades a database made by an earlier version:
+-- tables are created if they don't exist, and the columns added to a table
+-- after it was first released are added with ALTER TABLE, with a backfill
+-- where they can't be empty.
 CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY,
     name TEXT NOT NULL,
     email TEXT NOT NULL,
     password_hash TEXT NOT NULL,
     token TEXT NOT NULL,
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    locale TEXT NOT NULL DEFAULT 'en',
+    verified_at TIMESTAMP WITH TIME ZONE NULL,
+    -- The first admin is made in SQL: UPDATE users SET role = 'admin' WHERE email = '...';
+    role TEXT NOT NULL DEFAULT 'user',
+    disabled_at TIMESTAMP WITH TIME ZONE NULL,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     UNIQUE(email),
     UNIQUE(token)
 );
 
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS time_zone TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN IF NOT EXISTS locale TEXT NOT NULL DEFAULT 'en',
+    ADD COLUMN IF NOT EXISTS verified_at TIMESTAMP WITH TIME ZONE NULL,
+    ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user',
+    ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP WITH TIME ZONE NULL;
+
+-- Every user has a personal workspace with the ID of the user, it is
+-- created with the user.
+CREATE TABLE IF NOT EXISTS workspaces (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    personal BOOL NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS workspace_members (
+    workspace_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY(workspace_id, user_id),
+    FOREIGN KEY(workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS workspace_members_user_id_idx ON workspace_members(user_id);
+
+-- Users from before workspaces get their personal workspace.
+INSERT INTO workspaces (id, name, personal)
+    SELECT id, name, TRUE FROM users
+    ON CONFLICT (id) DO NOTHING;
+INSERT INTO workspace_members (workspace_id, user_id, role)
+    SELECT id, id, 'owner' FROM users
+    ON CONFLICT (workspace_id, user_id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS workspace_invitations (
+    id UUID PRIMARY KEY,
+    workspace_id UUID NOT NULL,
+    email TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    invited_by UUID NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE(token_hash),
+    FOREIGN KEY(workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY(invited_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS workspace_invitations_workspace_id_idx ON workspace_invitations(workspace_id);
+
 CREATE TABLE IF NOT EXISTS lists (
     id UUID PRIMARY KEY,
     user_id UUID NOT NULL,
+    workspace_id UUID NOT NULL,
     name TEXT NOT NULL,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY(workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE
 );
 
-CREATE TYPE priority AS ENUM ('low', 'normal', 'high');
+-- Lists from before workspaces move to the personal workspace of their owner.
+ALTER TABLE lists ADD COLUMN IF NOT EXISTS workspace_id UUID NULL REFERENCES workspaces(id) ON DELETE CASCADE;
+UPDATE lists SET workspace_id = user_id WHERE workspace_id IS NULL;
+ALTER TABLE lists ALTER COLUMN workspace_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS lists_workspace_id_idx ON lists(workspace_id);
+
+DO $$ BEGIN
+    CREATE TYPE priority AS ENUM ('low', 'normal', 'high');
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
 
 CREATE TABLE IF NOT EXISTS tasks (
     id UUID PRIMARY KEY,
     list_id UUID NOT NULL,
     priority priority NOT NULL,
     deadline TIMESTAMP WITH TIME ZONE NULL,
+    all_day BOOL NOT NULL DEFAULT FALSE,
     done BOOL NOT NULL,
     name TEXT NOT NULL,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    recurrence TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE NULL,
+    -- The object name and the UID a CalDAV client created the task with,
+    -- empty for other tasks.
+    dav_name TEXT NOT NULL DEFAULT '',
+    ical_uid TEXT NOT NULL DEFAULT '',
     FOREIGN KEY(list_id) REFERENCES lists(id) ON DELETE CASCADE
 );
+
+-- Tasks from before created_at count as created when the column is added.
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS all_day BOOL NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP WITH TIME ZONE NULL,
+    ADD COLUMN IF NOT EXISTS dav_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS ical_uid TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS tasks_created_at_idx ON tasks(created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS tasks_dav_name_idx ON tasks(list_id, dav_name) WHERE dav_name <> '';
+CREATE INDEX IF NOT EXISTS tasks_completed_at_idx ON tasks(completed_at);
+
+CREATE TABLE IF NOT EXISTS feed_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    workspace_id UUID NOT NULL,
+    token_hash TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE NULL,
+    UNIQUE(token_hash),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY(workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE
+);
+
+ALTER TABLE feed_tokens ADD COLUMN IF NOT EXISTS workspace_id UUID NULL REFERENCES workspaces(id) ON DELETE CASCADE;
+UPDATE feed_tokens SET workspace_id = user_id WHERE workspace_id IS NULL;
+ALTER TABLE feed_tokens ALTER COLUMN workspace_id SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS two_factor (
+    user_id UUID PRIMARY KEY,
+    secret TEXT NOT NULL,
+    enabled_at TIMESTAMP WITH TIME ZONE NULL,
+    last_counter BIGINT NOT NULL DEFAULT 0,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    user_id UUID NOT NULL,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE NULL,
+    PRIMARY KEY(user_id, code_hash),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS login_challenges (
+    token_hash TEXT PRIMARY KEY,
+    user_id UUID NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS password_resets (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    token_hash TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE NULL,
+    UNIQUE(token_hash),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens FLOAT8 NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS rate_limit_failures (
+    key TEXT PRIMARY KEY,
+    failures INT NOT NULL,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP WITH TIME ZONE NULL
+);
+
+-- Deleting a task, its list or its user keeps the attachment row with NULL
+-- references, so the application can remove the blob before the row.
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY,
+    task_id UUID NULL,
+    user_id UUID NULL,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE(storage_key),
+    FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE SET NULL,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS attachments_task_id_idx ON attachments(task_id);
+CREATE INDEX IF NOT EXISTS attachments_orphans_idx ON attachments(created_at) WHERE task_id IS NULL;
+
+CREATE TABLE IF NOT EXISTS comments (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY(author_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS comments_task_id_idx ON comments(task_id, created_at);
+
+CREATE TABLE IF NOT EXISTS list_members (
+    list_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY(list_id, user_id),
+    FOREIGN KEY(list_id) REFERENCES lists(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS list_members_user_id_idx ON list_members(user_id);
+
+CREATE TABLE IF NOT EXISTS task_assignees (
+    task_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    PRIMARY KEY(task_id, user_id),
+    FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS task_assignees_user_id_idx ON task_assignees(user_id);
+
+CREATE TABLE IF NOT EXISTS smart_lists (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    workspace_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    query TEXT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY(workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE
+);
+
+ALTER TABLE smart_lists ADD COLUMN IF NOT EXISTS workspace_id UUID NULL REFERENCES workspaces(id) ON DELETE CASCADE;
+UPDATE smart_lists SET workspace_id = user_id WHERE workspace_id IS NULL;
+ALTER TABLE smart_lists ALTER COLUMN workspace_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS smart_lists_user_id_idx ON smart_lists(user_id, workspace_id);
+
+CREATE TABLE IF NOT EXISTS user_identities (
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    user_id UUID NOT NULL,
+    email TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY(issuer, subject),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS user_identities_user_id_idx ON user_identities(user_id);
+
+CREATE TABLE IF NOT EXISTS oidc_logins (
+    state_hash TEXT PRIMARY KEY,
+    verifier TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS access_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    scopes TEXT[] NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE NULL,
+    UNIQUE(token_hash),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS access_tokens_user_id_idx ON access_tokens(user_id);
+
+CREATE TABLE IF NOT EXISTS signing_keys (
+    id TEXT PRIMARY KEY,
+    algorithm TEXT NOT NULL,
+    sealed_key BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti TEXT PRIMARY KEY,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Tokens of the user issued up to revoked_at are denied until expires_at,
+-- when the last of them has expired.
+CREATE TABLE IF NOT EXISTS revoked_users (
+    user_id UUID PRIMARY KEY,
+    revoked_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id UUID PRIMARY KEY,
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    delete_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Deleting the user keeps the export row with a NULL user, so the
+-- application can remove the archive before the row.
+CREATE TABLE IF NOT EXISTS data_exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NULL,
+    status TEXT NOT NULL,
+    storage_key TEXT NOT NULL DEFAULT '',
+    size BIGINT NOT NULL DEFAULT 0,
+    token_hash TEXT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMP WITH TIME ZONE NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NULL,
+    UNIQUE(token_hash),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS data_exports_user_id_idx ON data_exports(user_id);
+CREATE INDEX IF NOT EXISTS data_exports_pending_idx ON data_exports(created_at) WHERE status IN ('pending', 'running');
+
+-- Entries keep the IDs of deleted users, so there are no foreign keys.
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id UUID PRIMARY KEY,
+    admin_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    target_id UUID NULL,
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS admin_audit_log_created_at_idx ON admin_audit_log(created_at);
+
+-- The version of this schema, the server isn't ready with another one than
+-- domain.SchemaVersion. Bump both together, it is set after the upgrade
+-- steps above so a database is only marked upgraded once they went through.
+CREATE TABLE IF NOT EXISTS schema_version (
+    version INT NOT NULL
+);
+INSERT INTO schema_version SELECT 2 WHERE NOT EXISTS (SELECT 1 FROM schema_version);
+UPDATE schema_version SET version = 2;
+
+-- Row-level security backs up the checks of the queries. The application
+-- sets app.user_id to the user a request acts on behalf of, and
+-- app.bypass_rls to on for background jobs and admin reports acting on behalf
+-- of no one. Without either, no lists and tasks are visible.
+CREATE OR REPLACE FUNCTION app_user_id() RETURNS UUID AS $$
+    SELECT NULLIF(current_setting('app.user_id', TRUE), '')::UUID
+$$ LANGUAGE SQL STABLE;
+
+CREATE OR REPLACE FUNCTION app_bypass_rls() RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.bypass_rls', TRUE), '') = 'on'
+$$ LANGUAGE SQL STABLE;
+
+-- A user sees the lists of the workspaces they are in that they own or are
+-- members of, and every list of the workspaces they own. Foreign key
+-- cascades aren't restricted.
+ALTER TABLE lists ENABLE ROW LEVEL SECURITY;
+ALTER TABLE lists FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS lists_access ON lists;
+CREATE POLICY lists_access ON lists USING (
+    app_bypass_rls() OR EXISTS (
+        SELECT 1 FROM workspace_members w
+        WHERE w.workspace_id = lists.workspace_id AND w.user_id = app_user_id()
+            AND (w.role = 'owner' OR lists.user_id = w.user_id
+                OR EXISTS (SELECT 1 FROM list_members m WHERE m.list_id = lists.id AND m.user_id = w.user_id))
+    )
+);
+
+-- Tasks follow their list, the policy on lists applies to the subquery.
+ALTER TABLE tasks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tasks FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tasks_access ON tasks;
+CREATE POLICY tasks_access ON tasks USING (
+    app_bypass_rls() OR EXISTS (SELECT 1 FROM lists l WHERE l.id = tasks.list_id)
+);
+
+-- Superusers bypass row-level security, the application connects as
+-- todo_app instead. The role is created with the database, see
+-- db/role.sql for development.
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO todo_app;
@@ -0,0 +1,6 @@
+-- The role the application connects as in development. Deployments create
+-- it with a password of their own before running init.sql.
+DO $$ BEGIN
+    CREATE ROLE todo_app LOGIN PASSWORD 'password';
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
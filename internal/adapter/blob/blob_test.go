@@ -0,0 +1,117 @@
+package blob_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"todo_list/internal/adapter/blob"
+	"todo_list/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStores(t *testing.T) {
+	local, err := blob.NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	server := httptest.NewServer(newFakeS3(t))
+	t.Cleanup(server.Close)
+
+	s3, err := blob.NewS3(server.URL, "attachments", "us-east-1", "access", "secret")
+	require.NoError(t, err)
+
+	stores := map[string]domain.BlobStore{"Local": local, "S3": s3}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := "0a1b2c3d-key"
+
+			require.NoError(t, store.Put(ctx, key, strings.NewReader("hello"), 5, "text/plain"))
+
+			r, err := store.Get(ctx, key)
+			require.NoError(t, err)
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			require.Equal(t, "hello", string(data))
+
+			require.NoError(t, store.Delete(ctx, key))
+			require.NoError(t, store.Delete(ctx, key))
+
+			_, err = store.Get(ctx, key)
+			require.ErrorIs(t, err, blob.ErrBlobNotFound)
+		})
+	}
+}
+
+func TestLocalPutFailed(t *testing.T) {
+	store, err := blob.NewLocal(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		key  string
+		data string
+		size int64
+	}{
+		{name: "Short body", key: "short-key", data: "hell", size: 5},
+		{name: "Long body", key: "long-key", data: "hello!", size: 5},
+		{name: "Path traversal", key: "../escape", data: "hello", size: 5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := store.Put(ctx, test.key, strings.NewReader(test.data), test.size, "")
+			require.ErrorIs(t, err, blob.ErrBlobPut)
+
+			_, err = store.Get(ctx, test.key)
+			require.Error(t, err)
+		})
+	}
+}
+
+// newFakeS3 serves objects from memory and rejects unsigned requests.
+func newFakeS3(t *testing.T) http.Handler {
+	var (
+		mu      sync.Mutex
+		objects = map[string][]byte{}
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+			r.Header.Get("X-Amz-Date") == "" {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			require.Equal(t, "UNSIGNED-PAYLOAD", r.Header.Get("X-Amz-Content-Sha256"))
+			data, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			objects[r.URL.Path] = data
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+			_, _ = w.Write(data)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
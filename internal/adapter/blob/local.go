@@ -0,0 +1,110 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.BlobStore = (*Local)(nil)
+
+var (
+	errBlob         = errors.New("blob store error")
+	ErrBlobPut      = errors.Join(errBlob, errors.New("put failed"))
+	ErrBlobGet      = errors.Join(errBlob, errors.New("get failed"))
+	ErrBlobDelete   = errors.Join(errBlob, errors.New("delete failed"))
+	ErrBlobNotFound = errors.Join(ErrBlobGet, errors.New("blob not found"))
+)
+
+// Local keeps blobs as files below the root directory.
+type Local struct {
+	root string
+}
+
+func NewLocal(root string) (*Local, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, errors.Join(errBlob, err)
+	}
+
+	return &Local{root: root}, nil
+}
+
+// Put writes to a temporary file first, so readers never see a partial blob.
+func (s *Local) Put(_ context.Context, key string, r io.Reader, size int64, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return errors.Join(ErrBlobPut, err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return errors.Join(ErrBlobPut, err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.Join(ErrBlobPut, err)
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	written, err := io.Copy(file, io.LimitReader(r, size+1))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && written != size {
+		err = fmt.Errorf("got %d bytes, want %d", written, size)
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		return errors.Join(ErrBlobPut, err)
+	}
+
+	return nil
+}
+
+func (s *Local) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, errors.Join(ErrBlobGet, err)
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Join(ErrBlobNotFound, err)
+	}
+	if err != nil {
+		return nil, errors.Join(ErrBlobGet, err)
+	}
+
+	return file, nil
+}
+
+// Delete succeeds for missing blobs, so an interrupted cleanup can be repeated.
+func (s *Local) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return errors.Join(ErrBlobDelete, err)
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Join(ErrBlobDelete, err)
+	}
+
+	return nil
+}
+
+// path spreads blobs over subdirectories named by the key prefix.
+func (s *Local) path(key string) (string, error) {
+	if len(key) < 3 || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(s.root, key[:2], key), nil
+}
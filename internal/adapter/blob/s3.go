@@ -0,0 +1,259 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+const (
+	// unsignedPayload lets uploads stream without hashing the body up front.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	emptyPayload    = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	s3Service     = "s3"
+	s3Algorithm   = "AWS4-HMAC-SHA256"
+	s3DateLayout  = "20060102"
+	s3StampLayout = "20060102T150405Z"
+)
+
+var _ domain.BlobStore = (*S3)(nil)
+
+type (
+	// S3 talks to any S3-compatible service using path-style URLs and
+	// Signature Version 4, which MinIO, Ceph and others accept.
+	S3 struct {
+		endpoint  *url.URL
+		bucket    string
+		region    string
+		accessKey string
+		secretKey string
+		client    *http.Client
+		now       func() time.Time
+	}
+
+	S3Option func(*S3)
+)
+
+func NewS3(endpoint, bucket, region, accessKey, secretKey string, options ...S3Option) (*S3, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Join(errBlob, err)
+	}
+	if parsed.Scheme == "" || parsed.Host == "" || bucket == "" {
+		return nil, errors.Join(errBlob, errors.New("endpoint and bucket are required"))
+	}
+
+	store := &S3{
+		endpoint:  parsed,
+		bucket:    bucket,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    http.DefaultClient,
+		now:       time.Now,
+	}
+
+	for _, o := range options {
+		o(store)
+	}
+
+	return store, nil
+}
+
+func WithHTTPClient(client *http.Client) S3Option {
+	return func(s *S3) {
+		s.client = client
+	}
+}
+
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), io.NopCloser(r))
+	if err != nil {
+		return errors.Join(ErrBlobPut, err)
+	}
+	request.ContentLength = size
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	response, err := s.do(request, unsignedPayload)
+	if err != nil {
+		return errors.Join(ErrBlobPut, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if err = checkResponse(response); err != nil {
+		return errors.Join(ErrBlobPut, err)
+	}
+
+	return nil
+}
+
+func (s *S3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, errors.Join(ErrBlobGet, err)
+	}
+
+	response, err := s.do(request, emptyPayload)
+	if err != nil {
+		return nil, errors.Join(ErrBlobGet, err)
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		_ = response.Body.Close()
+
+		return nil, ErrBlobNotFound
+	}
+	if err = checkResponse(response); err != nil {
+		_ = response.Body.Close()
+
+		return nil, errors.Join(ErrBlobGet, err)
+	}
+
+	return response.Body, nil
+}
+
+// Delete succeeds for missing blobs, as S3 itself does.
+func (s *S3) Delete(ctx context.Context, key string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return errors.Join(ErrBlobDelete, err)
+	}
+
+	response, err := s.do(request, emptyPayload)
+	if err != nil {
+		return errors.Join(ErrBlobDelete, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err = checkResponse(response); err != nil {
+		return errors.Join(ErrBlobDelete, err)
+	}
+
+	return nil
+}
+
+func (s *S3) objectURL(key string) string {
+	object := *s.endpoint
+	object.Path = strings.TrimSuffix(object.Path, "/") + "/" + s.bucket + "/" + key
+	object.RawPath = escapePath(object.Path)
+
+	return object.String()
+}
+
+func (s *S3) do(request *http.Request, payloadHash string) (*http.Response, error) {
+	s.sign(request, payloadHash)
+
+	return s.client.Do(request)
+}
+
+// sign adds Signature Version 4 headers, see
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *S3) sign(request *http.Request, payloadHash string) {
+	now := s.now().UTC()
+	date := now.Format(s3DateLayout)
+
+	request.Header.Set("X-Amz-Date", now.Format(s3StampLayout))
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := request.Host
+	if host == "" {
+		host = request.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for name, values := range request.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") || name == "content-type" || name == "range" {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{s3Algorithm, now.Format(s3StampLayout), scope, hashHex(canonicalRequest)}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKey, scope, signedHeaders, signature))
+}
+
+func checkResponse(response *http.Response) error {
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+
+	return fmt.Errorf("unexpected status %s: %s", response.Status, body)
+}
+
+// escapePath encodes everything but unreserved characters and slashes, as
+// Signature Version 4 expects.
+func escapePath(path string) string {
+	var escaped strings.Builder
+	for _, b := range []byte(path) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9', strings.IndexByte("-_.~/", b) >= 0:
+			escaped.WriteByte(b)
+		default:
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+
+	return escaped.String()
+}
+
+func hashHex(value string) string {
+	sum := sha256.Sum256([]byte(value))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, value string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,493 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// FileKey names the optional configuration file, in the format of .env.
+const FileKey = "CONFIG_FILE"
+
+// minTokenBytes keeps generated tokens at 128 random bits at least.
+const minTokenBytes = 16
+
+// minSecretBytes is the shortest secret key accepted, as long as the
+// HMAC-SHA256 and AES-256 keys made from them.
+const minSecretBytes = 32
+
+// placeholderSecrets are refused as secrets, .env.sample ships with the
+// first of them.
+var placeholderSecrets = []string{"change me", "changeme", "secret"}
+
+var (
+	ErrConfig         = errors.New("config error")
+	ErrConfigLoad     = errors.Join(ErrConfig, errors.New("load failed"))
+	ErrConfigValidate = errors.Join(ErrConfig, errors.New("validate failed"))
+)
+
+type (
+	// Config is the whole configuration of the server. Every field has a key,
+	// SERVER_ADDRESS for example, set as an environment variable, a line of
+	// the configuration file or the -server-address flag.
+	Config struct {
+		Server    Server
+		Database  Database
+		Security  Security
+		Storage   Storage
+		Mail      Mail
+		URLs      URLs
+		RateLimit RateLimit
+		Auth      Auth
+		OIDC      OIDC
+	}
+
+	Server struct {
+		Address           string
+		TrustedProxies    []string
+		CORSAllowedOrigin string
+		ReadTimeout       time.Duration
+		WriteTimeout      time.Duration
+		IdleTimeout       time.Duration
+		ShutdownDelay     time.Duration
+		ShutdownTimeout   time.Duration
+	}
+
+	Database struct {
+		Connection      string
+		MaxConns        int
+		MinConns        int
+		MaxConnLifetime time.Duration
+		MaxConnIdleTime time.Duration
+		ConnectTimeout  time.Duration
+	}
+
+	Security struct {
+		BCryptCost              int
+		TokenBytes              int
+		EmailVerificationSecret string
+		UnverifiedRestrictions  string
+		TOTPIssuer              string
+		AccountDeletionGrace    time.Duration
+	}
+
+	Storage struct {
+		BlobStore   string
+		LocalDir    string
+		S3Endpoint  string
+		S3Bucket    string
+		S3Region    string
+		S3AccessKey string
+		S3SecretKey string
+		QuotaBytes  int64
+	}
+
+	Mail struct {
+		Mailer       string
+		File         string
+		From         string
+		SMTPAddress  string
+		SMTPUsername string
+		SMTPPassword string
+	}
+
+	URLs struct {
+		PasswordReset       string
+		EmailVerify         string
+		EmailChange         string
+		DataExport          string
+		WorkspaceInvitation string
+	}
+
+	RateLimit struct {
+		Store   string
+		Public  string
+		Account string
+		API     string
+	}
+
+	Auth struct {
+		Mode         string
+		JWTAlgorithm string
+		JWTKeySecret string
+		JWTTTL       time.Duration
+		JWTRotation  time.Duration
+	}
+
+	OIDC struct {
+		Issuer       string
+		ClientID     string
+		ClientSecret string
+		RedirectURL  string
+	}
+
+	// field binds a key to a field of the config. Secret fields may be read
+	// from the file named by the key with a _FILE suffix instead.
+	field struct {
+		key      string
+		value    value
+		fallback string
+		secret   bool
+		usage    string
+	}
+
+	value interface {
+		set(string) error
+	}
+
+	stringValue   struct{ p *string }
+	intValue      struct{ p *int }
+	int64Value    struct{ p *int64 }
+	durationValue struct{ p *time.Duration }
+	listValue     struct{ p *[]string }
+)
+
+func (v stringValue) set(s string) error {
+	*v.p = s
+
+	return nil
+}
+
+func (v intValue) set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*v.p = n
+
+	return nil
+}
+
+func (v int64Value) set(s string) error {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*v.p = n
+
+	return nil
+}
+
+func (v durationValue) set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*v.p = d
+
+	return nil
+}
+
+func (v listValue) set(s string) error {
+	*v.p = strings.Fields(s)
+
+	return nil
+}
+
+func (c *Config) fields() []field {
+	return []field{
+		{key: "SERVER_ADDRESS", value: stringValue{&c.Server.Address}, fallback: ":8080", usage: "address the server listens on"},
+		{key: "TRUSTED_PROXIES", value: listValue{&c.Server.TrustedProxies}, usage: "space separated proxies trusted with the client IP"},
+		{key: "CORS_ALLOWED_ORIGIN", value: stringValue{&c.Server.CORSAllowedOrigin}, usage: "origin of the web client"},
+		{key: "SERVER_READ_TIMEOUT", value: durationValue{&c.Server.ReadTimeout}, fallback: "30s", usage: "time to read a request with its body"},
+		{key: "SERVER_WRITE_TIMEOUT", value: durationValue{&c.Server.WriteTimeout}, fallback: "2m", usage: "time to write a response"},
+		{key: "SERVER_IDLE_TIMEOUT", value: durationValue{&c.Server.IdleTimeout}, fallback: "2m", usage: "time a kept-alive connection waits for the next request"},
+		{key: "SERVER_SHUTDOWN_DELAY", value: durationValue{&c.Server.ShutdownDelay}, fallback: "5s", usage: "time the server reports not ready before draining"},
+		{key: "SERVER_SHUTDOWN_TIMEOUT", value: durationValue{&c.Server.ShutdownTimeout}, fallback: "30s", usage: "time to drain requests and workers on shutdown"},
+
+		{key: "DB_CONNECTION", value: stringValue{&c.Database.Connection}, secret: true, usage: "Postgres connection string"},
+		{key: "DB_MAX_CONNS", value: intValue{&c.Database.MaxConns}, fallback: "10", usage: "maximum connections of the pool"},
+		{key: "DB_MIN_CONNS", value: intValue{&c.Database.MinConns}, fallback: "0", usage: "connections the pool keeps open"},
+		{key: "DB_MAX_CONN_LIFETIME", value: durationValue{&c.Database.MaxConnLifetime}, fallback: "1h", usage: "age after which a connection is closed"},
+		{key: "DB_MAX_CONN_IDLE_TIME", value: durationValue{&c.Database.MaxConnIdleTime}, fallback: "30m", usage: "idle time after which a connection is closed"},
+		{key: "DB_CONNECT_TIMEOUT", value: durationValue{&c.Database.ConnectTimeout}, fallback: "5s", usage: "time to open a connection"},
+
+		{key: "BCRYPT_COST", value: intValue{&c.Security.BCryptCost}, fallback: "12", usage: "bcrypt cost of new password hashes"},
+		{key: "TOKEN_BYTES", value: intValue{&c.Security.TokenBytes}, fallback: "16", usage: "random bytes of generated tokens"},
+		{key: "EMAIL_VERIFICATION_SECRET", value: stringValue{&c.Security.EmailVerificationSecret}, secret: true, usage: "secret signing email verification links"},
+		{key: "UNVERIFIED_RESTRICTIONS", value: stringValue{&c.Security.UnverifiedRestrictions}, usage: "comma separated features closed to unverified users"},
+		{key: "TOTP_ISSUER", value: stringValue{&c.Security.TOTPIssuer}, fallback: "To-do list", usage: "issuer shown by authenticator apps"},
+		{key: "ACCOUNT_DELETION_GRACE", value: durationValue{&c.Security.AccountDeletionGrace}, fallback: "720h", usage: "time before a deleted account is purged"},
+
+		{key: "BLOB_STORE", value: stringValue{&c.Storage.BlobStore}, fallback: "local", usage: "attachment store, local or s3"},
+		{key: "BLOB_LOCAL_DIR", value: stringValue{&c.Storage.LocalDir}, fallback: "./data/attachments", usage: "directory of the local store"},
+		{key: "S3_ENDPOINT", value: stringValue{&c.Storage.S3Endpoint}, usage: "endpoint of the s3 store"},
+		{key: "S3_BUCKET", value: stringValue{&c.Storage.S3Bucket}, usage: "bucket of the s3 store"},
+		{key: "S3_REGION", value: stringValue{&c.Storage.S3Region}, fallback: "us-east-1", usage: "region of the s3 store"},
+		{key: "S3_ACCESS_KEY", value: stringValue{&c.Storage.S3AccessKey}, secret: true, usage: "access key of the s3 store"},
+		{key: "S3_SECRET_KEY", value: stringValue{&c.Storage.S3SecretKey}, secret: true, usage: "secret key of the s3 store"},
+		{key: "STORAGE_QUOTA_BYTES", value: int64Value{&c.Storage.QuotaBytes}, fallback: "104857600", usage: "attachment bytes allowed per user"},
+
+		{key: "MAILER", value: stringValue{&c.Mail.Mailer}, fallback: "log", usage: "mailer, log, file or smtp"},
+		{key: "MAIL_FILE", value: stringValue{&c.Mail.File}, usage: "file the file mailer appends to"},
+		{key: "MAIL_FROM", value: stringValue{&c.Mail.From}, usage: "sender of the mails"},
+		{key: "SMTP_ADDRESS", value: stringValue{&c.Mail.SMTPAddress}, usage: "address of the smtp server"},
+		{key: "SMTP_USERNAME", value: stringValue{&c.Mail.SMTPUsername}, usage: "username at the smtp server"},
+		{key: "SMTP_PASSWORD", value: stringValue{&c.Mail.SMTPPassword}, secret: true, usage: "password at the smtp server"},
+
+		{key: "PASSWORD_RESET_URL", value: stringValue{&c.URLs.PasswordReset}, usage: "page of password reset links"},
+		{key: "EMAIL_VERIFY_URL", value: stringValue{&c.URLs.EmailVerify}, usage: "page of email verification links"},
+		{key: "EMAIL_CHANGE_URL", value: stringValue{&c.URLs.EmailChange}, usage: "page of email change links"},
+		{key: "DATA_EXPORT_URL", value: stringValue{&c.URLs.DataExport}, usage: "download of data exports"},
+		{key: "WORKSPACE_INVITATION_URL", value: stringValue{&c.URLs.WorkspaceInvitation}, usage: "page of workspace invitation links"},
+
+		{key: "RATE_LIMIT_STORE", value: stringValue{&c.RateLimit.Store}, fallback: "memory", usage: "rate limit store, memory or postgres"},
+		{key: "RATE_LIMIT_PUBLIC", value: stringValue{&c.RateLimit.Public}, fallback: "30/m", usage: "limit of the public routes per client"},
+		{key: "RATE_LIMIT_ACCOUNT", value: stringValue{&c.RateLimit.Account}, fallback: "10/m", usage: "limit of logins and resets per account"},
+		{key: "RATE_LIMIT_API", value: stringValue{&c.RateLimit.API}, fallback: "600/m", usage: "limit of the api per user"},
+
+		{key: "AUTH_MODE", value: stringValue{&c.Auth.Mode}, fallback: "token", usage: "authentication, token or jwt"},
+		{key: "JWT_ALGORITHM", value: stringValue{&c.Auth.JWTAlgorithm}, fallback: "EdDSA", usage: "signing algorithm of the jwts"},
+		{key: "JWT_KEY_SECRET", value: stringValue{&c.Auth.JWTKeySecret}, secret: true, usage: "secret sealing the jwt signing keys"},
+		{key: "JWT_TTL", value: durationValue{&c.Auth.JWTTTL}, fallback: "15m", usage: "lifetime of a jwt"},
+		{key: "JWT_ROTATION", value: durationValue{&c.Auth.JWTRotation}, fallback: "24h", usage: "lifetime of a signing key"},
+
+		{key: "OIDC_ISSUER", value: stringValue{&c.OIDC.Issuer}, usage: "issuer of the identity provider, empty to disable"},
+		{key: "OIDC_CLIENT_ID", value: stringValue{&c.OIDC.ClientID}, usage: "client id at the identity provider"},
+		{key: "OIDC_CLIENT_SECRET", value: stringValue{&c.OIDC.ClientSecret}, secret: true, usage: "client secret at the identity provider"},
+		{key: "OIDC_REDIRECT_URL", value: stringValue{&c.OIDC.RedirectURL}, usage: "page the identity provider redirects to"},
+	}
+}
+
+// Load reads the config from the defaults, the args, the file named by
+// CONFIG_FILE or -config and the environment, each overriding the ones
+// before, so a deployment's environment wins over a file baked into an image.
+// The config is validated.
+func Load(args []string) (Config, error) {
+	var c Config
+	fields := c.fields()
+
+	for _, f := range fields {
+		if err := f.value.set(f.fallback); err != nil {
+			return Config{}, errors.Join(ErrConfigLoad, fmt.Errorf("default of %s", f.key), err)
+		}
+	}
+
+	flags := flag.NewFlagSet("todo_list", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	file := flags.String("config", os.Getenv(FileKey), "configuration file in the format of .env")
+	flagKeys := map[string]string{}
+	for _, f := range fields {
+		name := flagName(f.key)
+		flags.String(name, f.fallback, f.usage)
+		flagKeys[name] = f.key
+	}
+	if err := flags.Parse(args); err != nil {
+		return Config{}, errors.Join(ErrConfigLoad, err)
+	}
+
+	fileValues := map[string]string{}
+	if *file != "" {
+		var err error
+		if fileValues, err = godotenv.Read(*file); err != nil {
+			return Config{}, errors.Join(ErrConfigLoad, fmt.Errorf("read %s", *file), err)
+		}
+	}
+
+	flagValues := map[string]string{}
+	flags.Visit(func(f *flag.Flag) {
+		if key, ok := flagKeys[f.Name]; ok {
+			flagValues[key] = f.Value.String()
+		}
+	})
+
+	sources := []struct {
+		name   string
+		lookup func(string) (string, bool)
+	}{
+		{name: "flags", lookup: lookupIn(flagValues)},
+		{name: "file", lookup: lookupIn(fileValues)},
+		{name: "environment", lookup: os.LookupEnv},
+	}
+	for _, source := range sources {
+		for _, f := range fields {
+			raw, ok, err := f.lookup(source.lookup)
+			if err != nil {
+				return Config{}, errors.Join(ErrConfigLoad, fmt.Errorf("%s in %s", f.key, source.name), err)
+			}
+			if !ok {
+				continue
+			}
+			if err = f.value.set(raw); err != nil {
+				return Config{}, errors.Join(ErrConfigLoad, fmt.Errorf("%s in %s", f.key, source.name), err)
+			}
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return c, nil
+}
+
+// lookup returns the value of the field in the source, read from the file
+// named by its _FILE key for secrets.
+func (f field) lookup(source func(string) (string, bool)) (string, bool, error) {
+	raw, ok := source(f.key)
+	if !f.secret {
+		return raw, ok, nil
+	}
+
+	path, fromFile := source(f.key + "_FILE")
+	if !fromFile {
+		return raw, ok, nil
+	}
+	if ok {
+		return "", false, fmt.Errorf("both %s and %s_FILE are set", f.key, f.key)
+	}
+
+	secret, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, err
+	}
+
+	return strings.TrimRight(string(secret), "\r\n"), true, nil
+}
+
+func lookupIn(values map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+
+		return value, ok
+	}
+}
+
+// flagName turns SERVER_ADDRESS into server-address.
+func flagName(key string) string {
+	return strings.ReplaceAll(strings.ToLower(key), "_", "-")
+}
+
+// Validate returns every problem of the config at once.
+func (c Config) Validate() error {
+	var problems []error
+	check := func(ok bool, key, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Errorf("%s "+format, append([]any{key}, args...)...))
+		}
+	}
+	checkSecret := func(secret, key string) {
+		switch {
+		case slices.Contains(placeholderSecrets, strings.ToLower(strings.TrimSpace(secret))):
+			check(false, key, "is a placeholder, set a random secret")
+		case secret != "":
+			check(len(secret) >= minSecretBytes, key, "must be at least %d bytes", minSecretBytes)
+		}
+	}
+
+	_, _, err := net.SplitHostPort(c.Server.Address)
+	check(err == nil, "SERVER_ADDRESS", "must be host:port, got %q", c.Server.Address)
+	check(validOrigin(c.Server.CORSAllowedOrigin), "CORS_ALLOWED_ORIGIN", "must be an origin like https://example.com, got %q",
+		c.Server.CORSAllowedOrigin)
+	check(c.Server.ReadTimeout > 0, "SERVER_READ_TIMEOUT", "must be positive")
+	check(c.Server.WriteTimeout > 0, "SERVER_WRITE_TIMEOUT", "must be positive")
+	check(c.Server.IdleTimeout > 0, "SERVER_IDLE_TIMEOUT", "must be positive")
+	check(c.Server.ShutdownDelay >= 0, "SERVER_SHUTDOWN_DELAY", "must not be negative")
+	check(c.Server.ShutdownTimeout > 0, "SERVER_SHUTDOWN_TIMEOUT", "must be positive")
+
+	check(c.Database.Connection != "", "DB_CONNECTION", "is required")
+	if c.Database.Connection != "" {
+		_, err = pgxpool.ParseConfig(c.Database.Connection)
+		check(err == nil, "DB_CONNECTION", "is not a valid connection string")
+	}
+	check(c.Database.MaxConns > 0, "DB_MAX_CONNS", "must be positive")
+	check(c.Database.MinConns >= 0 && c.Database.MinConns <= c.Database.MaxConns, "DB_MIN_CONNS", "must be between 0 and DB_MAX_CONNS")
+	check(c.Database.MaxConnLifetime > 0, "DB_MAX_CONN_LIFETIME", "must be positive")
+	check(c.Database.MaxConnIdleTime > 0, "DB_MAX_CONN_IDLE_TIME", "must be positive")
+	check(c.Database.ConnectTimeout > 0, "DB_CONNECT_TIMEOUT", "must be positive")
+
+	check(c.Security.BCryptCost >= bcrypt.MinCost && c.Security.BCryptCost <= bcrypt.MaxCost, "BCRYPT_COST", "must be between %d and %d",
+		bcrypt.MinCost, bcrypt.MaxCost)
+	check(c.Security.TokenBytes >= minTokenBytes, "TOKEN_BYTES", "must be at least %d", minTokenBytes)
+	check(c.Security.EmailVerificationSecret != "", "EMAIL_VERIFICATION_SECRET", "is required")
+	checkSecret(c.Security.EmailVerificationSecret, "EMAIL_VERIFICATION_SECRET")
+	check(c.Security.AccountDeletionGrace >= 0, "ACCOUNT_DELETION_GRACE", "must not be negative")
+
+	switch c.Storage.BlobStore {
+	case "local":
+		check(c.Storage.LocalDir != "", "BLOB_LOCAL_DIR", "is required by the local store")
+	case "s3":
+		check(c.Storage.S3Endpoint != "", "S3_ENDPOINT", "is required by the s3 store")
+		check(c.Storage.S3Bucket != "", "S3_BUCKET", "is required by the s3 store")
+	default:
+		check(false, "BLOB_STORE", "must be local or s3, got %q", c.Storage.BlobStore)
+	}
+	check(c.Storage.QuotaBytes >= 0, "STORAGE_QUOTA_BYTES", "must not be negative")
+
+	switch c.Mail.Mailer {
+	case "log":
+	case "file":
+		check(c.Mail.File != "", "MAIL_FILE", "is required by the file mailer")
+		check(c.Mail.From != "", "MAIL_FROM", "is required by the file mailer")
+	case "smtp":
+		check(c.Mail.SMTPAddress != "", "SMTP_ADDRESS", "is required by the smtp mailer")
+		check(c.Mail.From != "", "MAIL_FROM", "is required by the smtp mailer")
+	default:
+		check(false, "MAILER", "must be log, file or smtp, got %q", c.Mail.Mailer)
+	}
+
+	check(c.RateLimit.Store == "memory" || c.RateLimit.Store == "postgres", "RATE_LIMIT_STORE", "must be memory or postgres, got %q",
+		c.RateLimit.Store)
+
+	switch c.Auth.Mode {
+	case "token":
+	case "jwt":
+		check(c.Auth.JWTKeySecret != "", "JWT_KEY_SECRET", "is required by jwt authentication")
+		checkSecret(c.Auth.JWTKeySecret, "JWT_KEY_SECRET")
+		check(c.Auth.JWTTTL > 0, "JWT_TTL", "must be positive")
+		check(c.Auth.JWTRotation > 0, "JWT_ROTATION", "must be positive")
+	default:
+		check(false, "AUTH_MODE", "must be token or jwt, got %q", c.Auth.Mode)
+	}
+
+	if c.OIDC.Issuer != "" {
+		check(c.OIDC.ClientID != "", "OIDC_CLIENT_ID", "is required with OIDC_ISSUER")
+		check(c.OIDC.RedirectURL != "", "OIDC_REDIRECT_URL", "is required with OIDC_ISSUER")
+	}
+
+	if len(problems) > 0 {
+		return errors.Join(append([]error{ErrConfigValidate}, problems...)...)
+	}
+
+	return nil
+}
+
+// validOrigin accepts a scheme and a host, without a path.
+func validOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && (u.Path == "" || u.Path == "/") &&
+		u.RawQuery == "" && u.Fragment == ""
+}
+
+// PoolConfig returns the pool config of the connection string with the
+// sizing and timeouts applied.
+func (d Database) PoolConfig() (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(d.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	poolConfig.MaxConns = int32(d.MaxConns)
+	poolConfig.MinConns = int32(d.MinConns)
+	poolConfig.MaxConnLifetime = d.MaxConnLifetime
+	poolConfig.MaxConnIdleTime = d.MaxConnIdleTime
+	poolConfig.ConnConfig.ConnectTimeout = d.ConnectTimeout
+
+	return poolConfig, nil
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*AccessTokens)(nil)
+
+type AccessTokens struct {
+	service domain.AccessTokenInterface
+	secrets Secrets
+}
+
+func NewAccessTokens(service domain.AccessTokenInterface, secrets Secrets) *AccessTokens {
+	return &AccessTokens{service: service, secrets: secrets}
+}
+
+// CreateToken returns the token once, only its hash is kept.
+func (ctl *AccessTokens) CreateToken(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Name      string         `json:"name"`
+		Scopes    []domain.Scope `json:"scopes"`
+		ExpiresAt *time.Time     `json:"expires_at"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+	token = domain.AccessTokenPrefix + token
+
+	accessToken, err := ctl.service.CreateToken(ctx, domain.AccessToken{
+		UserID:    curUser.ID,
+		Name:      message.Name,
+		Scopes:    message.Scopes,
+		ExpiresAt: message.ExpiresAt,
+	}, token)
+	if err != nil {
+		slog.ErrorContext(ctx, "Create access token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create access token failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, struct {
+		domain.AccessToken
+		Token string `json:"token"`
+	}{
+		AccessToken: accessToken,
+		Token:       token,
+	})
+}
+
+func (ctl *AccessTokens) GetTokens(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	tokens, err := ctl.service.GetTokens(ctx, curUser.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read access tokens failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read access tokens failed."))
+
+		return
+	}
+	if tokens == nil {
+		tokens = []domain.AccessToken{}
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+func (ctl *AccessTokens) DeleteToken(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		ID domain.AccessTokenID `json:"id"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if err = ctl.service.RevokeToken(ctx, curUser.ID, message.ID); err != nil {
+		slog.ErrorContext(ctx, "Revoke access token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Revoke access token failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *AccessTokens) Close() error {
+	return ctl.service.Close()
+}
@@ -0,0 +1,247 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*Account)(nil)
+
+type Account struct {
+	service domain.AccountInterface
+	secrets Secrets
+}
+
+func NewAccount(service domain.AccountInterface, secrets Secrets) *Account {
+	return &Account{service: service, secrets: secrets}
+}
+
+type profile struct {
+	ID         domain.UserID `json:"id"`
+	Name       string        `json:"name"`
+	Email      string        `json:"email"`
+	TimeZone   string        `json:"time_zone"`
+	Locale     string        `json:"locale"`
+	VerifiedAt *time.Time    `json:"verified_at"`
+}
+
+func newProfile(user domain.User) profile {
+	return profile{
+		ID:         user.ID,
+		Name:       user.Name,
+		Email:      user.Email,
+		TimeZone:   user.TimeZone,
+		Locale:     user.Locale,
+		VerifiedAt: user.VerifiedAt,
+	}
+}
+
+func (ctl *Account) GetMe(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	user, err := ctl.service.Profile(ctx, curUser.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Get profile failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get profile failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, newProfile(user))
+}
+
+// UpdateMe changes the name, the other details have flows of their own.
+func (ctl *Account) UpdateMe(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Name *string `json:"name"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if message.Name != nil {
+		if err = ctl.service.UpdateName(ctx, curUser.ID, *message.Name); err != nil {
+			slog.ErrorContext(ctx, "Update name failed.", logger.ErrAttr(err))
+			c.JSON(http.StatusUnprocessableEntity, errorResponse("Update name failed."))
+
+			return
+		}
+	}
+
+	ctl.GetMe(c)
+}
+
+// ChangePassword returns a new token for this client, the others are signed
+// out.
+func (ctl *Account) ChangePassword(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		CurrentPassword string `json:"current_password"`
+		NewPassword     string `json:"new_password"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+	if len(message.NewPassword) <= 0 {
+		slog.ErrorContext(ctx, "Empty password.")
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Empty password."))
+
+		return
+	}
+
+	passwordHash, err := ctl.secrets.hashPassword(message.NewPassword)
+	if err != nil {
+		slog.ErrorContext(ctx, "Hashing password failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Hashing password failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	err = ctl.service.ChangePassword(ctx, curUser.ID, message.CurrentPassword, string(passwordHash), token)
+	if errors.Is(err, domain.ErrToDoServiceInvalidPasswordUser) {
+		slog.WarnContext(ctx, "Change password failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusForbidden, errorResponse("Wrong password."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Change password failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Change password failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, struct {
+		Token string `json:"token"`
+	}{
+		Token: token,
+	})
+}
+
+// RequestEmailChange mails a link to the new email, the email stays the
+// same until it is opened.
+func (ctl *Account) RequestEmailChange(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Password string `json:"password"`
+		Email    string `json:"email"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	err = ctl.service.RequestEmailChange(ctx, curUser.ID, message.Password, message.Email)
+	if errors.Is(err, domain.ErrToDoServiceInvalidPasswordUser) {
+		slog.WarnContext(ctx, "Request email change failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusForbidden, errorResponse("Wrong password."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Request email change failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Request email change failed."))
+
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// ConfirmEmailChange takes the token of the mailed link. Every client is
+// signed out, the user logs in with the new email.
+func (ctl *Account) ConfirmEmailChange(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Token string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	if err = ctl.service.ConfirmEmailChange(ctx, message.Token, token); err != nil {
+		slog.ErrorContext(ctx, "Confirm email change failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Confirm email change failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *Account) Close() error {
+	return ctl.service.Close()
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*AccountDeletion)(nil)
+
+type AccountDeletion struct {
+	service domain.AccountDeletionInterface
+	secrets Secrets
+}
+
+func NewAccountDeletion(service domain.AccountDeletionInterface, secrets Secrets) *AccountDeletion {
+	return &AccountDeletion{service: service, secrets: secrets}
+}
+
+// DeleteMe schedules the deletion of the account. Every client is signed
+// out, the user logs in again to cancel it.
+func (ctl *AccountDeletion) DeleteMe(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Password string
+		Code     string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	deletion, err := ctl.service.Schedule(ctx, curUser.ID, message.Password, message.Code, token)
+	if errors.Is(err, domain.ErrToDoServiceInvalidPasswordUser) || errors.Is(err, domain.ErrTwoFactorServiceInvalidCode) {
+		slog.WarnContext(ctx, "Schedule account deletion failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusForbidden, errorResponse("Wrong password or code."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Schedule account deletion failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Schedule account deletion failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, deletion)
+}
+
+func (ctl *AccountDeletion) GetDeletion(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	deletion, err := ctl.service.Get(ctx, curUser.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, errorResponse("No deletion scheduled."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Get account deletion failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get account deletion failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, deletion)
+}
+
+func (ctl *AccountDeletion) CancelDeletion(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	if err := ctl.service.Cancel(ctx, curUser.ID); err != nil {
+		slog.ErrorContext(ctx, "Cancel account deletion failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Cancel account deletion failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *AccountDeletion) Close() error {
+	return ctl.service.Close()
+}
@@ -0,0 +1,63 @@
+package controller_test
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountDeletion(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "ann@email.foo"}
+	serve := func(method string, body string, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+		router, response := gin.New(), httptest.NewRecorder()
+		router.Handle(method, "/", controller.WithUser(user), handler)
+		router.ServeHTTP(response, httptest.NewRequest(method, "/", bytes.NewBufferString(body)))
+
+		return response
+	}
+
+	t.Run("Delete Me", func(t *testing.T) {
+		service := mocks.NewMockAccountDeletionInterface(t)
+		requestedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		service.EXPECT().Schedule(mock.Anything, user.ID, "secret", "123456", mock.Anything).
+			Return(domain.AccountDeletion{UserID: user.ID, RequestedAt: requestedAt, DeleteAt: requestedAt.AddDate(0, 0, 30)}, nil).Once()
+
+		response := serve("DELETE", `{"password": "secret", "code": "123456"}`, controller.NewAccountDeletion(service, secrets).DeleteMe)
+
+		require.Equal(t, http.StatusAccepted, response.Code)
+		require.JSONEq(t, `{"requested_at": "2024-05-01T00:00:00Z", "delete_at": "2024-05-31T00:00:00Z"}`, response.Body.String())
+	})
+
+	t.Run("Delete Me Wrong Code", func(t *testing.T) {
+		service := mocks.NewMockAccountDeletionInterface(t)
+		service.EXPECT().Schedule(mock.Anything, user.ID, "secret", "", mock.Anything).
+			Return(domain.AccountDeletion{}, errors.Join(domain.ErrAccountDeletionServiceSchedule, domain.ErrTwoFactorServiceInvalidCode)).Once()
+
+		response := serve("DELETE", `{"password": "secret"}`, controller.NewAccountDeletion(service, secrets).DeleteMe)
+
+		require.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	t.Run("Get Deletion None", func(t *testing.T) {
+		service := mocks.NewMockAccountDeletionInterface(t)
+		service.EXPECT().Get(mock.Anything, user.ID).
+			Return(domain.AccountDeletion{}, errors.Join(domain.ErrAccountDeletionServiceGet, sql.ErrNoRows)).Once()
+
+		response := serve("GET", "", controller.NewAccountDeletion(service, secrets).GetDeletion)
+
+		require.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
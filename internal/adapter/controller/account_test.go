@@ -0,0 +1,69 @@
+package controller_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccount(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo", TimeZone: "UTC", Locale: "en"}
+	serve := func(method string, body string, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+		router, response := gin.New(), httptest.NewRecorder()
+		router.Handle(method, "/", controller.WithUser(user), handler)
+		router.ServeHTTP(response, httptest.NewRequest(method, "/", bytes.NewBufferString(body)))
+
+		return response
+	}
+
+	t.Run("Update Me", func(t *testing.T) {
+		service := mocks.NewMockAccountInterface(t)
+		renamed := user
+		renamed.Name = "Anna"
+		service.EXPECT().UpdateName(mock.Anything, user.ID, "Anna").Return(nil).Once()
+		service.EXPECT().Profile(mock.Anything, user.ID).Return(renamed, nil).Once()
+
+		response := serve("PATCH", `{"name": "Anna"}`, controller.NewAccount(service, secrets).UpdateMe)
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.JSONEq(t, `{"id": "`+user.ID.String()+`", "name": "Anna", "email": "ann@email.foo",
+			"time_zone": "UTC", "locale": "en", "verified_at": null}`, response.Body.String())
+	})
+
+	t.Run("Change Password", func(t *testing.T) {
+		service := mocks.NewMockAccountInterface(t)
+		service.EXPECT().ChangePassword(mock.Anything, user.ID, "old", mock.Anything, mock.Anything).Return(nil).Once()
+
+		response := serve("POST", `{"current_password": "old", "new_password": "new"}`, controller.NewAccount(service, secrets).ChangePassword)
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Contains(t, response.Body.String(), `"token"`)
+	})
+
+	t.Run("Change Password Wrong Password", func(t *testing.T) {
+		service := mocks.NewMockAccountInterface(t)
+		service.EXPECT().ChangePassword(mock.Anything, user.ID, "wrong", mock.Anything, mock.Anything).
+			Return(errors.Join(domain.ErrAccountServiceChangePassword, domain.ErrToDoServiceInvalidPasswordUser)).Once()
+
+		response := serve("POST", `{"current_password": "wrong", "new_password": "new"}`, controller.NewAccount(service, secrets).ChangePassword)
+
+		require.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	t.Run("Change Password Empty", func(t *testing.T) {
+		response := serve("POST", `{"current_password": "old"}`, controller.NewAccount(mocks.NewMockAccountInterface(t), secrets).ChangePassword)
+
+		require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+}
@@ -0,0 +1,311 @@
+package controller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var _ io.Closer = (*Admin)(nil)
+
+// Admin serves the /admin routes, RequireRole keeps them to admins.
+type Admin struct {
+	service domain.AdminInterface
+	secrets Secrets
+}
+
+func NewAdmin(service domain.AdminInterface, secrets Secrets) *Admin {
+	return &Admin{service: service, secrets: secrets}
+}
+
+type adminUser struct {
+	profile
+	Role       domain.Role `json:"role"`
+	DisabledAt *time.Time  `json:"disabled_at"`
+}
+
+func newAdminUser(user domain.User) adminUser {
+	return adminUser{profile: newProfile(user), Role: user.Role, DisabledAt: user.DisabledAt}
+}
+
+// SearchUsers finds users by the q query parameter, a page at a time.
+func (ctl *Admin) SearchUsers(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	page, err := queryPage(c)
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse page failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse page failed."))
+
+		return
+	}
+
+	users, err := ctl.service.SearchUsers(ctx, curUser.ID, c.Query("q"), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Search users failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Search users failed."))
+
+		return
+	}
+
+	result := make([]adminUser, 0, len(users))
+	for _, user := range users {
+		result = append(result, newAdminUser(user))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (ctl *Admin) GetUser(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse user id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse user id failed."))
+
+		return
+	}
+
+	user, err := ctl.service.GetUser(ctx, curUser.ID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, errorResponse("User not found."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Get user failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get user failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, newAdminUser(user))
+}
+
+func (ctl *Admin) GetUsage(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse user id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse user id failed."))
+
+		return
+	}
+
+	usage, err := ctl.service.Usage(ctx, curUser.ID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, errorResponse("User not found."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Get usage failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get usage failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
+
+func (ctl *Admin) DisableUser(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse user id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse user id failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	err = ctl.service.Disable(ctx, curUser.ID, userID, token)
+	if errors.Is(err, domain.ErrAdminServiceSelf) {
+		slog.WarnContext(ctx, "Disable user failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusForbidden, errorResponse("Admins can't disable themselves."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Disable user failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Disable user failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *Admin) EnableUser(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse user id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse user id failed."))
+
+		return
+	}
+
+	if err = ctl.service.Enable(ctx, curUser.ID, userID); err != nil {
+		slog.ErrorContext(ctx, "Enable user failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Enable user failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// LogoutUser signs out every client of the user.
+func (ctl *Admin) LogoutUser(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse user id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse user id failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	if err = ctl.service.Logout(ctx, curUser.ID, userID, token); err != nil {
+		slog.ErrorContext(ctx, "Logout user failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Logout user failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ResetPassword mails the user a password reset link.
+func (ctl *Admin) ResetPassword(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse user id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse user id failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	if err = ctl.service.ResetPassword(ctx, curUser.ID, userID, token); err != nil {
+		slog.ErrorContext(ctx, "Reset password failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Reset password failed."))
+
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (ctl *Admin) SetRole(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse user id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse user id failed."))
+
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Role domain.Role
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	err = ctl.service.SetRole(ctx, curUser.ID, userID, message.Role)
+	if errors.Is(err, domain.ErrAdminServiceSelf) {
+		slog.WarnContext(ctx, "Set role failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusForbidden, errorResponse("Admins can't change their own role."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Set role failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Set role failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetAuditLog returns a page of the audit log, newest first.
+func (ctl *Admin) GetAuditLog(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	page, err := queryPage(c)
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse page failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse page failed."))
+
+		return
+	}
+
+	entries, err := ctl.service.AuditLog(ctx, curUser.ID, page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read audit log failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read audit log failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+func (ctl *Admin) Close() error {
+	return ctl.service.Close()
+}
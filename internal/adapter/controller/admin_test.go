@@ -0,0 +1,70 @@
+package controller_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmin(t *testing.T) {
+	admin := domain.User{ID: domain.UserID(uuid.New()), Email: "admin@email.foo", Role: domain.RoleAdmin}
+	userID := domain.UserID(uuid.New())
+	serve := func(user domain.User, method string, target string, body string, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+		router, response := gin.New(), httptest.NewRecorder()
+		router.Handle(method, "/users/:id", controller.WithUser(user), controller.RequireRole(domain.RoleAdmin), handler)
+		router.Handle(method, "/users", controller.WithUser(user), controller.RequireRole(domain.RoleAdmin), handler)
+		router.ServeHTTP(response, httptest.NewRequest(method, target, bytes.NewBufferString(body)))
+
+		return response
+	}
+
+	t.Run("Not Admin", func(t *testing.T) {
+		service := mocks.NewMockAdminInterface(t)
+
+		response := serve(domain.User{ID: userID, Role: domain.RoleUser}, "GET", "/users?q=ann", "", controller.NewAdmin(service, secrets).SearchUsers)
+
+		require.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	t.Run("Search Users", func(t *testing.T) {
+		service := mocks.NewMockAdminInterface(t)
+		service.EXPECT().SearchUsers(mock.Anything, admin.ID, "ann", domain.Page{Limit: 10, Offset: 20}).
+			Return([]domain.User{{ID: userID, Name: "Ann", Email: "ann@email.foo", PasswordHash: "hash", Role: domain.RoleUser}}, nil).Once()
+
+		response := serve(admin, "GET", "/users?q=ann&limit=10&offset=20", "", controller.NewAdmin(service, secrets).SearchUsers)
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Contains(t, response.Body.String(), `"role":"user"`)
+		require.NotContains(t, response.Body.String(), "hash")
+	})
+
+	t.Run("Disable Self", func(t *testing.T) {
+		service := mocks.NewMockAdminInterface(t)
+		service.EXPECT().Disable(mock.Anything, admin.ID, admin.ID, mock.Anything).
+			Return(errors.Join(domain.ErrAdminServiceDisable, domain.ErrAdminServiceSelf)).Once()
+
+		response := serve(admin, "POST", "/users/"+admin.ID.String(), "", controller.NewAdmin(service, secrets).DisableUser)
+
+		require.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	t.Run("Set Role", func(t *testing.T) {
+		service := mocks.NewMockAdminInterface(t)
+		service.EXPECT().SetRole(mock.Anything, admin.ID, userID, domain.RoleAdmin).Return(nil).Once()
+
+		response := serve(admin, "PUT", "/users/"+userID.String(), `{"role": "admin"}`, controller.NewAdmin(service, secrets).SetRole)
+
+		require.Equal(t, http.StatusNoContent, response.Code)
+	})
+}
@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const defaultContentType = "application/octet-stream"
+
+var _ io.Closer = (*Attachments)(nil)
+
+type Attachments struct {
+	service domain.AttachmentInterface
+}
+
+func NewAttachments(service domain.AttachmentInterface) *Attachments {
+	return &Attachments{service: service}
+}
+
+// Upload streams the raw request body to the blob store. The size must be
+// known up front to enforce the quota, so chunked uploads are refused.
+func (ctl *Attachments) Upload(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse task id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse task id failed."))
+
+		return
+	}
+
+	if c.Request.ContentLength < 0 {
+		c.JSON(http.StatusLengthRequired, errorResponse("Content length required."))
+
+		return
+	}
+
+	contentType := c.ContentType()
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	attachment, err := ctl.service.Upload(ctx, curUser.ID, getCurrentWorkspace(c), domain.Attachment{
+		TaskID:      taskID,
+		Name:        c.Query("name"),
+		ContentType: contentType,
+		Size:        c.Request.ContentLength,
+	}, c.Request.Body)
+	if errors.Is(err, domain.ErrAttachmentServiceQuota) {
+		slog.WarnContext(ctx, "Storage quota exceeded.", logger.ErrAttr(err))
+		c.JSON(http.StatusRequestEntityTooLarge, errorResponse("Storage quota exceeded."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Upload attachment failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Upload attachment failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachment)
+}
+
+func (ctl *Attachments) GetAttachments(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse task id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse task id failed."))
+
+		return
+	}
+
+	attachments, err := ctl.service.GetAll(ctx, curUser.ID, getCurrentWorkspace(c), taskID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read attachments failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read attachments failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, attachments)
+}
+
+func (ctl *Attachments) Download(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	attachmentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse attachment id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse attachment id failed."))
+
+		return
+	}
+
+	attachment, blob, err := ctl.service.Download(ctx, curUser.ID, getCurrentWorkspace(c), attachmentID)
+	if err != nil {
+		slog.WarnContext(ctx, "Download attachment failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusNotFound, errorResponse("Attachment not found."))
+
+		return
+	}
+	defer func() { _ = blob.Close() }()
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, blob, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}),
+	})
+}
+
+func (ctl *Attachments) DeleteAttachment(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	attachmentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse attachment id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse attachment id failed."))
+
+		return
+	}
+
+	if err = ctl.service.Delete(ctx, curUser.ID, getCurrentWorkspace(c), attachmentID); err != nil {
+		slog.ErrorContext(ctx, "Delete attachment failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Delete attachment failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *Attachments) Close() error {
+	return ctl.service.Close()
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"todo_list/internal/adapter/logger"
@@ -12,15 +13,31 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-const ctxAuthUser = "ctx_auth_user"
+const (
+	ctxAuthUser = "ctx_auth_user"
+	// ctxAuthScopes holds the scopes of an access token, it is unset for
+	// login tokens.
+	ctxAuthScopes = "ctx_auth_scopes"
+	// ctxAuthJWT holds the JWT the request was authenticated with, it is
+	// unset for the other tokens.
+	ctxAuthJWT = "ctx_auth_jwt"
+)
 
 type AuthMiddleware struct {
-	userService domain.UserInterface
+	userService        domain.UserInterface
+	accessTokenService domain.AccessTokenInterface
+	keyRing            domain.JWTKeyRing
 }
 
-func NewAuthMiddleware(userService domain.UserInterface) *AuthMiddleware {
+// NewAuthMiddleware verifies JWTs with the key ring, which is nil when they
+// are turned off.
+func NewAuthMiddleware(userService domain.UserInterface, accessTokenService domain.AccessTokenInterface,
+	keyRing domain.JWTKeyRing,
+) *AuthMiddleware {
 	return &AuthMiddleware{
-		userService: userService,
+		userService:        userService,
+		accessTokenService: accessTokenService,
+		keyRing:            keyRing,
 	}
 }
 
@@ -41,6 +58,42 @@ func (mw AuthMiddleware) Auth(c *gin.Context) {
 		}
 	}
 
+	// JWTs are verified without a database round trip, login tokens are
+	// hex and never hold dots.
+	if mw.keyRing != nil && strings.Count(token, ".") == 2 {
+		curUser, err := mw.keyRing.Verify(ctx, token)
+		if err != nil {
+			slog.WarnContext(ctx, "JWT authentication failed.", logger.ErrAttr(err))
+
+			c.AbortWithStatus(http.StatusUnauthorized)
+
+			return
+		}
+		setCurrentUser(c, curUser)
+		c.Set(ctxAuthJWT, token)
+
+		c.Next()
+
+		return
+	}
+
+	if strings.HasPrefix(token, domain.AccessTokenPrefix) {
+		curUser, scopes, err := mw.accessTokenService.Authenticate(ctx, token)
+		if err != nil {
+			slog.WarnContext(ctx, "Access token authentication failed.", logger.ErrAttr(err))
+
+			c.AbortWithStatus(http.StatusUnauthorized)
+
+			return
+		}
+		setCurrentUser(c, curUser)
+		c.Set(ctxAuthScopes, scopes)
+
+		c.Next()
+
+		return
+	}
+
 	curUser, err := mw.userService.Authenticate(ctx, token)
 	if err != nil {
 		slog.WarnContext(ctx, "Bearer token authentication failed.", logger.ErrAttr(err))
@@ -49,13 +102,67 @@ func (mw AuthMiddleware) Auth(c *gin.Context) {
 
 		return
 	}
-	c.Set(ctxAuthUser, curUser)
+	setCurrentUser(c, curUser)
 
 	c.Next()
 }
 
+// setCurrentUser makes the request act on behalf of the user, in the database
+// too.
+func setCurrentUser(c *gin.Context, user domain.User) {
+	c.Set(ctxAuthUser, user)
+	c.Request = c.Request.WithContext(domain.WithAuthUser(c.Request.Context(), user.ID))
+}
+
 func getCurrentUser(c *gin.Context) domain.User {
 	user, _ := c.Get(ctxAuthUser)
 
 	return user.(domain.User)
 }
+
+// RequireScope returns a middleware that lets access tokens through only
+// with every one of the scopes, login tokens may do anything. It runs after
+// Auth.
+func RequireScope(scopes ...domain.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		granted, isAccessToken := c.Get(ctxAuthScopes)
+		for _, scope := range scopes {
+			if isAccessToken && !slices.Contains(granted.([]domain.Scope), scope) {
+				slog.WarnContext(c.Request.Context(), "Missing scope.", logger.ErrAttr(errors.New("access token without "+scope)))
+				c.AbortWithStatusJSON(http.StatusForbidden, errorResponse("Token lacks the "+scope+" scope."))
+
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// SessionOnly keeps access tokens out of the account settings, so a leaked
+// token can't lock the user out or create more tokens. It runs after Auth.
+func SessionOnly(c *gin.Context) {
+	if _, isAccessToken := c.Get(ctxAuthScopes); isAccessToken {
+		slog.WarnContext(c.Request.Context(), "Access token for account settings.", logger.ErrAttr(errors.New("login token required")))
+		c.AbortWithStatusJSON(http.StatusForbidden, errorResponse("Log in to change account settings."))
+
+		return
+	}
+
+	c.Next()
+}
+
+// RequireRole lets only users with the role through. JWTs carry the role
+// they were issued with, changing a role revokes them. It runs after Auth.
+func RequireRole(role domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if getCurrentUser(c).Role != role {
+			slog.WarnContext(c.Request.Context(), "Missing role.", logger.ErrAttr(errors.New("user without role "+role)))
+			c.AbortWithStatusJSON(http.StatusForbidden, errorResponse("Forbidden."))
+
+			return
+		}
+
+		c.Next()
+	}
+}
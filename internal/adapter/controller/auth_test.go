@@ -0,0 +1,77 @@
+package controller_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthScopes(t *testing.T) {
+	users, accessTokens := mocks.NewMockUserInterface(t), mocks.NewMockAccessTokenInterface(t)
+	users.EXPECT().Authenticate(mock.Anything, "login").Return(domain.User{Email: "ann@email.foo"}, nil).Maybe()
+	accessTokens.EXPECT().Authenticate(mock.Anything, "tdp_read").
+		Return(domain.User{Email: "ann@email.foo"}, []domain.Scope{domain.ScopeTasksRead}, nil).Maybe()
+	accessTokens.EXPECT().Authenticate(mock.Anything, "tdp_revoked").
+		Return(domain.User{}, nil, errors.New("some error")).Maybe()
+
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router := gin.New()
+	router.Use(controller.NewAuthMiddleware(users, accessTokens, nil).Auth)
+	router.GET("/task", controller.RequireScope(domain.ScopeTasksRead), ok)
+	router.POST("/task", controller.RequireScope(domain.ScopeTasksWrite), ok)
+	router.GET("/preferences", controller.SessionOnly, ok)
+
+	tests := []struct {
+		method, path, token string
+		code                int
+	}{
+		{"GET", "/task", "login", http.StatusOK},
+		{"POST", "/task", "login", http.StatusOK},
+		{"GET", "/preferences", "login", http.StatusOK},
+		{"GET", "/task", "tdp_read", http.StatusOK},
+		{"POST", "/task", "tdp_read", http.StatusForbidden},
+		{"GET", "/preferences", "tdp_read", http.StatusForbidden},
+		{"GET", "/task", "tdp_revoked", http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.method+" "+test.path+" "+test.token, func(t *testing.T) {
+			request := httptest.NewRequest(test.method, test.path, nil)
+			request.Header.Set("Authorization", "Bearer "+test.token)
+			response := httptest.NewRecorder()
+
+			router.ServeHTTP(response, request)
+
+			require.Equal(t, test.code, response.Code)
+		})
+	}
+}
+
+func TestAuthContext(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "ann@email.foo"}
+	users := mocks.NewMockUserInterface(t)
+	users.EXPECT().Authenticate(mock.Anything, "login").Return(user, nil).Once()
+
+	var authUser domain.UserID
+	router := gin.New()
+	router.Use(controller.NewAuthMiddleware(users, mocks.NewMockAccessTokenInterface(t), nil).Auth)
+	router.GET("/", func(c *gin.Context) {
+		authUser, _ = domain.AuthUser(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	request := httptest.NewRequest("GET", "/", nil)
+	request.Header.Set("Authorization", "Bearer login")
+	router.ServeHTTP(httptest.NewRecorder(), request)
+
+	require.Equal(t, user.ID, authUser)
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var _ io.Closer = (*Comments)(nil)
+
+type Comments struct {
+	service domain.CommentInterface
+}
+
+func NewComments(service domain.CommentInterface) *Comments {
+	return &Comments{service: service}
+}
+
+func (ctl *Comments) CreateComment(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse task id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse task id failed."))
+
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var comment domain.Comment
+	if err = json.Unmarshal(body, &comment); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+	comment.TaskID = taskID
+
+	comment, err = ctl.service.Create(ctx, curUser.ID, getCurrentWorkspace(c), comment)
+	if err != nil {
+		slog.ErrorContext(ctx, "Create comment failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create comment failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// GetComments returns a page of comments selected by the limit and offset
+// query parameters.
+func (ctl *Comments) GetComments(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse task id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse task id failed."))
+
+		return
+	}
+
+	var page domain.Page
+	if page.Limit, err = queryInt(c, "limit"); err == nil {
+		page.Offset, err = queryInt(c, "offset")
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse page failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse page failed."))
+
+		return
+	}
+
+	comments, err := ctl.service.GetAll(ctx, curUser.ID, getCurrentWorkspace(c), taskID, page)
+	if errors.Is(err, domain.ErrCommentServiceInvalidArg) {
+		slog.ErrorContext(ctx, "Invalid page.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Invalid page."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Read comments failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read comments failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, comments)
+}
+
+func (ctl *Comments) UpdateComment(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse comment id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse comment id failed."))
+
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var comment domain.Comment
+	if err = json.Unmarshal(body, &comment); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+	comment.ID = commentID
+
+	comment, err = ctl.service.Update(ctx, curUser.ID, getCurrentWorkspace(c), comment)
+	if err != nil {
+		slog.ErrorContext(ctx, "Update comment failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Update comment failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+func (ctl *Comments) DeleteComment(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse comment id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse comment id failed."))
+
+		return
+	}
+
+	if err = ctl.service.Delete(ctx, curUser.ID, getCurrentWorkspace(c), commentID); err != nil {
+		slog.ErrorContext(ctx, "Delete comment failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Delete comment failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *Comments) Close() error {
+	return ctl.service.Close()
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*DataExport)(nil)
+
+type DataExport struct {
+	service domain.DataExportInterface
+}
+
+func NewDataExport(service domain.DataExportInterface) *DataExport {
+	return &DataExport{service: service}
+}
+
+// RequestExport queues an export, its download link is mailed when it is
+// ready.
+func (ctl *DataExport) RequestExport(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	export, err := ctl.service.Request(ctx, curUser.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Request data export failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Request data export failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, export)
+}
+
+func (ctl *DataExport) GetExports(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	exports, err := ctl.service.GetAll(ctx, curUser.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Get data exports failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get data exports failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, exports)
+}
+
+// Download takes the token of the mailed link instead of a login, so the
+// link opens in any browser.
+func (ctl *DataExport) Download(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	export, archive, err := ctl.service.Download(ctx, c.Query("token"))
+	if err != nil {
+		slog.WarnContext(ctx, "Download data export failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusNotFound, errorResponse("Export not found."))
+
+		return
+	}
+	defer func() { _ = archive.Close() }()
+
+	c.DataFromReader(http.StatusOK, export.Size, "application/zip", archive, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": "todo-export.zip"}),
+	})
+}
+
+func (ctl *DataExport) Close() error {
+	return ctl.service.Close()
+}
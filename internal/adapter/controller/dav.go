@@ -0,0 +1,708 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"todo_list/internal/adapter/ical"
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	davRoot          = "/dav/"
+	davPrincipalPath = "/dav/principal/"
+	davCalendarsPath = "/dav/calendars/"
+
+	davStatusOK       = "HTTP/1.1 200 OK"
+	davStatusNotFound = "HTTP/1.1 404 Not Found"
+)
+
+const (
+	davResourceRoot = iota
+	davResourcePrincipal
+	davResourceHome
+	davResourceCalendar
+	davResourceObject
+)
+
+var errDAVOtherCalendar = errors.New("task belongs to another calendar")
+
+type (
+	DAV struct {
+		users        domain.UserInterface
+		accessTokens domain.AccessTokenInterface
+		lists        domain.ListInterface
+		tasks        domain.TaskInterface
+	}
+
+	davCredentials struct {
+		user domain.User
+		// scopes are those of an access token, nil for a password.
+		scopes []domain.Scope
+	}
+
+	davResource struct {
+		kind   int
+		listID domain.ListID
+		// name is the object name without the .ics suffix.
+		name string
+	}
+)
+
+type (
+	davMultistatus struct {
+		XMLName   xml.Name      `xml:"DAV: multistatus"`
+		Responses []davResponse `xml:"response"`
+	}
+
+	davResponse struct {
+		Href     string        `xml:"href"`
+		Propstat []davPropstat `xml:"propstat,omitempty"`
+		Status   string        `xml:"status,omitempty"`
+	}
+
+	davPropstat struct {
+		Prop   davProp `xml:"prop"`
+		Status string  `xml:"status"`
+	}
+
+	davProp struct {
+		ResourceType         *davResourceType `xml:"resourcetype,omitempty"`
+		DisplayName          string           `xml:"displayname,omitempty"`
+		CurrentUserPrincipal *davHref         `xml:"current-user-principal,omitempty"`
+		CalendarHomeSet      *davHref         `xml:"urn:ietf:params:xml:ns:caldav calendar-home-set,omitempty"`
+		SupportedComponents  *davComponentSet `xml:"urn:ietf:params:xml:ns:caldav supported-calendar-component-set,omitempty"`
+		CTag                 string           `xml:"http://calendarserver.org/ns/ getctag,omitempty"`
+		ETag                 string           `xml:"getetag,omitempty"`
+		ContentType          string           `xml:"getcontenttype,omitempty"`
+		CalendarData         string           `xml:"urn:ietf:params:xml:ns:caldav calendar-data,omitempty"`
+	}
+
+	davResourceType struct {
+		Collection *struct{} `xml:"collection,omitempty"`
+		Principal  *struct{} `xml:"principal,omitempty"`
+		Calendar   *struct{} `xml:"urn:ietf:params:xml:ns:caldav calendar,omitempty"`
+	}
+
+	davHref struct {
+		Href string `xml:"DAV: href"`
+	}
+
+	davComponentSet struct {
+		Components []davComponent `xml:"urn:ietf:params:xml:ns:caldav comp"`
+	}
+
+	davComponent struct {
+		Name string `xml:"name,attr"`
+	}
+
+	davReport struct {
+		XMLName xml.Name
+		Prop    struct {
+			CalendarData *struct{} `xml:"urn:ietf:params:xml:ns:caldav calendar-data"`
+		} `xml:"DAV: prop"`
+		Hrefs []string `xml:"DAV: href"`
+	}
+)
+
+// NewDAV serves lists as CalDAV calendar collections and their tasks as VTODO
+// resources. The controller shares services with the REST controllers and
+// doesn't own them, so it has no Close.
+func NewDAV(users domain.UserInterface, accessTokens domain.AccessTokenInterface, lists domain.ListInterface, tasks domain.TaskInterface) *DAV {
+	return &DAV{
+		users:        users,
+		accessTokens: accessTokens,
+		lists:        lists,
+		tasks:        tasks,
+	}
+}
+
+// Auth authenticates CalDAV clients with HTTP Basic credentials, since native
+// task clients can't obtain a bearer token. The password may be a personal
+// access token, which users with two-factor authentication enabled must use.
+// The credentials are checked on every request and never cached, so a changed
+// password or a disabled account takes effect at once.
+func (ctl *DAV) Auth(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	email, password, ok := c.Request.BasicAuth()
+	if !ok {
+		c.Header("WWW-Authenticate", `Basic realm="todo_list", charset="UTF-8"`)
+		c.AbortWithStatus(http.StatusUnauthorized)
+
+		return
+	}
+
+	credentials, err := ctl.authenticate(c, email, password)
+	reportLogin(c, err == nil)
+	if err != nil {
+		slog.WarnContext(ctx, "Basic authentication failed.", logger.ErrAttr(err))
+
+		c.Header("WWW-Authenticate", `Basic realm="todo_list", charset="UTF-8"`)
+		c.AbortWithStatus(http.StatusUnauthorized)
+
+		return
+	}
+
+	setCurrentUser(c, credentials.user)
+	if credentials.scopes != nil {
+		c.Set(ctxAuthScopes, credentials.scopes)
+	}
+
+	c.Next()
+}
+
+// authenticate checks a password or, with its prefix, an access token, which
+// must belong to the user of the email.
+func (ctl *DAV) authenticate(c *gin.Context, email string, password string) (davCredentials, error) {
+	ctx := c.Request.Context()
+
+	if !strings.HasPrefix(password, domain.AccessTokenPrefix) {
+		user, err := ctl.users.AuthenticatePassword(ctx, email, password)
+
+		return davCredentials{user: user}, err
+	}
+
+	user, scopes, err := ctl.accessTokens.Authenticate(ctx, password)
+	if err != nil {
+		return davCredentials{}, err
+	}
+	if !strings.EqualFold(strings.TrimSpace(email), user.Email) {
+		return davCredentials{}, errors.New("access token of another user")
+	}
+
+	return davCredentials{user: user, scopes: scopes}, nil
+}
+
+// WellKnown points service discovery (RFC 6764) to the DAV root.
+func (ctl *DAV) WellKnown(c *gin.Context) {
+	c.Redirect(http.StatusMovedPermanently, davRoot)
+}
+
+func (ctl *DAV) Options(c *gin.Context) {
+	c.Header("DAV", "1, 3, calendar-access")
+	c.Header("Allow", "OPTIONS, GET, PUT, DELETE, PROPFIND, REPORT")
+	c.Status(http.StatusOK)
+}
+
+func (ctl *DAV) Propfind(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	resource, ok := parseDAVPath(c.Param("path"))
+	if !ok {
+		c.Status(http.StatusNotFound)
+
+		return
+	}
+
+	// The request body names the wanted properties. Every known property is
+	// returned regardless, which clients accept.
+	_, _ = io.Copy(io.Discard, c.Request.Body)
+
+	// Infinite depth is treated as 1, there is nothing deeper than tasks.
+	withChildren := c.GetHeader("Depth") != "0"
+
+	var responses []davResponse
+	switch resource.kind {
+	case davResourceRoot, davResourcePrincipal:
+		href := davRoot
+		if resource.kind == davResourcePrincipal {
+			href = davPrincipalPath
+		}
+		responses = append(responses, davOK(href, davProp{
+			ResourceType:         &davResourceType{Collection: &struct{}{}, Principal: &struct{}{}},
+			DisplayName:          curUser.Name,
+			CurrentUserPrincipal: &davHref{Href: davPrincipalPath},
+			CalendarHomeSet:      &davHref{Href: davCalendarsPath},
+		}))
+
+	case davResourceHome:
+		responses = append(responses, davOK(davCalendarsPath, davProp{
+			ResourceType:         &davResourceType{Collection: &struct{}{}},
+			CurrentUserPrincipal: &davHref{Href: davPrincipalPath},
+		}))
+
+		if withChildren {
+			lists, err := ctl.lists.GetAll(ctx, curUser.ID, getCurrentWorkspace(c))
+			if err != nil {
+				slog.ErrorContext(ctx, "Read all failed.", logger.ErrAttr(err))
+				c.Status(http.StatusInternalServerError)
+
+				return
+			}
+
+			tasks, err := ctl.tasks.Find(ctx, curUser.ID, getCurrentWorkspace(c), domain.TaskFilter{})
+			if err != nil {
+				slog.ErrorContext(ctx, "Find tasks failed.", logger.ErrAttr(err))
+				c.Status(http.StatusInternalServerError)
+
+				return
+			}
+
+			for _, list := range lists {
+				var listTasks []domain.Task
+				for _, task := range tasks {
+					if task.ListID == list.ID {
+						listTasks = append(listTasks, task)
+					}
+				}
+				responses = append(responses, davCalendarResponse(list, listTasks))
+			}
+		}
+
+	case davResourceCalendar:
+		list, tasks, found, err := ctl.readCalendar(c, curUser.ID, resource.listID)
+		if err != nil || !found {
+			return
+		}
+
+		responses = append(responses, davCalendarResponse(list, tasks))
+		if withChildren {
+			for _, task := range tasks {
+				responses = append(responses, davObjectResponse(task, false))
+			}
+		}
+
+	case davResourceObject:
+		task, found := ctl.readObject(c, curUser.ID, resource)
+		if !found {
+			return
+		}
+
+		responses = append(responses, davObjectResponse(task, false))
+	}
+
+	writeMultistatus(c, responses)
+}
+
+// Report supports calendar-query, answered with every task of the calendar,
+// and calendar-multiget.
+func (ctl *DAV) Report(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	resource, ok := parseDAVPath(c.Param("path"))
+	if !ok || resource.kind != davResourceCalendar {
+		c.Status(http.StatusNotFound)
+
+		return
+	}
+
+	var report davReport
+	if err := xml.NewDecoder(c.Request.Body).Decode(&report); err != nil {
+		slog.ErrorContext(ctx, "Parse report failed.", logger.ErrAttr(err))
+		c.Status(http.StatusBadRequest)
+
+		return
+	}
+
+	_, tasks, found, err := ctl.readCalendar(c, curUser.ID, resource.listID)
+	if err != nil || !found {
+		return
+	}
+	withData := report.Prop.CalendarData != nil
+
+	var responses []davResponse
+	switch report.XMLName.Local {
+	case "calendar-query":
+		for _, task := range tasks {
+			responses = append(responses, davObjectResponse(task, withData))
+		}
+
+	case "calendar-multiget":
+		for _, href := range report.Hrefs {
+			requested, ok := parseDAVHref(href)
+			index := slices.IndexFunc(tasks, func(task domain.Task) bool {
+				return requested.kind == davResourceObject && requested.listID == resource.listID && davObjectName(task) == requested.name
+			})
+			if !ok || index < 0 {
+				responses = append(responses, davResponse{Href: href, Status: davStatusNotFound})
+
+				continue
+			}
+
+			responses = append(responses, davObjectResponse(tasks[index], withData))
+		}
+
+	default:
+		slog.ErrorContext(ctx, "Unsupported report.", slog.String("report", report.XMLName.Local))
+		c.Status(http.StatusForbidden)
+
+		return
+	}
+
+	writeMultistatus(c, responses)
+}
+
+func (ctl *DAV) Get(c *gin.Context) {
+	curUser := getCurrentUser(c)
+
+	resource, ok := parseDAVPath(c.Param("path"))
+	if !ok || resource.kind != davResourceObject {
+		c.Status(http.StatusNotFound)
+
+		return
+	}
+
+	task, found := ctl.readObject(c, curUser.ID, resource)
+	if !found {
+		return
+	}
+
+	c.Header("ETag", davETag(task))
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", davCalendarData(task))
+}
+
+func (ctl *DAV) Put(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	resource, ok := parseDAVPath(c.Param("path"))
+	if !ok || resource.kind != davResourceObject {
+		c.Status(http.StatusForbidden)
+
+		return
+	}
+
+	if _, _, found, err := ctl.readCalendar(c, curUser.ID, resource.listID); err != nil || !found {
+		return
+	}
+
+	calendar, err := ical.Decode(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse calendar failed.", logger.ErrAttr(err))
+		c.Status(http.StatusBadRequest)
+
+		return
+	}
+	todo, ok := calendar.Component("VTODO")
+	if !ok {
+		slog.ErrorContext(ctx, "Calendar has no VTODO.")
+		c.Status(http.StatusUnsupportedMediaType)
+
+		return
+	}
+	task, err := ical.FromTodo(todo)
+	if err != nil {
+		slog.ErrorContext(ctx, "Map VTODO to task failed.", logger.ErrAttr(err))
+		c.Status(http.StatusBadRequest)
+
+		return
+	}
+
+	existing, exists, err := ctl.findObject(c, curUser.ID, resource)
+	if errors.Is(err, errDAVOtherCalendar) {
+		slog.ErrorContext(ctx, "Task belongs to another calendar.")
+		c.Status(http.StatusConflict)
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Read task failed.", logger.ErrAttr(err))
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	// A new task keeps the name and the UID the client picked, it must find
+	// both again. The ID is the server's, names and UIDs of other users'
+	// tasks may be anything.
+	task.ID, task.ListID, task.DAVName = uuid.New(), resource.listID, resource.name
+	if exists {
+		task.ID, task.DAVName = existing.ID, ""
+	}
+
+	if match := c.GetHeader("If-Match"); match != "" && (!exists || (match != "*" && match != davETag(existing))) {
+		c.Status(http.StatusPreconditionFailed)
+
+		return
+	}
+	if c.GetHeader("If-None-Match") == "*" && exists {
+		c.Status(http.StatusPreconditionFailed)
+
+		return
+	}
+
+	status := http.StatusNoContent
+	if exists {
+		err = ctl.tasks.Update(ctx, curUser.ID, getCurrentWorkspace(c), task)
+	} else {
+		status = http.StatusCreated
+		err = ctl.tasks.Create(ctx, curUser.ID, getCurrentWorkspace(c), task)
+	}
+	if errors.Is(err, domain.ErrToDoServiceTaskForbidden) {
+		// The calendar went away or was unshared since it was read.
+		slog.ErrorContext(ctx, "Save task failed.", logger.ErrAttr(err))
+		c.Status(http.StatusConflict)
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Save task failed.", logger.ErrAttr(err))
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	if saved, err := ctl.tasks.Read(ctx, curUser.ID, getCurrentWorkspace(c), task.ID); err == nil {
+		c.Header("ETag", davETag(saved))
+	}
+	c.Status(status)
+}
+
+func (ctl *DAV) Delete(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	resource, ok := parseDAVPath(c.Param("path"))
+	if !ok || resource.kind != davResourceObject {
+		c.Status(http.StatusForbidden)
+
+		return
+	}
+
+	task, found := ctl.readObject(c, curUser.ID, resource)
+	if !found {
+		return
+	}
+
+	if match := c.GetHeader("If-Match"); match != "" && match != "*" && match != davETag(task) {
+		c.Status(http.StatusPreconditionFailed)
+
+		return
+	}
+
+	err := ctl.tasks.Delete(ctx, curUser.ID, getCurrentWorkspace(c), task.ID)
+	if errors.Is(err, domain.ErrToDoServiceTaskForbidden) {
+		slog.ErrorContext(ctx, "Delete task failed.", logger.ErrAttr(err))
+		c.Status(http.StatusNotFound)
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Delete task failed.", logger.ErrAttr(err))
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// readCalendar writes the error response itself when the list can't be served.
+func (ctl *DAV) readCalendar(c *gin.Context, userID domain.UserID, listID domain.ListID) (domain.List, []domain.Task, bool, error) {
+	ctx := c.Request.Context()
+
+	lists, err := ctl.lists.GetAll(ctx, userID, getCurrentWorkspace(c))
+	if err != nil {
+		slog.ErrorContext(ctx, "Read all failed.", logger.ErrAttr(err))
+		c.Status(http.StatusInternalServerError)
+
+		return domain.List{}, nil, false, err
+	}
+
+	index := slices.IndexFunc(lists, func(list domain.List) bool { return list.ID == listID })
+	if index < 0 {
+		c.Status(http.StatusNotFound)
+
+		return domain.List{}, nil, false, nil
+	}
+
+	tasks, err := ctl.tasks.Find(ctx, userID, getCurrentWorkspace(c), domain.TaskFilter{ListIDs: []domain.ListID{listID}})
+	if err != nil {
+		slog.ErrorContext(ctx, "Find tasks failed.", logger.ErrAttr(err))
+		c.Status(http.StatusInternalServerError)
+
+		return domain.List{}, nil, false, err
+	}
+
+	return lists[index], tasks, true, nil
+}
+
+// readObject writes the error response itself when the task can't be served.
+func (ctl *DAV) readObject(c *gin.Context, userID domain.UserID, resource davResource) (domain.Task, bool) {
+	ctx := c.Request.Context()
+
+	task, found, err := ctl.findObject(c, userID, resource)
+	if errors.Is(err, errDAVOtherCalendar) || (err == nil && !found) {
+		c.Status(http.StatusNotFound)
+
+		return task, false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Read task failed.", logger.ErrAttr(err))
+		c.Status(http.StatusInternalServerError)
+
+		return task, false
+	}
+
+	return task, true
+}
+
+// findObject reads the task the object names, found is false when there is
+// none yet. The name is the one a client created the task with or, for other
+// tasks, the ID. A task named by its ID but kept in another calendar is
+// errDAVOtherCalendar, clients move tasks by deleting and creating them.
+func (ctl *DAV) findObject(c *gin.Context, userID domain.UserID, resource davResource) (domain.Task, bool, error) {
+	ctx := c.Request.Context()
+
+	tasks, err := ctl.tasks.Find(ctx, userID, getCurrentWorkspace(c), domain.TaskFilter{ListIDs: []domain.ListID{resource.listID}, DAVName: resource.name})
+	if err != nil {
+		return domain.Task{}, false, err
+	}
+	if len(tasks) > 0 {
+		return tasks[0], true, nil
+	}
+
+	taskID, err := uuid.Parse(resource.name)
+	if err != nil {
+		return domain.Task{}, false, nil
+	}
+	task, err := ctl.tasks.Read(ctx, userID, getCurrentWorkspace(c), taskID)
+	if errors.Is(err, domain.ErrToDoServiceTaskNotFound) {
+		return domain.Task{}, false, nil
+	}
+	if err != nil {
+		return domain.Task{}, false, err
+	}
+	if task.ListID != resource.listID {
+		return domain.Task{}, false, errDAVOtherCalendar
+	}
+
+	return task, true, nil
+}
+
+// parseDAVPath parses the path below the DAV root. Object names are kept as
+// they are, a client may pick its own, see DAV.findObject.
+func parseDAVPath(path string) (davResource, bool) {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return davResource{kind: davResourceRoot}, true
+	}
+
+	parts := strings.Split(path, "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "principal":
+		return davResource{kind: davResourcePrincipal}, true
+	case parts[0] != "calendars" || len(parts) > 3:
+		return davResource{}, false
+	case len(parts) == 1:
+		return davResource{kind: davResourceHome}, true
+	}
+
+	listID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return davResource{}, false
+	}
+	if len(parts) == 2 {
+		return davResource{kind: davResourceCalendar, listID: listID}, true
+	}
+
+	name := strings.TrimSuffix(parts[2], ".ics")
+	if name == "" {
+		return davResource{}, false
+	}
+
+	return davResource{kind: davResourceObject, listID: listID, name: name}, true
+}
+
+// parseDAVHref accepts both absolute URLs and paths, as clients send either.
+func parseDAVHref(href string) (davResource, bool) {
+	parsed, err := url.Parse(href)
+	if err != nil || !strings.HasPrefix(parsed.Path, davRoot) {
+		return davResource{}, false
+	}
+
+	return parseDAVPath(strings.TrimPrefix(parsed.Path, strings.TrimSuffix(davRoot, "/")))
+}
+
+func davOK(href string, prop davProp) davResponse {
+	return davResponse{
+		Href:     href,
+		Propstat: []davPropstat{{Prop: prop, Status: davStatusOK}},
+	}
+}
+
+func davCalendarResponse(list domain.List, tasks []domain.Task) davResponse {
+	return davOK(davCalendarsPath+list.ID.String()+"/", davProp{
+		ResourceType:         &davResourceType{Collection: &struct{}{}, Calendar: &struct{}{}},
+		DisplayName:          list.Name,
+		CurrentUserPrincipal: &davHref{Href: davPrincipalPath},
+		SupportedComponents:  &davComponentSet{Components: []davComponent{{Name: "VTODO"}}},
+		CTag:                 davCTag(list, tasks),
+	})
+}
+
+func davObjectResponse(task domain.Task, withData bool) davResponse {
+	prop := davProp{
+		ETag:        davETag(task),
+		ContentType: "text/calendar; charset=utf-8; component=VTODO",
+	}
+	if withData {
+		prop.CalendarData = string(davCalendarData(task))
+	}
+
+	return davOK(davObjectHref(task), prop)
+}
+
+func davObjectHref(task domain.Task) string {
+	return davCalendarsPath + task.ListID.String() + "/" + url.PathEscape(davObjectName(task)) + ".ics"
+}
+
+// davObjectName is the name the client created the task with, or the ID.
+func davObjectName(task domain.Task) string {
+	if task.DAVName != "" {
+		return task.DAVName
+	}
+
+	return task.ID.String()
+}
+
+func davCalendarData(task domain.Task) []byte {
+	calendar := ical.NewCalendar(task.Name)
+	calendar.Components = append(calendar.Components, ical.Todo(task))
+
+	var data strings.Builder
+	_ = ical.Encode(&data, calendar)
+
+	return []byte(data.String())
+}
+
+// davETag changes whenever the task is written, as every update bumps updated_at.
+func davETag(task domain.Task) string {
+	return fmt.Sprintf(`"%x"`, task.UpdatedAT.UnixMicro())
+}
+
+// davCTag changes whenever the list or any of its tasks changes, including
+// deletion, so clients know when to resync the collection.
+func davCTag(list domain.List, tasks []domain.Task) string {
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%s\x00%d\x00", list.Name, list.UpdatedAt.UnixMicro())
+	for _, task := range tasks {
+		_, _ = fmt.Fprintf(hash, "%s\x00%s\x00", task.ID, davETag(task))
+	}
+
+	return `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+func writeMultistatus(c *gin.Context, responses []davResponse) {
+	body, err := xml.Marshal(davMultistatus{Responses: responses})
+	if err != nil {
+		slog.ErrorContext(c.Request.Context(), "Marshal multistatus failed.", logger.ErrAttr(err))
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(http.StatusMultiStatus, "application/xml; charset=utf-8", append([]byte(xml.Header), body...))
+}
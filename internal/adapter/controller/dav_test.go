@@ -0,0 +1,354 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAV(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "John Doe", Email: "john@doe.foo"}
+	list := domain.List{ID: domain.ListID(uuid.New()), UserID: user.ID, Name: "Work"}
+	task := domain.Task{
+		ID:        domain.TaskID(uuid.New()),
+		ListID:    list.ID,
+		Priority:  domain.Normal,
+		Name:      "Write report",
+		UpdatedAT: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	const accessToken = domain.AccessTokenPrefix + "secret"
+	otherListID := domain.ListID(uuid.New())
+	calendarPath := "/dav/calendars/" + list.ID.String() + "/"
+	objectPath := calendarPath + task.ID.String() + ".ics"
+
+	request := func(method, target, body string) *http.Request {
+		r := httptest.NewRequest(method, target, strings.NewReader(body))
+		r.SetBasicAuth(user.Email, "secret")
+
+		return r
+	}
+	expectAuth := func(users *mocks.MockUserInterface) {
+		users.EXPECT().AuthenticatePassword(mock.Anything, user.Email, "secret").Return(user, nil).Once()
+	}
+	expectNoName := func(tasks *mocks.MockTaskInterface, listID domain.ListID, name string) {
+		tasks.EXPECT().Find(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), domain.TaskFilter{ListIDs: []domain.ListID{listID}, DAVName: name}).
+			Return(nil, nil).Once()
+	}
+	expectCalendar := func(lists *mocks.MockListInterface, tasks *mocks.MockTaskInterface) {
+		lists.EXPECT().GetAll(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID)).Return([]domain.List{list}, nil).Once()
+		tasks.EXPECT().Find(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), domain.TaskFilter{ListIDs: []domain.ListID{list.ID}}).
+			Return([]domain.Task{task}, nil).Once()
+	}
+
+	tests := []struct {
+		name         string
+		request      *http.Request
+		prepareMocks func(*mocks.MockUserInterface, *mocks.MockAccessTokenInterface, *mocks.MockListInterface, *mocks.MockTaskInterface)
+		validation   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "Missing credentials",
+			request: httptest.NewRequest("PROPFIND", "/dav/", nil),
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, response.Code)
+				require.Contains(t, response.Header().Get("WWW-Authenticate"), "Basic")
+			},
+		},
+		{
+			name:    "Wrong credentials",
+			request: request("PROPFIND", "/dav/", ""),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, _ *mocks.MockListInterface, _ *mocks.MockTaskInterface) {
+				users.EXPECT().AuthenticatePassword(mock.Anything, user.Email, "secret").
+					Return(domain.User{}, errors.New("some error")).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, response.Code)
+			},
+		},
+		{
+			name:    "Password with two-factor enabled",
+			request: request("PROPFIND", "/dav/", ""),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, _ *mocks.MockListInterface, _ *mocks.MockTaskInterface) {
+				users.EXPECT().AuthenticatePassword(mock.Anything, user.Email, "secret").
+					Return(domain.User{}, domain.ErrUserServiceTwoFactorRequired).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, response.Code)
+			},
+		},
+		{
+			name: "Access token",
+			request: func() *http.Request {
+				r := request("PROPFIND", "/dav/", "")
+				r.SetBasicAuth("John@Doe.foo", accessToken)
+
+				return r
+			}(),
+			prepareMocks: func(_ *mocks.MockUserInterface, accessTokens *mocks.MockAccessTokenInterface, _ *mocks.MockListInterface, _ *mocks.MockTaskInterface) {
+				accessTokens.EXPECT().Authenticate(mock.Anything, accessToken).
+					Return(user, []domain.Scope{domain.ScopeListsRead, domain.ScopeTasksRead}, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMultiStatus, response.Code)
+			},
+		},
+		{
+			name: "Access token without scope",
+			request: func() *http.Request {
+				r := request("DELETE", objectPath, "")
+				r.SetBasicAuth(user.Email, accessToken)
+
+				return r
+			}(),
+			prepareMocks: func(_ *mocks.MockUserInterface, accessTokens *mocks.MockAccessTokenInterface, _ *mocks.MockListInterface, _ *mocks.MockTaskInterface) {
+				accessTokens.EXPECT().Authenticate(mock.Anything, accessToken).
+					Return(user, []domain.Scope{domain.ScopeListsRead, domain.ScopeTasksRead}, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, response.Code)
+			},
+		},
+		{
+			name: "Access token of another user",
+			request: func() *http.Request {
+				r := request("PROPFIND", "/dav/", "")
+				r.SetBasicAuth("jane@doe.foo", accessToken)
+
+				return r
+			}(),
+			prepareMocks: func(_ *mocks.MockUserInterface, accessTokens *mocks.MockAccessTokenInterface, _ *mocks.MockListInterface, _ *mocks.MockTaskInterface) {
+				accessTokens.EXPECT().Authenticate(mock.Anything, accessToken).
+					Return(user, []domain.Scope{domain.ScopeListsRead, domain.ScopeTasksRead}, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, response.Code)
+			},
+		},
+		{
+			name:    "Propfind root",
+			request: request("PROPFIND", "/dav/", ""),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, _ *mocks.MockListInterface, _ *mocks.MockTaskInterface) {
+				expectAuth(users)
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMultiStatus, response.Code)
+				require.Contains(t, response.Body.String(), `<calendar-home-set xmlns="urn:ietf:params:xml:ns:caldav"><href xmlns="DAV:">/dav/calendars/</href></calendar-home-set>`)
+			},
+		},
+		{
+			name: "Propfind calendar",
+			request: func() *http.Request {
+				r := request("PROPFIND", calendarPath, "")
+				r.Header.Set("Depth", "1")
+
+				return r
+			}(),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, lists *mocks.MockListInterface, tasks *mocks.MockTaskInterface) {
+				expectAuth(users)
+				expectCalendar(lists, tasks)
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMultiStatus, response.Code)
+				require.Contains(t, response.Body.String(), "<displayname>Work</displayname>")
+				require.Contains(t, response.Body.String(), `name="VTODO"`)
+				require.Contains(t, response.Body.String(), "<href>"+objectPath+"</href>")
+			},
+		},
+		{
+			name: "Report multiget",
+			request: request("REPORT", calendarPath, `<?xml version="1.0"?>
+				<C:calendar-multiget xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+					<D:prop><D:getetag/><C:calendar-data/></D:prop>
+					<D:href>`+objectPath+`</D:href>
+					<D:href>`+calendarPath+uuid.NewString()+`.ics</D:href>
+				</C:calendar-multiget>`),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, lists *mocks.MockListInterface, tasks *mocks.MockTaskInterface) {
+				expectAuth(users)
+				expectCalendar(lists, tasks)
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMultiStatus, response.Code)
+				require.Contains(t, response.Body.String(), "SUMMARY:Write report")
+				require.Contains(t, response.Body.String(), "HTTP/1.1 404 Not Found")
+			},
+		},
+		{
+			name: "Put new task",
+			request: request("PUT", calendarPath+"client-name.ics", "BEGIN:VCALENDAR\r\n"+
+				"BEGIN:VTODO\r\nUID:client-name\r\nSUMMARY:Buy milk\r\nPRIORITY:9\r\nEND:VTODO\r\n"+
+				"END:VCALENDAR\r\n"),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, lists *mocks.MockListInterface, tasks *mocks.MockTaskInterface) {
+				var taskID domain.TaskID
+
+				expectAuth(users)
+				expectCalendar(lists, tasks)
+				expectNoName(tasks, list.ID, "client-name")
+				tasks.EXPECT().Create(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.MatchedBy(func(created domain.Task) bool {
+					taskID = created.ID
+					created.ID = uuid.Nil
+
+					return reflect.DeepEqual(created, domain.Task{ListID: list.ID, Priority: domain.Low, Name: "Buy milk", Tags: []string{},
+						DAVName: "client-name", ICalUID: "client-name"})
+				})).Return(nil).Once()
+				tasks.EXPECT().Read(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.Anything).
+					RunAndReturn(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) (domain.Task, error) {
+						return domain.Task{ID: taskID, ListID: list.ID, UpdatedAT: time.UnixMicro(0x10)}, nil
+					}).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, response.Code)
+				require.Equal(t, `"10"`, response.Header().Get("ETag"))
+			},
+		},
+		{
+			name: "Put with UUID name of another user's task",
+			request: func() *http.Request {
+				r := request("PUT", calendarPath+task.ID.String()+".ics", "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nSUMMARY:x\r\nEND:VTODO\r\nEND:VCALENDAR\r\n")
+				r.Header.Set("If-None-Match", "*")
+
+				return r
+			}(),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, lists *mocks.MockListInterface, tasks *mocks.MockTaskInterface) {
+				expectAuth(users)
+				expectCalendar(lists, tasks)
+				expectNoName(tasks, list.ID, task.ID.String())
+				tasks.EXPECT().Read(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), task.ID).
+					Return(domain.Task{}, domain.ErrToDoServiceTaskNotFound).Once()
+				tasks.EXPECT().Create(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.MatchedBy(func(created domain.Task) bool {
+					return created.ID != task.ID && created.DAVName == task.ID.String()
+				})).Return(domain.ErrToDoServiceTaskForbidden).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, response.Code)
+			},
+		},
+		{
+			name: "Put with stale ETag",
+			request: func() *http.Request {
+				r := request("PUT", objectPath, "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nSUMMARY:x\r\nEND:VTODO\r\nEND:VCALENDAR\r\n")
+				r.Header.Set("If-Match", `"stale"`)
+
+				return r
+			}(),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, lists *mocks.MockListInterface, tasks *mocks.MockTaskInterface) {
+				expectAuth(users)
+				expectCalendar(lists, tasks)
+				expectNoName(tasks, list.ID, task.ID.String())
+				tasks.EXPECT().Read(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), task.ID).Return(task, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusPreconditionFailed, response.Code)
+			},
+		},
+		{
+			name:    "Delete task of another calendar",
+			request: request("DELETE", "/dav/calendars/"+otherListID.String()+"/"+task.ID.String()+".ics", ""),
+			prepareMocks: func(users *mocks.MockUserInterface, _ *mocks.MockAccessTokenInterface, _ *mocks.MockListInterface, tasks *mocks.MockTaskInterface) {
+				expectAuth(users)
+				expectNoName(tasks, otherListID, task.ID.String())
+				tasks.EXPECT().Read(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), task.ID).Return(task, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, response.Code)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			users, accessTokens := mocks.NewMockUserInterface(t), mocks.NewMockAccessTokenInterface(t)
+			lists, tasks := mocks.NewMockListInterface(t), mocks.NewMockTaskInterface(t)
+			if test.prepareMocks != nil {
+				test.prepareMocks(users, accessTokens, lists, tasks)
+			}
+
+			response := serveDAV(controller.NewDAV(users, accessTokens, lists, tasks), test.request)
+
+			test.validation(t, response)
+		})
+	}
+}
+
+// TestDAVRoundTrip checks that a client finds the object it put under its own
+// name and with its own UID.
+func TestDAVRoundTrip(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "john@doe.foo"}
+	list := domain.List{ID: domain.ListID(uuid.New()), UserID: user.ID, Name: "Work"}
+	workspaceID := domain.PersonalWorkspace(user.ID)
+	calendarPath := "/dav/calendars/" + list.ID.String() + "/"
+
+	users, lists, tasks := mocks.NewMockUserInterface(t), mocks.NewMockListInterface(t), mocks.NewMockTaskInterface(t)
+	users.EXPECT().AuthenticatePassword(mock.Anything, user.Email, "secret").Return(user, nil).Times(3)
+	lists.EXPECT().GetAll(mock.Anything, user.ID, workspaceID).Return([]domain.List{list}, nil).Times(3)
+
+	var stored []domain.Task
+	tasks.EXPECT().Find(mock.Anything, user.ID, workspaceID, domain.TaskFilter{ListIDs: []domain.ListID{list.ID}}).
+		RunAndReturn(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskFilter) ([]domain.Task, error) {
+			return stored, nil
+		}).Times(3)
+	tasks.EXPECT().Find(mock.Anything, user.ID, workspaceID, domain.TaskFilter{ListIDs: []domain.ListID{list.ID}, DAVName: "client-name"}).
+		Return(nil, nil).Once()
+	tasks.EXPECT().Create(mock.Anything, user.ID, workspaceID, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ domain.UserID, _ domain.WorkspaceID, task domain.Task) error {
+			task.UpdatedAT = time.UnixMicro(0x10)
+			stored = append(stored, task)
+
+			return nil
+		}).Once()
+	tasks.EXPECT().Read(mock.Anything, user.ID, workspaceID, mock.Anything).
+		RunAndReturn(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) (domain.Task, error) {
+			return stored[0], nil
+		}).Once()
+
+	ctl := controller.NewDAV(users, mocks.NewMockAccessTokenInterface(t), lists, tasks)
+	request := func(method, target, body string) *http.Request {
+		r := httptest.NewRequest(method, target, strings.NewReader(body))
+		r.SetBasicAuth(user.Email, "secret")
+
+		return r
+	}
+
+	response := serveDAV(ctl, request("PUT", calendarPath+"client-name.ics", "BEGIN:VCALENDAR\r\n"+
+		"BEGIN:VTODO\r\nUID:client-uid\r\nSUMMARY:Buy milk\r\nEND:VTODO\r\n"+
+		"END:VCALENDAR\r\n"))
+	require.Equal(t, http.StatusCreated, response.Code)
+	require.NotEqual(t, "client-name", stored[0].ID.String())
+
+	for _, report := range []string{
+		`<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav"><D:prop><C:calendar-data/></D:prop></C:calendar-query>`,
+		`<C:calendar-multiget xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav"><D:prop><C:calendar-data/></D:prop>` +
+			`<D:href>` + calendarPath + `client-name.ics</D:href></C:calendar-multiget>`,
+	} {
+		response = serveDAV(ctl, request("REPORT", calendarPath, report))
+		require.Equal(t, http.StatusMultiStatus, response.Code)
+		require.Contains(t, response.Body.String(), "<href>"+calendarPath+"client-name.ics</href>")
+		require.Contains(t, response.Body.String(), "UID:client-uid")
+		require.NotContains(t, response.Body.String(), stored[0].ID.String())
+	}
+}
+
+func serveDAV(ctl *controller.DAV, request *http.Request) *httptest.ResponseRecorder {
+	router, response := gin.New(), httptest.NewRecorder()
+	read, write := controller.RequireScope(domain.ScopeListsRead, domain.ScopeTasksRead), controller.RequireScope(domain.ScopeTasksWrite)
+	dav := router.Group("/dav", ctl.Auth)
+	dav.Handle("PROPFIND", "/*path", read, ctl.Propfind)
+	dav.Handle("REPORT", "/*path", read, ctl.Report)
+	dav.PUT("/*path", write, ctl.Put)
+	dav.DELETE("/*path", write, ctl.Delete)
+	router.ServeHTTP(response, request)
+
+	return response
+}
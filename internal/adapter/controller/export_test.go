@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReportLogin stands in for an authenticating handler in Lockout tests.
+func ReportLogin(c *gin.Context, ok bool) {
+	reportLogin(c, ok)
+}
+
+// WithUser stands in for AuthMiddleware in handler tests.
+func WithUser(user domain.User) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		setCurrentUser(c, user)
+	}
+}
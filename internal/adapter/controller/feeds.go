@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"todo_list/internal/adapter/ical"
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	componentEvent = "vevent"
+	componentTodo  = "vtodo"
+)
+
+var _ io.Closer = (*Feeds)(nil)
+
+type Feeds struct {
+	service domain.FeedInterface
+	secrets Secrets
+}
+
+func NewFeeds(service domain.FeedInterface, secrets Secrets) *Feeds {
+	return &Feeds{service: service, secrets: secrets}
+}
+
+func (ctl *Feeds) CreateFeed(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	feedToken, err := ctl.service.CreateToken(ctx, curUser.ID, getCurrentWorkspace(c), token)
+	if err != nil {
+		slog.ErrorContext(ctx, "Create feed failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create feed failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, struct {
+		ID    domain.FeedTokenID `json:"id"`
+		Token string             `json:"token"`
+		URL   string             `json:"url"`
+	}{
+		ID:    feedToken.ID,
+		Token: token,
+		URL:   "/feed/" + token + ".ics",
+	})
+}
+
+func (ctl *Feeds) GetFeeds(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	tokens, err := ctl.service.GetTokens(ctx, curUser.ID, getCurrentWorkspace(c))
+	if err != nil {
+		slog.ErrorContext(ctx, "Read feeds failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read feeds failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+func (ctl *Feeds) DeleteFeed(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		ID domain.FeedTokenID `json:"id"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if err = ctl.service.RevokeToken(ctx, curUser.ID, getCurrentWorkspace(c), message.ID); err != nil {
+		slog.ErrorContext(ctx, "Revoke feed failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Revoke feed failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Calendar serves the feed to calendar clients. It is authenticated by the
+// secret token in the URL only, as subscribers can't send a bearer token.
+func (ctl *Feeds) Calendar(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Subscribers aren't logged in, so due views use UTC days.
+	filter, err := parseTaskFilter(c, time.UTC)
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse task filter failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse filter failed."))
+
+		return
+	}
+
+	component := c.DefaultQuery("component", componentEvent)
+	if component != componentEvent && component != componentTodo {
+		slog.ErrorContext(ctx, "Unknown calendar component.", slog.String("component", component))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Unknown component."))
+
+		return
+	}
+
+	tasks, err := ctl.service.Tasks(ctx, strings.TrimSuffix(c.Param("token"), ".ics"), filter)
+	if err != nil {
+		slog.WarnContext(ctx, "Read feed failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusNotFound, errorResponse("Feed not found."))
+
+		return
+	}
+
+	calendar := ical.NewCalendar("Tasks")
+	for _, task := range tasks {
+		if component == componentTodo {
+			calendar.Components = append(calendar.Components, ical.Todo(task))
+		} else if event, ok := ical.Event(task); ok {
+			calendar.Components = append(calendar.Components, event)
+		}
+	}
+
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Status(http.StatusOK)
+	if err = ical.Encode(c.Writer, calendar); err != nil {
+		slog.ErrorContext(ctx, "Write calendar failed.", logger.ErrAttr(err))
+	}
+}
+
+func (ctl *Feeds) Close() error {
+	return ctl.service.Close()
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	healthOK      = "ok"
+	healthFailed  = "failed"
+	healthReady   = "ready"
+	healthUnready = "not ready"
+
+	// healthCheckShutdown fails once the server shuts down, so load
+	// balancers stop sending it requests before it stops taking them.
+	healthCheckShutdown = "shutdown"
+	healthCheckWorker   = "worker "
+
+	// healthCheckError replaces the errors of the database checks, which
+	// may tell the unauthenticated caller about the database. The details
+	// are logged.
+	healthCheckError = "unavailable"
+)
+
+var _ io.Closer = (*Health)(nil)
+
+// Lifecycle reports the shutdown and the background workers to the
+// readiness probe.
+type Lifecycle interface {
+	Stopping() bool
+	// Workers reports whether each worker runs, by name.
+	Workers() map[string]bool
+}
+
+// Health serves the probes, which need no authentication.
+type Health struct {
+	service   domain.HealthInterface
+	lifecycle Lifecycle
+}
+
+func NewHealth(service domain.HealthInterface, lifecycle Lifecycle) *Health {
+	return &Health{service: service, lifecycle: lifecycle}
+}
+
+type healthCheck struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	DurationMS float64 `json:"duration_ms"`
+	Error      string  `json:"error,omitempty"`
+}
+
+type healthResponse struct {
+	Status string        `json:"status"`
+	Checks []healthCheck `json:"checks,omitempty"`
+}
+
+func newHealthCheck(name string, duration time.Duration, err string) healthCheck {
+	status := healthOK
+	if err != "" {
+		status = healthFailed
+	}
+
+	return healthCheck{Name: name, Status: status, DurationMS: float64(duration.Microseconds()) / 1000, Error: err}
+}
+
+// Live answers as long as the server handles requests. It checks nothing
+// else, a database outage shouldn't get the server restarted.
+func (ctl *Health) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, healthResponse{Status: healthOK})
+}
+
+// Ready checks the shutdown, the database and the workers, and answers 503
+// when any check failed.
+func (ctl *Health) Ready(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var shutdown string
+	if ctl.lifecycle.Stopping() {
+		shutdown = "shutting down"
+	}
+	checks := []healthCheck{newHealthCheck(healthCheckShutdown, 0, shutdown)}
+
+	for _, check := range ctl.service.Check(ctx) {
+		if check.Error != "" && check.Error != domain.HealthCheckSkipped {
+			slog.ErrorContext(ctx, "Readiness check failed.", slog.String("check", check.Name), slog.String("error", check.Error))
+			check.Error = healthCheckError
+		}
+		checks = append(checks, newHealthCheck(check.Name, check.Duration, check.Error))
+	}
+
+	workers := ctl.lifecycle.Workers()
+	for _, name := range slices.Sorted(maps.Keys(workers)) {
+		var stopped string
+		if !workers[name] {
+			stopped = "stopped"
+			slog.ErrorContext(ctx, "Readiness check failed.", slog.String("check", healthCheckWorker+name), slog.String("error", stopped))
+		}
+		checks = append(checks, newHealthCheck(healthCheckWorker+name, 0, stopped))
+	}
+
+	response := healthResponse{Status: healthReady, Checks: checks}
+	for _, check := range checks {
+		if check.Status != healthOK {
+			response.Status = healthUnready
+		}
+	}
+
+	if response.Status != healthReady {
+		c.JSON(http.StatusServiceUnavailable, response)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (ctl *Health) Close() error {
+	return ctl.service.Close()
+}
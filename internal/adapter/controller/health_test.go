@@ -0,0 +1,116 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLifecycle struct {
+	stopping bool
+	workers  map[string]bool
+}
+
+func (f fakeLifecycle) Stopping() bool           { return f.stopping }
+func (f fakeLifecycle) Workers() map[string]bool { return f.workers }
+
+func TestHealth(t *testing.T) {
+	healthy := []domain.HealthCheck{
+		{Name: domain.HealthCheckConnection, Duration: 1500 * time.Microsecond},
+		{Name: domain.HealthCheckQuery},
+		{Name: domain.HealthCheckSchema},
+	}
+	type check struct {
+		Name       string
+		Status     string
+		DurationMS float64 `json:"duration_ms"`
+		Error      string
+	}
+	type body struct {
+		Status string
+		Checks []check
+	}
+
+	tests := []struct {
+		name      string
+		lifecycle fakeLifecycle
+		checks    []domain.HealthCheck
+		want      int
+		status    string
+		failed    []string
+	}{
+		{
+			name:      "Ready",
+			lifecycle: fakeLifecycle{workers: map[string]bool{"attachments": true}},
+			checks:    healthy,
+			want:      http.StatusOK,
+			status:    "ready",
+		},
+		{
+			name:      "Shutting Down",
+			lifecycle: fakeLifecycle{stopping: true, workers: map[string]bool{"attachments": true}},
+			checks:    healthy,
+			want:      http.StatusServiceUnavailable,
+			status:    "not ready",
+			failed:    []string{"shutdown"},
+		},
+		{
+			name:      "Schema Mismatch And Worker Stopped",
+			lifecycle: fakeLifecycle{workers: map[string]bool{"attachments": false, "data exports": true}},
+			checks: []domain.HealthCheck{
+				{Name: domain.HealthCheckConnection, Duration: 1500 * time.Microsecond},
+				{Name: domain.HealthCheckQuery},
+				{Name: domain.HealthCheckSchema, Error: "schema version 2, want 1"},
+			},
+			want:   http.StatusServiceUnavailable,
+			status: "not ready",
+			failed: []string{domain.HealthCheckSchema, "worker attachments"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := mocks.NewMockHealthInterface(t)
+			service.EXPECT().Check(mock.Anything).Return(test.checks).Once()
+
+			router, response := gin.New(), httptest.NewRecorder()
+			router.GET("/readyz", controller.NewHealth(service, test.lifecycle).Ready)
+			router.ServeHTTP(response, httptest.NewRequest("GET", "/readyz", nil))
+
+			require.Equal(t, test.want, response.Code)
+			var got body
+			require.NoError(t, json.Unmarshal(response.Body.Bytes(), &got))
+			require.Equal(t, test.status, got.Status)
+			require.Len(t, got.Checks, 1+len(test.checks)+len(test.lifecycle.workers))
+			require.Equal(t, 1.5, got.Checks[1].DurationMS)
+
+			var failed []string
+			for _, c := range got.Checks {
+				if c.Status != "ok" {
+					require.NotEmpty(t, c.Error)
+					failed = append(failed, c.Name)
+				}
+			}
+			require.Equal(t, test.failed, failed)
+			require.NotContains(t, response.Body.String(), "schema version")
+		})
+	}
+
+	t.Run("Live", func(t *testing.T) {
+		router, response := gin.New(), httptest.NewRecorder()
+		router.GET("/healthz", controller.NewHealth(mocks.NewMockHealthInterface(t), fakeLifecycle{stopping: true}).Live)
+		router.ServeHTTP(response, httptest.NewRequest("GET", "/healthz", nil))
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.JSONEq(t, `{"status":"ok"}`, response.Body.String())
+	})
+}
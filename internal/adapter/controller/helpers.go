@@ -1,5 +1,19 @@
 package controller
 
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
 type errorMessage struct {
 	Message string
 }
@@ -7,3 +21,94 @@ type errorMessage struct {
 func errorResponse(message string) errorMessage {
 	return errorMessage{Message: message}
 }
+
+// Secrets hashes new passwords with the bcrypt cost PasswordCost and generates
+// tokens of TokenBytes random bytes. The server sets both from its config.
+type Secrets struct {
+	PasswordCost int
+	TokenBytes   int
+}
+
+func (s Secrets) hashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), s.PasswordCost)
+}
+
+func (s Secrets) generateToken() (string, error) {
+	tokenBytes := make([]byte, s.TokenBytes)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(tokenBytes), nil
+}
+
+// queryInt returns zero for a missing parameter.
+func queryInt(c *gin.Context, name string) (int, error) {
+	value, ok := c.GetQuery(name)
+	if !ok {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+// queryPage reads the limit and offset query parameters, zero when missing.
+func queryPage(c *gin.Context) (domain.Page, error) {
+	var page domain.Page
+	var err error
+	if page.Limit, err = queryInt(c, "limit"); err == nil {
+		page.Offset, err = queryInt(c, "offset")
+	}
+
+	return page, err
+}
+
+// parseTaskFilter reads the repeatable list, priority and assignee query
+// parameters, the optional done flag, the due view and the q filter query.
+// Due and q are computed for the day in the location.
+func parseTaskFilter(c *gin.Context, location *time.Location) (domain.TaskFilter, error) {
+	var filter domain.TaskFilter
+	for _, value := range c.QueryArray("list") {
+		listID, err := uuid.Parse(value)
+		if err != nil {
+			return filter, fmt.Errorf("list: %w", err)
+		}
+		filter.ListIDs = append(filter.ListIDs, listID)
+	}
+	for _, value := range c.QueryArray("priority") {
+		if value != domain.Low && value != domain.Normal && value != domain.High {
+			return filter, fmt.Errorf("unknown priority %q", value)
+		}
+		filter.Priorities = append(filter.Priorities, value)
+	}
+	for _, value := range c.QueryArray("assignee") {
+		assigneeID, err := uuid.Parse(value)
+		if err != nil {
+			return filter, fmt.Errorf("assignee: %w", err)
+		}
+		filter.AssigneeIDs = append(filter.AssigneeIDs, assigneeID)
+	}
+	if value, ok := c.GetQuery("done"); ok {
+		done, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, fmt.Errorf("done: %w", err)
+		}
+		filter.Done = &done
+	}
+	if value, ok := c.GetQuery("due"); ok {
+		if value != domain.DueToday && value != domain.DueOverdue {
+			return filter, fmt.Errorf("unknown due view %q", value)
+		}
+		filter.Due = value
+	}
+	filter.Today = domain.DayIn(time.Now(), location)
+	if value, ok := c.GetQuery("q"); ok {
+		query, err := domain.ParseQuery(value, filter.Today)
+		if err != nil {
+			return filter, fmt.Errorf("q: %w", err)
+		}
+		filter.Query = query
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JWT struct {
+	users   domain.UserInterface
+	keyRing domain.JWTKeyRing
+	secrets Secrets
+}
+
+// NewJWT mints JWTs for logged in users. The controller shares the user
+// service with Users and doesn't own it, so it has no Close.
+func NewJWT(users domain.UserInterface, keyRing domain.JWTKeyRing, secrets Secrets) *JWT {
+	return &JWT{users: users, keyRing: keyRing, secrets: secrets}
+}
+
+// Issue trades the login token for a JWT. A JWT can't mint another one, so
+// logging out ends the renewals.
+func (ctl *JWT) Issue(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	if _, isJWT := c.Get(ctxAuthJWT); isJWT {
+		slog.WarnContext(ctx, "JWT for a JWT.", logger.ErrAttr(errors.New("login token required")))
+		c.JSON(http.StatusForbidden, errorResponse("Log in to get a JWT."))
+
+		return
+	}
+
+	token, expiresAt, err := ctl.keyRing.Issue(ctx, curUser)
+	if err != nil {
+		slog.ErrorContext(ctx, "Issue JWT failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Issue JWT failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// Logout revokes the JWT of the request and replaces the login token, so
+// neither can be used again. JWTs issued to other clients stay valid until
+// they expire.
+func (ctl *JWT) Logout(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	if token, isJWT := c.Get(ctxAuthJWT); isJWT {
+		if err := ctl.keyRing.Revoke(ctx, token.(string)); err != nil {
+			slog.ErrorContext(ctx, "Revoke JWT failed.", logger.ErrAttr(err))
+			c.JSON(http.StatusUnprocessableEntity, errorResponse("Logout failed."))
+
+			return
+		}
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Logout failed."))
+
+		return
+	}
+
+	if err = ctl.users.UpdateToken(ctx, curUser.Email, token); err != nil {
+		slog.ErrorContext(ctx, "Update user token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Logout failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// JWKS publishes the public keys, so other services can verify the JWTs.
+func (ctl *JWT) JWKS(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	data, err := ctl.keyRing.JWKS()
+	if err != nil {
+		slog.ErrorContext(ctx, "Get JWKS failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusInternalServerError, errorResponse("Get JWKS failed."))
+
+		return
+	}
+
+	// Verifiers fetch the set again for a key ID they don't know yet.
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Data(http.StatusOK, "application/jwk-set+json", data)
+}
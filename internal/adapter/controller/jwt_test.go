@@ -0,0 +1,94 @@
+package controller_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWT(t *testing.T) {
+	const jwt = "header.claims.signature"
+	user := domain.User{Email: "ann@email.foo"}
+
+	newRouter := func(t *testing.T) (*gin.Engine, *mocks.MockUserInterface, *mocks.MockJWTKeyRing) {
+		users, keyRing := mocks.NewMockUserInterface(t), mocks.NewMockJWTKeyRing(t)
+		users.EXPECT().Authenticate(mock.Anything, "login").Return(user, nil).Maybe()
+		keyRing.EXPECT().Verify(mock.Anything, jwt).Return(user, nil).Maybe()
+		keyRing.EXPECT().Verify(mock.Anything, "revoked.claims.signature").Return(domain.User{}, errors.New("some error")).Maybe()
+
+		ctl := controller.NewJWT(users, keyRing, secrets)
+		router := gin.New()
+		router.GET("/jwks.json", ctl.JWKS)
+		authRequired := router.Group("/v1")
+		authRequired.Use(controller.NewAuthMiddleware(users, mocks.NewMockAccessTokenInterface(t), keyRing).Auth)
+		authRequired.POST("/jwt", ctl.Issue)
+		authRequired.POST("/logout", ctl.Logout)
+		authRequired.GET("/task", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		return router, users, keyRing
+	}
+	serve := func(router *gin.Engine, method, path, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		return response
+	}
+
+	t.Run("Authenticate", func(t *testing.T) {
+		router, _, _ := newRouter(t)
+
+		require.Equal(t, http.StatusOK, serve(router, "GET", "/v1/task", jwt).Code)
+		require.Equal(t, http.StatusOK, serve(router, "GET", "/v1/task", "login").Code)
+		require.Equal(t, http.StatusUnauthorized, serve(router, "GET", "/v1/task", "revoked.claims.signature").Code)
+	})
+
+	t.Run("Issue", func(t *testing.T) {
+		router, _, keyRing := newRouter(t)
+		expiresAt := time.Date(2025, time.March, 1, 12, 15, 0, 0, time.UTC)
+		keyRing.EXPECT().Issue(mock.Anything, user).Return(jwt, expiresAt, nil).Once()
+
+		response := serve(router, "POST", "/v1/jwt", "login")
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.JSONEq(t, `{"token": "header.claims.signature", "expires_at": "2025-03-01T12:15:00Z"}`, response.Body.String())
+	})
+
+	t.Run("Issue With JWT", func(t *testing.T) {
+		router, _, _ := newRouter(t)
+
+		require.Equal(t, http.StatusForbidden, serve(router, "POST", "/v1/jwt", jwt).Code)
+	})
+
+	t.Run("Logout", func(t *testing.T) {
+		router, users, keyRing := newRouter(t)
+		keyRing.EXPECT().Revoke(mock.Anything, jwt).Return(nil).Once()
+		users.EXPECT().UpdateToken(mock.Anything, user.Email, mock.Anything).Return(nil).Twice()
+
+		require.Equal(t, http.StatusNoContent, serve(router, "POST", "/v1/logout", jwt).Code)
+		// Login tokens are only replaced.
+		require.Equal(t, http.StatusNoContent, serve(router, "POST", "/v1/logout", "login").Code)
+	})
+
+	t.Run("JWKS", func(t *testing.T) {
+		router, _, keyRing := newRouter(t)
+		keyRing.EXPECT().JWKS().Return([]byte(`{"keys":[]}`), nil).Once()
+
+		response := serve(router, "GET", "/jwks.json", "")
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Equal(t, "application/jwk-set+json", response.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"keys":[]}`, response.Body.String())
+	})
+}
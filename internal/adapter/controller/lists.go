@@ -10,6 +10,7 @@ import (
 	"todo_list/internal/domain"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 var _ io.Closer = (*Lists)(nil)
@@ -25,7 +26,7 @@ func NewLists(service domain.ListInterface) *Lists {
 func (ctl *Lists) GetUserListsAndTasks(c *gin.Context) {
 	ctx, curUser := c.Request.Context(), getCurrentUser(c)
 
-	listAndTasks, err := ctl.service.GetAll(ctx, curUser.ID)
+	listAndTasks, err := ctl.service.GetAll(ctx, curUser.ID, getCurrentWorkspace(c))
 	if err != nil {
 		slog.ErrorContext(ctx, "Read all failed.", logger.ErrAttr(err))
 		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read all failed."))
@@ -55,7 +56,7 @@ func (ctl *Lists) CreateList(c *gin.Context) {
 		return
 	}
 
-	list.UserID = curUser.ID
+	list.UserID, list.WorkspaceID = curUser.ID, getCurrentWorkspace(c)
 
 	if err = ctl.service.Create(ctx, list); err != nil {
 		slog.ErrorContext(ctx, "Create list failed.", logger.ErrAttr(err))
@@ -86,7 +87,7 @@ func (ctl *Lists) UpdateList(c *gin.Context) {
 		return
 	}
 	// Вот здесь
-	list.UserID = curUser.ID
+	list.UserID, list.WorkspaceID = curUser.ID, getCurrentWorkspace(c)
 
 	if err = ctl.service.Update(ctx, list); err != nil {
 		slog.ErrorContext(ctx, "Update name failed.", logger.ErrAttr(err))
@@ -119,7 +120,7 @@ func (ctl *Lists) DeleteList(c *gin.Context) {
 		return
 	}
 
-	if err = ctl.service.Delete(ctx, curUser.ID, message.ListID); err != nil {
+	if err = ctl.service.Delete(ctx, curUser.ID, getCurrentWorkspace(c), message.ListID); err != nil {
 		slog.ErrorContext(ctx, "Delete list failed.", logger.ErrAttr(err))
 		c.JSON(http.StatusUnprocessableEntity, errorResponse("Delete list failed."))
 
@@ -129,6 +130,98 @@ func (ctl *Lists) DeleteList(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+func (ctl *Lists) GetMembers(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse list id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse list id failed."))
+
+		return
+	}
+
+	members, err := ctl.service.GetMembers(ctx, curUser.ID, getCurrentWorkspace(c), listID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read members failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read members failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, members)
+}
+
+// AddMember shares the list with a registered user found by email.
+func (ctl *Lists) AddMember(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse list id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse list id failed."))
+
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Email string `json:"email"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if err = ctl.service.AddMember(ctx, curUser.ID, getCurrentWorkspace(c), listID, message.Email); err != nil {
+		slog.ErrorContext(ctx, "Add member failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Add member failed."))
+
+		return
+	}
+
+	c.Status(http.StatusCreated)
+}
+
+// RemoveMember unshares the list. Members may remove themselves to leave it.
+func (ctl *Lists) RemoveMember(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse list id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse list id failed."))
+
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("user"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse member id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse member id failed."))
+
+		return
+	}
+
+	if err = ctl.service.RemoveMember(ctx, curUser.ID, getCurrentWorkspace(c), listID, memberID); err != nil {
+		slog.ErrorContext(ctx, "Remove member failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Remove member failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 func (ctl *Lists) Close() error {
 	return ctl.service.Close()
 }
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*OIDC)(nil)
+
+// oidcBindingCookie holds the secret binding a sign-in to the browser that
+// started it, the page at the redirect URL sends it back with credentials.
+const oidcBindingCookie = "oidc_binding"
+
+// OIDC signs users in with an OpenID Connect provider. The client sends the
+// browser to the URL from Start, and the page at the redirect URL posts the
+// code and state it got back to Callback.
+type OIDC struct {
+	service domain.OIDCInterface
+	secrets Secrets
+}
+
+func NewOIDC(service domain.OIDCInterface, secrets Secrets) *OIDC {
+	return &OIDC{service: service, secrets: secrets}
+}
+
+func (ctl *OIDC) Start(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	binding, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create binding failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Start sign-in failed."))
+
+		return
+	}
+
+	authURL, err := ctl.service.Start(ctx, binding)
+	if err != nil {
+		slog.ErrorContext(ctx, "Start sign-in failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Start sign-in failed."))
+
+		return
+	}
+
+	setOIDCBinding(c, binding, domain.OIDCLoginTTL)
+	c.JSON(http.StatusOK, struct {
+		URL string `json:"url"`
+	}{
+		URL: authURL,
+	})
+}
+
+// Callback answers like Login, with a token or a two-factor challenge.
+func (ctl *OIDC) Callback(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		State string
+		Code  string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	// A missing cookie leaves the binding empty, which the service refuses.
+	binding, _ := c.Cookie(oidcBindingCookie)
+	setOIDCBinding(c, "", -time.Second)
+
+	challenge, err := ctl.service.Callback(ctx, message.State, message.Code, binding, token)
+	if err != nil {
+		slog.ErrorContext(ctx, "Sign-in failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Sign-in failed."))
+
+		return
+	}
+	if challenge != "" {
+		c.JSON(http.StatusOK, struct {
+			TwoFactor bool   `json:"two_factor"`
+			Challenge string `json:"challenge"`
+		}{
+			TwoFactor: true,
+			Challenge: challenge,
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, struct {
+		Token string `json:"token"`
+	}{
+		Token: token,
+	})
+}
+
+// setOIDCBinding sets the cookie for the sign-in routes, a negative maxAge
+// removes it. The client runs on another origin, so the cookie is sent
+// cross-site, the binding itself is what stops a forged callback.
+func setOIDCBinding(c *gin.Context, binding string, maxAge time.Duration) {
+	c.SetSameSite(http.SameSiteNoneMode)
+	c.SetCookie(oidcBindingCookie, binding, int(maxAge.Seconds()), "/oidc", "", true, true)
+}
+
+func (ctl *OIDC) Close() error {
+	return ctl.service.Close()
+}
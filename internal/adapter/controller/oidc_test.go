@@ -0,0 +1,108 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"todo_list/internal/adapter/controller"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCStart(t *testing.T) {
+	service := mocks.NewMockOIDCInterface(t)
+	var binding string
+	service.EXPECT().Start(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, b string) (string, error) {
+			binding = b
+
+			return "https://idp.example/authorize", nil
+		}).Once()
+
+	response := httpPost(httptest.NewRequest("POST", "/", nil), controller.NewOIDC(service, secrets).Start)
+
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Contains(t, response.Body.String(), "https://idp.example/authorize")
+	cookies := response.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.NotEmpty(t, binding)
+	require.Equal(t, binding, cookies[0].Value)
+	require.True(t, cookies[0].HttpOnly)
+	require.True(t, cookies[0].Secure)
+}
+
+func TestOIDCCallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		binding  string
+		setup    func(*mocks.MockOIDCInterface)
+		code     int
+		contains string
+	}{
+		{
+			name:    "Token",
+			body:    `{"state": "some state", "code": "some code"}`,
+			binding: "some binding",
+			setup: func(service *mocks.MockOIDCInterface) {
+				service.EXPECT().Callback(mock.Anything, "some state", "some code", "some binding", mock.Anything).Return("", nil).Once()
+			},
+			code:     http.StatusOK,
+			contains: `"token"`,
+		},
+		{
+			name:    "Two-factor required",
+			body:    `{"state": "some state", "code": "some code"}`,
+			binding: "some binding",
+			setup: func(service *mocks.MockOIDCInterface) {
+				service.EXPECT().Callback(mock.Anything, "some state", "some code", "some binding", mock.Anything).Return("some challenge", nil).Once()
+			},
+			code:     http.StatusOK,
+			contains: `"challenge":"some challenge"`,
+		},
+		{
+			name:    "Invalid state",
+			body:    `{"state": "used state", "code": "some code"}`,
+			binding: "some binding",
+			setup: func(service *mocks.MockOIDCInterface) {
+				service.EXPECT().Callback(mock.Anything, "used state", "some code", "some binding", mock.Anything).Return("", errors.New("some error")).Once()
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Without binding",
+			body: `{"state": "some state", "code": "some code"}`,
+			setup: func(service *mocks.MockOIDCInterface) {
+				service.EXPECT().Callback(mock.Anything, "some state", "some code", "", mock.Anything).Return("", errors.New("some error")).Once()
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name:  "Invalid body",
+			body:  `{`,
+			setup: func(*mocks.MockOIDCInterface) {},
+			code:  http.StatusUnprocessableEntity,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := mocks.NewMockOIDCInterface(t)
+			test.setup(service)
+
+			request := httptest.NewRequest("POST", "/", strings.NewReader(test.body))
+			if test.binding != "" {
+				request.AddCookie(&http.Cookie{Name: "oidc_binding", Value: test.binding})
+			}
+			response := httpPost(request, controller.NewOIDC(service, secrets).Callback)
+
+			require.Equal(t, test.code, response.Code)
+			require.Contains(t, response.Body.String(), test.contains)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*Passwords)(nil)
+
+type Passwords struct {
+	service domain.PasswordResetInterface
+	secrets Secrets
+}
+
+func NewPasswords(service domain.PasswordResetInterface, secrets Secrets) *Passwords {
+	return &Passwords{service: service, secrets: secrets}
+}
+
+// Forgot answers the same whether an account with the email exists or not.
+func (ctl *Passwords) Forgot(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Email string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	if err = ctl.service.Forgot(ctx, message.Email, token); err != nil {
+		slog.ErrorContext(ctx, "Forgot password failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Forgot password failed."))
+
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// Reset signs the user out everywhere, they log in with the new password.
+func (ctl *Passwords) Reset(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Token    string
+		Password string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+	if len(message.Password) <= 0 {
+		slog.ErrorContext(ctx, "Empty password.")
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Empty password."))
+
+		return
+	}
+
+	passwordHash, err := ctl.secrets.hashPassword(message.Password)
+	if err != nil {
+		slog.ErrorContext(ctx, "Hashing password failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Hashing password failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	if err = ctl.service.Reset(ctx, message.Token, string(passwordHash), token); err != nil {
+		slog.ErrorContext(ctx, "Reset password failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Reset password failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *Passwords) Close() error {
+	return ctl.service.Close()
+}
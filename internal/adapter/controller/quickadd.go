@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/adapter/quickadd"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var _ io.Closer = (*QuickAdd)(nil)
+
+type QuickAdd struct {
+	lists domain.ListInterface
+}
+
+func NewQuickAdd(lists domain.ListInterface) *QuickAdd {
+	return &QuickAdd{lists: lists}
+}
+
+// Parse returns the task typed as a single line for the user to confirm, it
+// doesn't create anything. The task is ready to be sent to CreateTask, with
+// the list filled in when the user has access to a list of that name.
+func (ctl *QuickAdd) Parse(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Text string `json:"text"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	result, err := quickadd.Parse(message.Text, quickadd.Options{Now: time.Now(), Location: curUser.Location(), Locale: curUser.Locale})
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse task failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse task failed."))
+
+		return
+	}
+	result.Task.ID = domain.TaskID(uuid.New())
+
+	if result.List != "" {
+		lists, err := ctl.lists.GetAll(ctx, curUser.ID, getCurrentWorkspace(c))
+		if err != nil {
+			slog.ErrorContext(ctx, "Get user lists failed.", logger.ErrAttr(err))
+			c.JSON(http.StatusUnprocessableEntity, errorResponse("Get lists failed."))
+
+			return
+		}
+
+		for _, list := range lists {
+			if strings.EqualFold(list.Name, result.List) {
+				result.Task.ListID = list.ID
+
+				break
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (ctl *QuickAdd) Close() error {
+	return ctl.lists.Close()
+}
@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ctxLoginResult holds whether the credentials of the request were right, for
+// Lockout.
+const ctxLoginResult = "ctx_login_result"
+
+// KeyFunc tells whose requests a limit counts. An empty key isn't limited.
+type KeyFunc func(*gin.Context) string
+
+// ClientIP keys by the address of the client. Addresses in forwarding
+// headers are only used for the router's trusted proxies.
+func ClientIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// BodyEmail keys by the email field of a JSON body, so attempts against one
+// account count together whatever address they come from. The body is left
+// for the handler.
+func BodyEmail(c *gin.Context) string {
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var message struct {
+		Email string
+	}
+	_ = json.Unmarshal(body, &message)
+
+	return strings.ToLower(strings.TrimSpace(message.Email))
+}
+
+// BasicAuthEmail keys by the username of HTTP Basic credentials, which CalDAV
+// clients send as the email.
+func BasicAuthEmail(c *gin.Context) string {
+	email, _, ok := c.Request.BasicAuth()
+	if !ok {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// CurrentUser keys by the authenticated user.
+func CurrentUser(c *gin.Context) string {
+	return getCurrentUser(c).ID.String()
+}
+
+// RateLimiter makes middlewares rejecting requests over their limits with
+// 429 Too Many Requests and a Retry-After header. Requests pass when the
+// store fails, an unavailable store shouldn't take the service down.
+type RateLimiter struct {
+	store domain.RateLimitStore
+}
+
+func NewRateLimiter(store domain.RateLimitStore) *RateLimiter {
+	return &RateLimiter{store: store}
+}
+
+// Limit gives every key a token bucket of its own. The name separates the
+// buckets of route groups sharing a key.
+func (rl *RateLimiter) Limit(name string, limit domain.RateLimit, key KeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		k := key(c)
+		if k == "" {
+			c.Next()
+
+			return
+		}
+
+		wait, err := rl.store.Take(ctx, name+":"+k, limit)
+		if err != nil {
+			slog.ErrorContext(ctx, "Rate limit failed.", logger.ErrAttr(err))
+		}
+		if wait > 0 {
+			slog.WarnContext(ctx, "Rate limit exceeded.", slog.String("limit", name), slog.String("key", k))
+			tooManyRequests(c, wait)
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// reportLogin tells Lockout whether the credentials were right. Requests
+// without a report, such as those failing for other reasons once
+// authenticated, leave the failures as they are.
+func reportLogin(c *gin.Context, ok bool) {
+	c.Set(ctxLoginResult, ok)
+}
+
+// Lockout locks a key out after the handler reported wrong credentials for it
+// repeatedly, see reportLogin. Right credentials clear the failures.
+func (rl *RateLimiter) Lockout(name string, lockout domain.Lockout, key KeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		k := key(c)
+		if k == "" {
+			c.Next()
+
+			return
+		}
+		k = name + ":" + k
+
+		wait, err := rl.store.Locked(ctx, k)
+		if err != nil {
+			slog.ErrorContext(ctx, "Read lockout failed.", logger.ErrAttr(err))
+		}
+		if wait > 0 {
+			slog.WarnContext(ctx, "Locked out.", slog.String("key", k))
+			tooManyRequests(c, wait)
+
+			return
+		}
+
+		c.Next()
+
+		result, reported := c.Get(ctxLoginResult)
+		switch {
+		case !reported:
+			return
+		case result == true:
+			err = rl.store.Clear(ctx, k)
+		default:
+			_, err = rl.store.Fail(ctx, k, lockout)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Update lockout failed.", logger.ErrAttr(err))
+		}
+	}
+}
+
+func tooManyRequests(c *gin.Context, wait time.Duration) {
+	c.Header("Retry-After", strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, errorResponse("Too many requests."))
+}
+
+// ParseRateLimit reads a limit such as "10/m": a burst of 10 requests and
+// 10 more every minute. The units are s, m and h.
+func ParseRateLimit(value string) (domain.RateLimit, error) {
+	count, unit, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return domain.RateLimit{}, fmt.Errorf("rate limit %q is not count/unit", value)
+	}
+
+	burst, err := strconv.Atoi(count)
+	if err != nil || burst <= 0 {
+		return domain.RateLimit{}, fmt.Errorf("rate limit %q: invalid count", value)
+	}
+
+	var period time.Duration
+	switch unit {
+	case "s":
+		period = time.Second
+	case "m":
+		period = time.Minute
+	case "h":
+		period = time.Hour
+	default:
+		return domain.RateLimit{}, fmt.Errorf("rate limit %q: unknown unit %q", value, unit)
+	}
+
+	return domain.RateLimit{Rate: float64(burst) / period.Seconds(), Burst: burst}, nil
+}
@@ -0,0 +1,172 @@
+package controller_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterLimit(t *testing.T) {
+	limit := domain.RateLimit{Rate: 1, Burst: 5}
+
+	tests := []struct {
+		name       string
+		wait       time.Duration
+		err        error
+		want       int
+		retryAfter string
+	}{
+		{name: "Allowed", want: http.StatusNoContent},
+		{name: "Limited", wait: 1500 * time.Millisecond, want: http.StatusTooManyRequests, retryAfter: "2"},
+		{name: "Store failed", err: errors.New("some error"), want: http.StatusNoContent},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := mocks.NewMockRateLimitStore(t)
+			store.EXPECT().Take(mock.Anything, "login:ann@email.foo", limit).Return(test.wait, test.err).Once()
+
+			limiter := controller.NewRateLimiter(store)
+			response := httpPost(httptest.NewRequest("POST", "/", strings.NewReader(`{"email": " Ann@email.foo"}`)),
+				func(c *gin.Context) {
+					limiter.Limit("login", limit, controller.BodyEmail)(c)
+					if !c.IsAborted() {
+						body, err := io.ReadAll(c.Request.Body)
+						require.NoError(t, err)
+						require.Contains(t, string(body), "Ann@email.foo")
+						c.Status(http.StatusNoContent)
+					}
+				})
+
+			require.Equal(t, test.want, response.Code)
+			require.Equal(t, test.retryAfter, response.Header().Get("Retry-After"))
+		})
+	}
+}
+
+func TestRateLimiterLockout(t *testing.T) {
+	lockout := domain.Lockout{Threshold: 5, Delay: time.Minute, MaxDelay: time.Hour, Reset: time.Hour}
+	const key = "login:ann@email.foo"
+	right, wrong := true, false
+
+	tests := []struct {
+		name         string
+		key          controller.KeyFunc
+		status       int
+		reported     *bool
+		prepareMocks func(*mocks.MockRateLimitStore)
+		want         int
+	}{
+		{
+			name:     "Success clears",
+			status:   http.StatusOK,
+			reported: &right,
+			prepareMocks: func(store *mocks.MockRateLimitStore) {
+				store.EXPECT().Locked(mock.Anything, key).Return(0, nil).Once()
+				store.EXPECT().Clear(mock.Anything, key).Return(nil).Once()
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:     "Failure counts",
+			status:   http.StatusUnprocessableEntity,
+			reported: &wrong,
+			prepareMocks: func(store *mocks.MockRateLimitStore) {
+				store.EXPECT().Locked(mock.Anything, key).Return(0, nil).Once()
+				store.EXPECT().Fail(mock.Anything, key, lockout).Return(time.Minute, nil).Once()
+			},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "Unreported failure doesn't count",
+			status: http.StatusUnprocessableEntity,
+			prepareMocks: func(store *mocks.MockRateLimitStore) {
+				store.EXPECT().Locked(mock.Anything, key).Return(0, nil).Once()
+			},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "Unreported success doesn't clear",
+			status: http.StatusOK,
+			prepareMocks: func(store *mocks.MockRateLimitStore) {
+				store.EXPECT().Locked(mock.Anything, key).Return(0, nil).Once()
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:   "Locked out",
+			status: http.StatusOK,
+			prepareMocks: func(store *mocks.MockRateLimitStore) {
+				store.EXPECT().Locked(mock.Anything, key).Return(time.Minute, nil).Once()
+			},
+			want: http.StatusTooManyRequests,
+		},
+		{
+			name:     "Basic authentication failure counts",
+			key:      controller.BasicAuthEmail,
+			status:   http.StatusUnauthorized,
+			reported: &wrong,
+			prepareMocks: func(store *mocks.MockRateLimitStore) {
+				store.EXPECT().Locked(mock.Anything, key).Return(0, nil).Once()
+				store.EXPECT().Fail(mock.Anything, key, lockout).Return(time.Minute, nil).Once()
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name:   "Basic authentication locked out",
+			key:    controller.BasicAuthEmail,
+			status: http.StatusOK,
+			prepareMocks: func(store *mocks.MockRateLimitStore) {
+				store.EXPECT().Locked(mock.Anything, key).Return(time.Minute, nil).Once()
+			},
+			want: http.StatusTooManyRequests,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := mocks.NewMockRateLimitStore(t)
+			test.prepareMocks(store)
+
+			key := test.key
+			if key == nil {
+				key = controller.BodyEmail
+			}
+
+			limiter := controller.NewRateLimiter(store)
+			router, response := gin.New(), httptest.NewRecorder()
+			router.POST("/", limiter.Lockout("login", lockout, key), func(c *gin.Context) {
+				if test.reported != nil {
+					controller.ReportLogin(c, *test.reported)
+				}
+				c.Status(test.status)
+			})
+			request := httptest.NewRequest("POST", "/", strings.NewReader(`{"email": "ann@email.foo"}`))
+			request.SetBasicAuth("Ann@Email.foo", "secret")
+			router.ServeHTTP(response, request)
+
+			require.Equal(t, test.want, response.Code)
+		})
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	limit, err := controller.ParseRateLimit("30/m")
+	require.NoError(t, err)
+	require.Equal(t, domain.RateLimit{Rate: 0.5, Burst: 30}, limit)
+
+	for _, value := range []string{"", "30", "0/m", "x/m", "30/d"} {
+		_, err = controller.ParseRateLimit(value)
+		require.Error(t, err, value)
+	}
+}
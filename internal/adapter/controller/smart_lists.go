@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var _ io.Closer = (*SmartLists)(nil)
+
+type SmartLists struct {
+	service domain.SmartListInterface
+}
+
+func NewSmartLists(service domain.SmartListInterface) *SmartLists {
+	return &SmartLists{service: service}
+}
+
+func (ctl *SmartLists) GetSmartLists(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	lists, err := ctl.service.GetAll(ctx, curUser.ID, getCurrentWorkspace(c))
+	if err != nil {
+		slog.ErrorContext(ctx, "Get smart lists failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get smart lists failed."))
+
+		return
+	}
+	if lists == nil {
+		lists = []domain.SmartList{}
+	}
+
+	c.JSON(http.StatusOK, lists)
+}
+
+func (ctl *SmartLists) CreateSmartList(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	list, ok := readSmartList(c)
+	if !ok {
+		return
+	}
+	list.UserID, list.WorkspaceID = curUser.ID, getCurrentWorkspace(c)
+
+	if err := ctl.service.Create(ctx, list); err != nil {
+		slog.ErrorContext(ctx, "Create smart list failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create smart list failed."))
+
+		return
+	}
+
+	c.Status(http.StatusCreated)
+}
+
+func (ctl *SmartLists) UpdateSmartList(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	list, ok := readSmartList(c)
+	if !ok {
+		return
+	}
+	list.UserID, list.WorkspaceID = curUser.ID, getCurrentWorkspace(c)
+
+	if err := ctl.service.Update(ctx, list); err != nil {
+		slog.ErrorContext(ctx, "Update smart list failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Update smart list failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *SmartLists) DeleteSmartList(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		ListID domain.SmartListID `json:"id"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if err = ctl.service.Delete(ctx, curUser.ID, getCurrentWorkspace(c), message.ListID); err != nil {
+		slog.ErrorContext(ctx, "Delete smart list failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Delete smart list failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetTasks evaluates the smart list for the current day in the user's zone.
+func (ctl *SmartLists) GetTasks(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse smart list id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse smart list id failed."))
+
+		return
+	}
+
+	tasks, err := ctl.service.GetTasks(ctx, curUser.ID, getCurrentWorkspace(c), listID, domain.DayIn(time.Now(), curUser.Location()))
+	if err != nil {
+		slog.ErrorContext(ctx, "Get smart list tasks failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get smart list tasks failed."))
+
+		return
+	}
+	if tasks == nil {
+		tasks = []domain.Task{}
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
+func (ctl *SmartLists) Close() error {
+	return ctl.service.Close()
+}
+
+func readSmartList(c *gin.Context) (domain.SmartList, bool) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return domain.SmartList{}, false
+	}
+
+	var list domain.SmartList
+	if err = json.Unmarshal(body, &list); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return domain.SmartList{}, false
+	}
+
+	return list, true
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*Stats)(nil)
+
+type Stats struct {
+	service domain.StatsInterface
+}
+
+func NewStats(service domain.StatsInterface) *Stats {
+	return &Stats{service: service}
+}
+
+// GetStats reads the optional from and to dates and the day or week
+// interval. By default it covers the last 30 days, or the last 12 weeks.
+func (ctl *Stats) GetStats(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	statsRange, err := parseStatsRange(c, curUser.Location())
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse stats range failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse range failed."))
+
+		return
+	}
+
+	stats, err := ctl.service.Get(ctx, curUser.ID, getCurrentWorkspace(c), statsRange)
+	if err != nil {
+		slog.ErrorContext(ctx, "Get stats failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Get stats failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+func (ctl *Stats) Close() error {
+	return ctl.service.Close()
+}
+
+func parseStatsRange(c *gin.Context, location *time.Location) (domain.StatsRange, error) {
+	today := domain.DayIn(time.Now(), location)
+	statsRange := domain.StatsRange{Interval: c.DefaultQuery("interval", domain.StatsDay), To: today, Today: today}
+
+	if value, ok := c.GetQuery("to"); ok {
+		date, err := time.ParseInLocation(time.DateOnly, value, location)
+		if err != nil {
+			return statsRange, fmt.Errorf("to: %w", err)
+		}
+		statsRange.To = domain.DayIn(date, location)
+	}
+
+	statsRange.From = statsRange.To.AddDays(-29)
+	if statsRange.Interval == domain.StatsWeek {
+		statsRange.From = statsRange.To.AddDays(-83)
+	}
+	if value, ok := c.GetQuery("from"); ok {
+		date, err := time.ParseInLocation(time.DateOnly, value, location)
+		if err != nil {
+			return statsRange, fmt.Errorf("from: %w", err)
+		}
+		statsRange.From = domain.DayIn(date, location)
+	}
+
+	return statsRange, nil
+}
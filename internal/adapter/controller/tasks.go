@@ -41,7 +41,7 @@ func (ctl *Tasks) CreateTask(c *gin.Context) {
 		return
 	}
 
-	if err = ctl.service.Create(ctx, curUser.ID, task); err != nil {
+	if err = ctl.service.Create(ctx, curUser.ID, getCurrentWorkspace(c), task); err != nil {
 		slog.ErrorContext(ctx, "Create task failed.", logger.ErrAttr(err))
 		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create task failed."))
 
@@ -70,7 +70,7 @@ func (ctl *Tasks) UpdateTask(c *gin.Context) {
 		return
 	}
 
-	if err = ctl.service.Update(ctx, curUser.ID, task); err != nil {
+	if err = ctl.service.Update(ctx, curUser.ID, getCurrentWorkspace(c), task); err != nil {
 		slog.ErrorContext(ctx, "Update task failed.", logger.ErrAttr(err))
 		c.JSON(http.StatusUnprocessableEntity, errorResponse("Update task failed."))
 
@@ -101,7 +101,7 @@ func (ctl *Tasks) DeleteTask(c *gin.Context) {
 		return
 	}
 
-	if err = ctl.service.Delete(ctx, curUser.ID, message.TaskID); err != nil {
+	if err = ctl.service.Delete(ctx, curUser.ID, getCurrentWorkspace(c), message.TaskID); err != nil {
 		slog.ErrorContext(ctx, "Delete task failed.", logger.ErrAttr(err))
 		c.JSON(http.StatusUnprocessableEntity, errorResponse("Delete task failed."))
 
@@ -111,6 +111,46 @@ func (ctl *Tasks) DeleteTask(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// FindTasks searches the accessible lists of the workspace, see parseTaskFilter for the parameters.
+func (ctl *Tasks) FindTasks(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	filter, err := parseTaskFilter(c, curUser.Location())
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse task filter failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse filter failed."))
+
+		return
+	}
+
+	ctl.find(c, curUser.ID, filter)
+}
+
+// GetAssigned returns the tasks assigned to the current user across the lists
+// of the workspace.
+func (ctl *Tasks) GetAssigned(c *gin.Context) {
+	curUser := getCurrentUser(c)
+
+	ctl.find(c, curUser.ID, domain.TaskFilter{AssigneeIDs: []domain.UserID{curUser.ID}})
+}
+
+func (ctl *Tasks) find(c *gin.Context, userID domain.UserID, filter domain.TaskFilter) {
+	ctx := c.Request.Context()
+
+	tasks, err := ctl.service.Find(ctx, userID, getCurrentWorkspace(c), filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "Find tasks failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Find tasks failed."))
+
+		return
+	}
+	if tasks == nil {
+		tasks = []domain.Task{}
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
 func (ctl *Tasks) Close() error {
 	return ctl.service.Close()
 }
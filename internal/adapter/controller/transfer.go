@@ -0,0 +1,362 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+
+	// maxImportSize bounds the body of an import, which is parsed whole
+	// before the import starts.
+	maxImportSize = 32 << 20
+)
+
+var _ io.Closer = (*Transfer)(nil)
+
+// csvHeader has the columns added after the first release at the end, files
+// with only the first legacyCSVColumns of them are still read.
+var csvHeader = []string{"list_id", "list_name", "task_id", "task_name", "priority", "deadline", "done", "all_day", "tags", "recurrence"}
+
+const legacyCSVColumns = 7
+
+type Transfer struct {
+	service domain.TransferInterface
+}
+
+func NewTransfer(service domain.TransferInterface) *Transfer {
+	return &Transfer{service: service}
+}
+
+func (ctl *Transfer) Export(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	format := c.DefaultQuery("format", formatJSON)
+	if format != formatJSON && format != formatCSV {
+		slog.ErrorContext(ctx, "Unknown export format.", slog.String("format", format))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Unknown format."))
+
+		return
+	}
+
+	var encoder listEncoder = &csvEncoder{writer: csv.NewWriter(c.Writer)}
+	contentType := "text/csv; charset=utf-8"
+	if format == formatJSON {
+		encoder = &jsonEncoder{w: c.Writer, encoder: json.NewEncoder(c.Writer)}
+		contentType = "application/json; charset=utf-8"
+	}
+
+	// The headers go out with the first list, until then a failed export
+	// can still be answered with an error.
+	started := false
+	start := func() {
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="export.%s"`, format))
+		c.Header("Content-Type", contentType)
+		c.Status(http.StatusOK)
+		started = true
+	}
+	err := ctl.service.Export(ctx, curUser.ID, getCurrentWorkspace(c), func(list domain.List) error {
+		if !started {
+			start()
+		}
+
+		return encoder.Encode(list)
+	})
+	if err == nil {
+		if !started {
+			start()
+		}
+		err = encoder.Close()
+	}
+	if err != nil && !started {
+		slog.ErrorContext(ctx, "Export failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Export failed."))
+
+		return
+	}
+	if err != nil {
+		// Headers are already sent, the client sees a truncated body.
+		slog.ErrorContext(ctx, "Write export failed.", logger.ErrAttr(err))
+	}
+}
+
+func (ctl *Transfer) Import(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	var options domain.ImportOptions
+	{
+		var err error
+		options.Mode = c.DefaultQuery("mode", domain.ImportMerge)
+		if options.RemapIDs, err = strconv.ParseBool(c.DefaultQuery("remap_ids", "false")); err != nil {
+			slog.ErrorContext(ctx, "Parse remap_ids failed.", logger.ErrAttr(err))
+			c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse remap_ids failed."))
+
+			return
+		}
+		if options.DryRun, err = strconv.ParseBool(c.DefaultQuery("dry_run", "false")); err != nil {
+			slog.ErrorContext(ctx, "Parse dry_run failed.", logger.ErrAttr(err))
+			c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse dry_run failed."))
+
+			return
+		}
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	var lists []domain.List
+	var err error
+	switch format := c.DefaultQuery("format", formatJSON); format {
+	case formatJSON:
+		err = json.NewDecoder(body).Decode(&lists)
+	case formatCSV:
+		lists, err = readCSV(body)
+	default:
+		slog.ErrorContext(ctx, "Unknown import format.", slog.String("format", format))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Unknown format."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	report, err := ctl.service.Import(ctx, curUser.ID, getCurrentWorkspace(c), lists, options)
+	if err != nil {
+		slog.ErrorContext(ctx, "Import failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Import failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (ctl *Transfer) Close() error {
+	return ctl.service.Close()
+}
+
+// listEncoder writes an export one list at a time, Close finishes it.
+type listEncoder interface {
+	Encode(domain.List) error
+	Close() error
+}
+
+// jsonEncoder writes the lists as a JSON array.
+type jsonEncoder struct {
+	w       io.Writer
+	encoder *json.Encoder
+	started bool
+}
+
+func (e *jsonEncoder) Encode(list domain.List) error {
+	separator := ","
+	if !e.started {
+		separator, e.started = "[", true
+	}
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+
+	return e.encoder.Encode(list)
+}
+
+func (e *jsonEncoder) Close() error {
+	end := "]\n"
+	if !e.started {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(e.w, end)
+
+	return err
+}
+
+// csvEncoder writes a row per task. Lists without tasks get a row with empty
+// task columns. Rows are flushed a list at a time.
+type csvEncoder struct {
+	writer  *csv.Writer
+	started bool
+}
+
+func (e *csvEncoder) Encode(list domain.List) error {
+	if err := e.header(); err != nil {
+		return err
+	}
+
+	if len(list.Tasks) == 0 {
+		record := make([]string, len(csvHeader))
+		record[0], record[1] = list.ID.String(), list.Name
+		if err := e.writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	for _, task := range list.Tasks {
+		var deadline string
+		if task.Deadline != nil && task.AllDay {
+			deadline = task.Deadline.Format(time.DateOnly)
+		} else if task.Deadline != nil {
+			deadline = task.Deadline.Format(time.RFC3339)
+		}
+
+		// Tags may hold commas and spaces, a JSON array keeps them apart.
+		var tags string
+		if len(task.Tags) > 0 {
+			encoded, err := json.Marshal(task.Tags)
+			if err != nil {
+				return err
+			}
+			tags = string(encoded)
+		}
+
+		record := []string{list.ID.String(), list.Name, task.ID.String(), task.Name, task.Priority, deadline,
+			strconv.FormatBool(task.Done), strconv.FormatBool(task.AllDay), tags, task.Recurrence}
+		if err := e.writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) Close() error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) header() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	return e.writer.Write(csvHeader)
+}
+
+// readCSV is the inverse of csvEncoder. Rows of the same list are grouped in
+// order of first appearance. The tasks of a legacy file keep their all-day
+// flag from the format of the deadline, and nil tags, which keeps the current
+// tags of tasks that are merged.
+func readCSV(r io.Reader) ([]domain.List, error) {
+	reader := csv.NewReader(r)
+	// Every row has as many columns as the header.
+	reader.FieldsPerRecord = 0
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(header) != len(csvHeader) && len(header) != legacyCSVColumns {
+		return nil, fmt.Errorf("unexpected %d columns, want %d", len(header), len(csvHeader))
+	}
+	for i := range header {
+		if header[i] != csvHeader[i] {
+			return nil, fmt.Errorf("unexpected column %q, want %q", header[i], csvHeader[i])
+		}
+	}
+	legacy := len(header) == legacyCSVColumns
+
+	var lists []domain.List
+	index := make(map[domain.ListID]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		listID, err := uuid.Parse(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("parse list_id: %w", err)
+		}
+
+		i, ok := index[listID]
+		if !ok {
+			i = len(lists)
+			index[listID] = i
+			lists = append(lists, domain.List{ID: listID, Name: record[1]})
+		}
+
+		if record[2] == "" {
+			continue
+		}
+
+		task := domain.Task{ListID: listID, Name: record[3], Priority: record[4]}
+		if task.ID, err = uuid.Parse(record[2]); err != nil {
+			return nil, fmt.Errorf("parse task_id: %w", err)
+		}
+		if record[5] != "" {
+			// All-day deadlines are written as a bare date.
+			layout := time.RFC3339
+			if len(record[5]) == len(time.DateOnly) {
+				layout = time.DateOnly
+				task.AllDay = true
+			}
+			deadline, err := time.Parse(layout, record[5])
+			if err != nil {
+				return nil, fmt.Errorf("parse deadline: %w", err)
+			}
+			task.Deadline = &deadline
+		}
+		if record[6] != "" {
+			if task.Done, err = strconv.ParseBool(record[6]); err != nil {
+				return nil, fmt.Errorf("parse done: %w", err)
+			}
+		}
+		if !legacy {
+			if err = readCSVColumns(record, &task); err != nil {
+				return nil, err
+			}
+		}
+
+		lists[i].Tasks = append(lists[i].Tasks, task)
+	}
+
+	return lists, nil
+}
+
+// readCSVColumns reads the columns added after the first release.
+func readCSVColumns(record []string, task *domain.Task) error {
+	if record[7] != "" {
+		var err error
+		if task.AllDay, err = strconv.ParseBool(record[7]); err != nil {
+			return fmt.Errorf("parse all_day: %w", err)
+		}
+	}
+
+	task.Tags = []string{}
+	if record[8] != "" {
+		if err := json.Unmarshal([]byte(record[8]), &task.Tags); err != nil {
+			return fmt.Errorf("parse tags: %w", err)
+		}
+	}
+
+	task.Recurrence = record[9]
+
+	return nil
+}
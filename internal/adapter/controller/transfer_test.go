@@ -0,0 +1,254 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferExport(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.MustParse("8a0c1c4e-6d1b-4a53-b0a4-1b7f0c9d2a11"))}
+	deadline := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	lists := []domain.List{
+		{
+			ID:   domain.ListID(uuid.MustParse("0f6e9a34-0a4c-4a55-9d33-0d2f0b4f7c01")),
+			Name: "Work",
+			Tasks: []domain.Task{{
+				ID:       domain.TaskID(uuid.MustParse("5b3d7c1e-2f4a-4b6c-8d9e-0a1b2c3d4e5f")),
+				Priority: domain.High,
+				Deadline: &deadline,
+				Name:     "Report, final",
+			}},
+		},
+		{
+			ID:   domain.ListID(uuid.MustParse("1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f")),
+			Name: "Empty",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		query        string
+		prepareMocks func(*mocks.MockTransferInterface)
+		validation   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "CSV",
+			query: "?format=csv",
+			prepareMocks: func(serviceMock *mocks.MockTransferInterface) {
+				serviceMock.EXPECT().Export(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.Anything).
+					RunAndReturn(exportLists(lists...)).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				require.Equal(t, "list_id,list_name,task_id,task_name,priority,deadline,done,all_day,tags,recurrence\n"+
+					"0f6e9a34-0a4c-4a55-9d33-0d2f0b4f7c01,Work,5b3d7c1e-2f4a-4b6c-8d9e-0a1b2c3d4e5f,\"Report, final\",high,2025-01-02T03:04:05Z,false,false,,\n"+
+					"1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f,Empty,,,,,,,,\n", response.Body.String())
+			},
+		},
+		{
+			name: "JSON",
+			prepareMocks: func(serviceMock *mocks.MockTransferInterface) {
+				serviceMock.EXPECT().Export(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.Anything).
+					RunAndReturn(exportLists(lists...)).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				require.True(t, strings.HasPrefix(response.Body.String(), `[{"id":"0f6e9a34-0a4c-4a55-9d33-0d2f0b4f7c01"`))
+				require.Contains(t, response.Body.String(), `"name":"Report, final"`)
+			},
+		},
+		{
+			name: "JSON without lists",
+			prepareMocks: func(serviceMock *mocks.MockTransferInterface) {
+				serviceMock.EXPECT().Export(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.Anything).
+					RunAndReturn(exportLists()).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				require.Equal(t, "[]\n", response.Body.String())
+			},
+		},
+		{
+			name: "Export failed",
+			prepareMocks: func(serviceMock *mocks.MockTransferInterface) {
+				serviceMock.EXPECT().Export(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.Anything).
+					Return(errors.New("some error")).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+				require.Contains(t, response.Body.String(), "Export failed")
+			},
+		},
+		{
+			name:  "Unknown format",
+			query: "?format=xml",
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+				require.Contains(t, response.Body.String(), "Unknown format")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serviceMock := mocks.NewMockTransferInterface(t)
+			if test.prepareMocks != nil {
+				test.prepareMocks(serviceMock)
+			}
+
+			router, response := gin.New(), httptest.NewRecorder()
+			router.GET("/", controller.WithUser(user), controller.NewTransfer(serviceMock).Export)
+			router.ServeHTTP(response, httptest.NewRequest("GET", "/"+test.query, nil))
+
+			test.validation(t, response)
+		})
+	}
+}
+
+// exportLists passes the lists to the write function like the service.
+func exportLists(lists ...domain.List) func(context.Context, domain.UserID, domain.WorkspaceID, func(domain.List) error) error {
+	return func(_ context.Context, _ domain.UserID, _ domain.WorkspaceID, write func(domain.List) error) error {
+		for _, list := range lists {
+			if err := write(list); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestTransferImport(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New())}
+	listID := uuid.MustParse("0f6e9a34-0a4c-4a55-9d33-0d2f0b4f7c01")
+
+	tests := []struct {
+		name         string
+		request      *http.Request
+		prepareMocks func(*mocks.MockTransferInterface)
+		validation   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Legacy CSV dry run",
+			request: httptest.NewRequest("POST", "/?format=csv&mode=replace&dry_run=true", strings.NewReader(
+				"list_id,list_name,task_id,task_name,priority,deadline,done\n"+
+					"0f6e9a34-0a4c-4a55-9d33-0d2f0b4f7c01,Work,5b3d7c1e-2f4a-4b6c-8d9e-0a1b2c3d4e5f,Report,high,2025-01-02T03:04:05Z,true\n"+
+					"0f6e9a34-0a4c-4a55-9d33-0d2f0b4f7c01,Work,6b3d7c1e-2f4a-4b6c-8d9e-0a1b2c3d4e5f,Review,low,,false\n")),
+			prepareMocks: func(serviceMock *mocks.MockTransferInterface) {
+				serviceMock.EXPECT().Import(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.MatchedBy(func(lists []domain.List) bool {
+					return len(lists) == 1 && lists[0].ID == listID && len(lists[0].Tasks) == 2 &&
+						lists[0].Tasks[0].Done && lists[0].Tasks[0].Deadline != nil && lists[0].Tasks[1].Deadline == nil &&
+						lists[0].Tasks[0].Tags == nil
+				}), domain.ImportOptions{Mode: domain.ImportReplace, DryRun: true}).
+					Return(domain.ImportReport{DryRun: true, ListsCreated: 1, TasksCreated: 2}, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				require.Contains(t, response.Body.String(), `"tasks_created":2`)
+			},
+		},
+		{
+			name:    "Parse body failed",
+			request: httptest.NewRequest("POST", "/?format=csv", strings.NewReader("id,name\n")),
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+				require.Contains(t, response.Body.String(), "Parse body failed")
+			},
+		},
+		{
+			name:    "Parse dry_run failed",
+			request: httptest.NewRequest("POST", "/?dry_run=maybe", strings.NewReader("[]")),
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+				require.Contains(t, response.Body.String(), "Parse dry_run failed")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serviceMock := mocks.NewMockTransferInterface(t)
+			if test.prepareMocks != nil {
+				test.prepareMocks(serviceMock)
+			}
+
+			router, response := gin.New(), httptest.NewRecorder()
+			router.POST("/", controller.WithUser(user), controller.NewTransfer(serviceMock).Import)
+			router.ServeHTTP(response, test.request)
+
+			test.validation(t, response)
+		})
+	}
+}
+
+func TestTransferCSVRoundTrip(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New())}
+	deadline := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	lists := []domain.List{
+		{
+			ID:   domain.ListID(uuid.New()),
+			Name: "Home",
+			Tasks: []domain.Task{
+				{
+					ID:         domain.TaskID(uuid.New()),
+					Priority:   domain.Low,
+					Deadline:   &deadline,
+					AllDay:     true,
+					Done:       true,
+					Name:       "Water plants",
+					Tags:       []string{"garden, front", "weekly"},
+					Recurrence: "FREQ=WEEKLY;BYDAY=MO",
+				},
+				{
+					ID:       domain.TaskID(uuid.New()),
+					Priority: domain.Normal,
+					Name:     "Call plumber",
+					Tags:     []string{},
+				},
+			},
+		},
+		{
+			ID:   domain.ListID(uuid.New()),
+			Name: "Empty",
+		},
+	}
+	for i := range lists[0].Tasks {
+		lists[0].Tasks[i].ListID = lists[0].ID
+	}
+
+	serviceMock := mocks.NewMockTransferInterface(t)
+	serviceMock.EXPECT().Export(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.Anything).
+		RunAndReturn(exportLists(lists...)).Once()
+	var imported []domain.List
+	serviceMock.EXPECT().Import(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID), mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ domain.UserID, _ domain.WorkspaceID, lists []domain.List, _ domain.ImportOptions) (domain.ImportReport, error) {
+			imported = lists
+
+			return domain.ImportReport{}, nil
+		}).Once()
+
+	router, exported := gin.New(), httptest.NewRecorder()
+	ctl := controller.NewTransfer(serviceMock)
+	router.GET("/", controller.WithUser(user), ctl.Export)
+	router.POST("/", controller.WithUser(user), ctl.Import)
+	router.ServeHTTP(exported, httptest.NewRequest("GET", "/?format=csv", nil))
+	require.Equal(t, http.StatusOK, exported.Code)
+
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest("POST", "/?format=csv", exported.Body))
+
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, lists, imported)
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ io.Closer = (*TwoFactor)(nil)
+
+type TwoFactor struct {
+	service domain.TwoFactorInterface
+}
+
+func NewTwoFactor(service domain.TwoFactorInterface) *TwoFactor {
+	return &TwoFactor{service: service}
+}
+
+// Enroll returns the secret both as is and as an otpauth URI for a QR code.
+// Logins don't ask for codes until Confirm.
+func (ctl *TwoFactor) Enroll(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	enrollment, err := ctl.service.Enroll(ctx, curUser)
+	if err != nil {
+		slog.ErrorContext(ctx, "Enroll two-factor failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Enroll two-factor failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, enrollment)
+}
+
+func (ctl *TwoFactor) Confirm(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Code string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	codes, err := ctl.service.Confirm(ctx, curUser.ID, message.Code)
+	if err != nil {
+		slog.ErrorContext(ctx, "Confirm two-factor failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Confirm two-factor failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}{
+		RecoveryCodes: codes,
+	})
+}
+
+func (ctl *TwoFactor) Disable(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Password string
+		Code     string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if err = ctl.service.Disable(ctx, curUser, message.Password, message.Code); err != nil {
+		slog.ErrorContext(ctx, "Disable two-factor failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Disable two-factor failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *TwoFactor) Close() error {
+	return ctl.service.Close()
+}
@@ -1,34 +1,35 @@
 package controller
 
 import (
-	"crypto/rand"
-	"encoding/hex"
+	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/mail"
+	"strings"
 
 	"todo_list/internal/adapter/logger"
 	"todo_list/internal/domain"
 
 	"github.com/gin-gonic/gin"
-	"golang.org/x/crypto/bcrypt"
 )
 
-const (
-	passwordBCryptoCost  = 12
-	passwordHashBytesLen = 16
-)
+// ctxChallengeEmail holds the result of ChallengeEmail, which every limit
+// of the route asks for.
+const ctxChallengeEmail = "ctx_challenge_email"
 
 var _ io.Closer = (*Users)(nil)
 
 type Users struct {
-	service domain.UserInterface
+	service      domain.UserInterface
+	verification domain.EmailVerificationInterface
+	secrets      Secrets
 }
 
-func NewUsers(service domain.UserInterface) *Users {
-	return &Users{service: service}
+func NewUsers(service domain.UserInterface, verification domain.EmailVerificationInterface, secrets Secrets) *Users {
+	return &Users{service: service, verification: verification, secrets: secrets}
 }
 
 func (ctl *Users) Register(c *gin.Context) {
@@ -77,7 +78,7 @@ func (ctl *Users) Register(c *gin.Context) {
 
 	var passwordHash string
 	{
-		passwordHashBytes, err := bcrypt.GenerateFromPassword([]byte(message.Password), passwordBCryptoCost)
+		passwordHashBytes, err := ctl.secrets.hashPassword(message.Password)
 		if err != nil {
 			slog.ErrorContext(ctx, "Hashing password failed.", logger.ErrAttr(err))
 			c.JSON(http.StatusUnprocessableEntity, errorResponse("Hashing password failed."))
@@ -89,7 +90,7 @@ func (ctl *Users) Register(c *gin.Context) {
 
 	var token string
 	{
-		token, err = ctl.generateToken()
+		token, err = ctl.secrets.generateToken()
 		if err != nil {
 			slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
 			c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
@@ -106,6 +107,12 @@ func (ctl *Users) Register(c *gin.Context) {
 		return
 	}
 
+	// The account works without verification, the user can ask for another
+	// link.
+	if err = ctl.verification.Send(ctx, parsedEmail.Address); err != nil {
+		slog.ErrorContext(ctx, "Send verification failed.", logger.ErrAttr(err))
+	}
+
 	c.JSON(http.StatusOK, struct {
 		Token string `json:"token"`
 	}{
@@ -136,26 +143,123 @@ func (ctl *Users) Login(c *gin.Context) {
 		return
 	}
 
-	err = ctl.service.Login(ctx, message.Email, message.Password)
+	// The login succeeds with the second factor, a right password alone
+	// doesn't clear the failures.
+	challenge, err := ctl.service.Login(ctx, message.Email, message.Password)
+	switch {
+	case errors.Is(err, domain.ErrUserServiceDisabled):
+	case err != nil:
+		reportLogin(c, false)
+	case challenge == "":
+		reportLogin(c, true)
+	}
+	if errors.Is(err, domain.ErrUserServiceDisabled) {
+		slog.WarnContext(ctx, "Login failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusForbidden, errorResponse("Account disabled."))
+
+		return
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "Login failed.", logger.ErrAttr(err))
 		c.JSON(http.StatusUnprocessableEntity, errorResponse("Login failed."))
 
 		return
 	}
+	if challenge != "" {
+		c.JSON(http.StatusOK, struct {
+			TwoFactor bool   `json:"two_factor"`
+			Challenge string `json:"challenge"`
+		}{
+			TwoFactor: true,
+			Challenge: challenge,
+		})
 
-	var token string
-	{
-		token, err = ctl.generateToken()
-		if err != nil {
-			slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
-			c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+		return
+	}
 
-			return
+	ctl.issueToken(c, message.Email)
+}
+
+// ChallengeEmail keys limits of LoginTwoFactor by the email of the user the
+// challenge in the JSON body was issued to, so they count together with those
+// of Login. Unknown challenges aren't limited, they fail anyway. The body is
+// left for the handler.
+func (ctl *Users) ChallengeEmail(c *gin.Context) string {
+	if email, ok := c.Get(ctxChallengeEmail); ok {
+		return email.(string)
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var message struct {
+		Challenge string
+	}
+	_ = json.Unmarshal(body, &message)
+
+	var email string
+	if message.Challenge != "" {
+		if email, err = ctl.service.ChallengeEmail(c.Request.Context(), message.Challenge); err != nil {
+			slog.WarnContext(c.Request.Context(), "Read challenge failed.", logger.ErrAttr(err))
 		}
 	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	c.Set(ctxChallengeEmail, email)
 
-	err = ctl.service.UpdateToken(ctx, message.Email, token)
+	return email
+}
+
+// LoginTwoFactor is the second step of Login for users with two-factor
+// authentication, it takes the challenge and a TOTP or recovery code.
+func (ctl *Users) LoginTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Challenge string
+		Code      string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	user, err := ctl.service.LoginTwoFactor(ctx, message.Challenge, message.Code)
+	reportLogin(c, err == nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Login failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Login failed."))
+
+		return
+	}
+
+	ctl.issueToken(c, user.Email)
+}
+
+func (ctl *Users) issueToken(c *gin.Context, email string) {
+	ctx := c.Request.Context()
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	err = ctl.service.UpdateToken(ctx, email, token)
 	if err != nil {
 		slog.ErrorContext(ctx, "Update user token failed.", logger.ErrAttr(err))
 		c.JSON(http.StatusUnprocessableEntity, errorResponse("Update user token failed."))
@@ -170,15 +274,41 @@ func (ctl *Users) Login(c *gin.Context) {
 	})
 }
 
-func (ctl *Users) Close() error {
-	return ctl.service.Close()
+func (ctl *Users) GetPreferences(c *gin.Context) {
+	curUser := getCurrentUser(c)
+
+	c.JSON(http.StatusOK, domain.Preferences{TimeZone: curUser.TimeZone, Locale: curUser.Locale})
 }
 
-func (ctl *Users) generateToken() (string, error) {
-	tokenBytes := make([]byte, passwordHashBytesLen)
-	if _, err := rand.Read(tokenBytes); err != nil {
-		return "", err
+func (ctl *Users) UpdatePreferences(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
 	}
 
-	return hex.EncodeToString(tokenBytes), nil
+	preferences := domain.Preferences{TimeZone: curUser.TimeZone, Locale: curUser.Locale}
+	if err = json.Unmarshal(body, &preferences); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if err = ctl.service.UpdatePreferences(ctx, curUser.ID, preferences); err != nil {
+		slog.ErrorContext(ctx, "Update preferences failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Update preferences failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+func (ctl *Users) Close() error {
+	return ctl.service.Close()
 }
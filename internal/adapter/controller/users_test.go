@@ -2,33 +2,42 @@ package controller_test
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"todo_list/internal/adapter/controller"
+	"todo_list/internal/adapter/ratelimit"
+	"todo_list/internal/domain"
 	mocks "todo_list/mocks/todo_list/src/domain"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
+// secrets hashes with the lowest cost, to keep the tests fast.
+var secrets = controller.Secrets{PasswordCost: bcrypt.MinCost, TokenBytes: 16}
+
 func TestUsersRegister(t *testing.T) {
-	httpCall := func(usersMock *mocks.MockUserInterface, request *http.Request) *httptest.ResponseRecorder {
-		ctl := controller.NewUsers(usersMock)
+	httpCall := func(usersMock *mocks.MockUserInterface, verificationMock *mocks.MockEmailVerificationInterface, request *http.Request) *httptest.ResponseRecorder {
+		ctl := controller.NewUsers(usersMock, verificationMock, secrets)
 		defer func() { _ = ctl.Close() }()
 
 		return httpPost(request, ctl.Register)
 	}
 
 	tests := []struct {
-		name         string
-		request      *http.Request
-		prepareMocks func(*mocks.MockUserInterface)
-		validation   func(*testing.T, *httptest.ResponseRecorder)
+		name                string
+		request             *http.Request
+		prepareMocks        func(*mocks.MockUserInterface)
+		prepareVerification func(*mocks.MockEmailVerificationInterface)
+		validation          func(*testing.T, *httptest.ResponseRecorder)
 	}{
 		{
 			name: "Success",
@@ -37,6 +46,31 @@ func TestUsersRegister(t *testing.T) {
 					Return(nil).Once()
 				serviceMock.EXPECT().Close().Return(nil).Once()
 			},
+			prepareVerification: func(verificationMock *mocks.MockEmailVerificationInterface) {
+				verificationMock.EXPECT().Send(mock.Anything, "johh@doe.foo").Return(nil).Once()
+			},
+			request: httptest.NewRequest("POST", "/", strings.NewReader(`{
+				"name": "John Doe",
+				"email": "johh@doe.foo",
+				"password": "secret"
+			}`)),
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				body, err := response.Body.ReadString('\n')
+				require.ErrorIs(t, err, io.EOF)
+				require.Contains(t, body, `"token"`)
+			},
+		},
+		{
+			name: "Send verification failed",
+			prepareMocks: func(serviceMock *mocks.MockUserInterface) {
+				serviceMock.EXPECT().RegisterUser(mock.Anything, "John Doe", "johh@doe.foo", mock.Anything, mock.Anything).
+					Return(nil).Once()
+				serviceMock.EXPECT().Close().Return(nil).Once()
+			},
+			prepareVerification: func(verificationMock *mocks.MockEmailVerificationInterface) {
+				verificationMock.EXPECT().Send(mock.Anything, "johh@doe.foo").Return(errors.New("some error")).Once()
+			},
 			request: httptest.NewRequest("POST", "/", strings.NewReader(`{
 				"name": "John Doe",
 				"email": "johh@doe.foo",
@@ -140,14 +174,18 @@ func TestUsersRegister(t *testing.T) {
 			if test.prepareMocks != nil {
 				test.prepareMocks(serviceMock)
 			}
-			test.validation(t, httpCall(serviceMock, test.request))
+			verificationMock := mocks.NewMockEmailVerificationInterface(t)
+			if test.prepareVerification != nil {
+				test.prepareVerification(verificationMock)
+			}
+			test.validation(t, httpCall(serviceMock, verificationMock, test.request))
 		})
 	}
 }
 
 func TestUsersLogin(t *testing.T) {
 	httpCall := func(usersMock *mocks.MockUserInterface, request *http.Request) *httptest.ResponseRecorder {
-		ctl := controller.NewUsers(usersMock)
+		ctl := controller.NewUsers(usersMock, nil, secrets)
 		defer func() { _ = ctl.Close() }()
 
 		return httpPost(request, ctl.Login)
@@ -167,7 +205,7 @@ func TestUsersLogin(t *testing.T) {
 			}`)),
 			prepareMocks: func(mockService *mocks.MockUserInterface) {
 				mockService.EXPECT().Login(mock.Anything, "johh@doe.foo", "secret").
-					Return(nil).Once()
+					Return("", nil).Once()
 
 				mockService.EXPECT().UpdateToken(mock.Anything, "johh@doe.foo", mock.Anything).
 					Return(nil).Once()
@@ -181,6 +219,25 @@ func TestUsersLogin(t *testing.T) {
 				require.Contains(t, body, `"token"`)
 			},
 		},
+		{
+			name: "Two-factor required",
+			request: httptest.NewRequest("POST", "/", strings.NewReader(`{
+				"email": "johh@doe.foo",
+				"password": "secret"
+			}`)),
+			prepareMocks: func(mockService *mocks.MockUserInterface) {
+				mockService.EXPECT().Login(mock.Anything, "johh@doe.foo", "secret").
+					Return("some challenge", nil).Once()
+				mockService.EXPECT().Close().Return(nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				body, err := response.Body.ReadString('\n')
+				require.ErrorIs(t, err, io.EOF)
+				require.Contains(t, body, `"challenge":"some challenge"`)
+				require.NotContains(t, body, `"token"`)
+			},
+		},
 		{
 			name:    "Parse body failed",
 			request: httptest.NewRequest("POST", "/", strings.NewReader(`invalid parse body`)),
@@ -203,7 +260,7 @@ func TestUsersLogin(t *testing.T) {
 
 			prepareMocks: func(mockService *mocks.MockUserInterface) {
 				mockService.EXPECT().Login(mock.Anything, "johh@doe.foo", "wrong").
-					Return(errors.New("some error")).Once()
+					Return("", errors.New("some error")).Once()
 				mockService.EXPECT().Close().Return(nil).Once()
 			},
 
@@ -218,7 +275,7 @@ func TestUsersLogin(t *testing.T) {
 			name: "Update token failed",
 			prepareMocks: func(mockService *mocks.MockUserInterface) {
 				mockService.EXPECT().Login(mock.Anything, "johh@doe.foo", "secret").
-					Return(nil).Once()
+					Return("", nil).Once()
 				mockService.EXPECT().UpdateToken(mock.Anything, "johh@doe.foo", mock.Anything).
 					Return(errors.New("some error")).Once()
 				mockService.EXPECT().Close().Return(nil).Once()
@@ -247,6 +304,65 @@ func TestUsersLogin(t *testing.T) {
 	}
 }
 
+func TestUsersLoginTwoFactor(t *testing.T) {
+	serviceMock := mocks.NewMockUserInterface(t)
+	serviceMock.EXPECT().LoginTwoFactor(mock.Anything, "some challenge", "123456").
+		Return(domain.User{Email: "johh@doe.foo"}, nil).Once()
+	serviceMock.EXPECT().UpdateToken(mock.Anything, "johh@doe.foo", mock.Anything).Return(nil).Once()
+	serviceMock.EXPECT().LoginTwoFactor(mock.Anything, "used challenge", "123456").
+		Return(domain.User{}, errors.New("some error")).Once()
+
+	ctl := controller.NewUsers(serviceMock, nil, secrets)
+
+	response := httpPost(httptest.NewRequest("POST", "/", strings.NewReader(`{"challenge": "some challenge", "code": "123456"}`)),
+		ctl.LoginTwoFactor)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Contains(t, response.Body.String(), `"token"`)
+
+	response = httpPost(httptest.NewRequest("POST", "/", strings.NewReader(`{"challenge": "used challenge", "code": "123456"}`)),
+		ctl.LoginTwoFactor)
+	require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+// TestUsersLoginTwoFactorLockout guesses codes with fresh challenges, the
+// account gets locked out all the same.
+func TestUsersLoginTwoFactorLockout(t *testing.T) {
+	lockout := domain.Lockout{Threshold: 5, Delay: time.Minute, MaxDelay: time.Hour, Reset: time.Hour}
+	const email = "ann@email.foo"
+
+	serviceMock := mocks.NewMockUserInterface(t)
+	ctl := controller.NewUsers(serviceMock, nil, secrets)
+	limiter := controller.NewRateLimiter(ratelimit.NewMemory())
+	router := gin.New()
+	router.POST("/login", limiter.Lockout("login", lockout, controller.BodyEmail), ctl.Login)
+	router.POST("/login/2fa", limiter.Lockout("login", lockout, ctl.ChallengeEmail), ctl.LoginTwoFactor)
+	post := func(target, body string) *httptest.ResponseRecorder {
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, httptest.NewRequest("POST", target, strings.NewReader(body)))
+
+		return response
+	}
+
+	for i := range lockout.Threshold {
+		challenge := fmt.Sprintf("challenge %d", i)
+		serviceMock.EXPECT().Login(mock.Anything, email, "right").Return(challenge, nil).Once()
+		serviceMock.EXPECT().ChallengeEmail(mock.Anything, challenge).Return(email, nil).Once()
+		serviceMock.EXPECT().LoginTwoFactor(mock.Anything, challenge, "000000").Return(domain.User{}, errors.New("some error")).Once()
+
+		response := post("/login", `{"email": "`+email+`", "password": "right"}`)
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Contains(t, response.Body.String(), challenge)
+
+		response = post("/login/2fa", `{"challenge": "`+challenge+`", "code": "000000"}`)
+		require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	}
+
+	require.Equal(t, http.StatusTooManyRequests, post("/login", `{"email": "`+email+`", "password": "right"}`).Code)
+
+	serviceMock.EXPECT().ChallengeEmail(mock.Anything, "late challenge").Return(email, nil).Once()
+	require.Equal(t, http.StatusTooManyRequests, post("/login/2fa", `{"challenge": "late challenge", "code": "123456"}`).Code)
+}
+
 func httpPost(request *http.Request, handler func(*gin.Context)) *httptest.ResponseRecorder {
 	router, response := gin.New(), httptest.NewRecorder()
 	router.POST("/", handler)
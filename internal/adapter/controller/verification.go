@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Features unverified users can be restricted from, see Restrictions.
+const (
+	FeatureSharing     = "sharing"
+	FeatureFeeds       = "feeds"
+	FeatureAttachments = "attachments"
+	FeatureImport      = "import"
+)
+
+var features = []string{FeatureSharing, FeatureFeeds, FeatureAttachments, FeatureImport}
+
+var _ io.Closer = (*Verification)(nil)
+
+type Verification struct {
+	service domain.EmailVerificationInterface
+}
+
+func NewVerification(service domain.EmailVerificationInterface) *Verification {
+	return &Verification{service: service}
+}
+
+func (ctl *Verification) Verify(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Token string
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	if err = ctl.service.Verify(ctx, message.Token); err != nil {
+		slog.ErrorContext(ctx, "Verify email failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Verify email failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Resend mails a new link to the current user, the earlier ones keep working.
+func (ctl *Verification) Resend(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	if err := ctl.service.Send(ctx, curUser.Email); err != nil {
+		slog.ErrorContext(ctx, "Send verification failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Send verification failed."))
+
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (ctl *Verification) Close() error {
+	return ctl.service.Close()
+}
+
+// Restrictions lists the features users can't use until they verify their
+// email.
+type Restrictions map[string]bool
+
+// ParseRestrictions reads a comma-separated list of features.
+func ParseRestrictions(value string) (Restrictions, error) {
+	restrictions := Restrictions{}
+	for _, feature := range strings.Split(value, ",") {
+		feature = strings.TrimSpace(feature)
+		if feature == "" {
+			continue
+		}
+		if !slices.Contains(features, feature) {
+			return nil, fmt.Errorf("unknown feature %q", feature)
+		}
+		restrictions[feature] = true
+	}
+
+	return restrictions, nil
+}
+
+// Verified returns a middleware that lets only verified users use the
+// feature when it is restricted. It runs after the authentication one.
+func (r Restrictions) Verified(feature string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if r[feature] && getCurrentUser(c).VerifiedAt == nil {
+			slog.WarnContext(c.Request.Context(), "Unverified user.", logger.ErrAttr(errors.New(feature+" requires a verified email")))
+			c.AbortWithStatusJSON(http.StatusForbidden, errorResponse("Verify your email first."))
+
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,45 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestrictions(t *testing.T) {
+	_, err := controller.ParseRestrictions("sharing,webcams")
+	require.Error(t, err)
+
+	restrictions, err := controller.ParseRestrictions(" sharing, ,feeds")
+	require.NoError(t, err)
+
+	verifiedAt := time.Now()
+	tests := []struct {
+		name    string
+		user    domain.User
+		feature string
+		want    int
+	}{
+		{name: "Unverified Restricted", feature: controller.FeatureSharing, want: http.StatusForbidden},
+		{name: "Unverified Allowed", feature: controller.FeatureImport, want: http.StatusNoContent},
+		{name: "Verified", user: domain.User{VerifiedAt: &verifiedAt}, feature: controller.FeatureFeeds, want: http.StatusNoContent},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router, response := gin.New(), httptest.NewRecorder()
+			router.POST("/", controller.WithUser(test.user), restrictions.Verified(test.feature), func(c *gin.Context) {
+				c.Status(http.StatusNoContent)
+			})
+			router.ServeHTTP(response, httptest.NewRequest("POST", "/", nil))
+
+			require.Equal(t, test.want, response.Code)
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"todo_list/internal/adapter/logger"
+	"todo_list/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// WorkspaceHeader selects the workspace of a request, unless the path
+	// does.
+	WorkspaceHeader = "X-Workspace-ID"
+	// ctxWorkspace holds the workspace selected for the request, it is unset
+	// for the personal workspace.
+	ctxWorkspace = "ctx_workspace"
+)
+
+var _ io.Closer = (*Workspaces)(nil)
+
+type Workspaces struct {
+	service domain.WorkspaceInterface
+	secrets Secrets
+}
+
+func NewWorkspaces(service domain.WorkspaceInterface, secrets Secrets) *Workspaces {
+	return &Workspaces{service: service, secrets: secrets}
+}
+
+// Select is a middleware that switches the request to the workspace in the
+// workspace path parameter or WorkspaceHeader. Requests without either work
+// in the personal workspace. It runs after Auth.
+func (ctl *Workspaces) Select(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	value := c.Param("workspace")
+	if value == "" {
+		value = c.GetHeader(WorkspaceHeader)
+	}
+	if value == "" {
+		c.Next()
+
+		return
+	}
+
+	workspaceID, err := uuid.Parse(value)
+	if err != nil {
+		slog.WarnContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	workspace, err := ctl.service.Get(ctx, curUser.ID, workspaceID)
+	if errors.Is(err, domain.ErrWorkspaceServiceNotFound) {
+		slog.WarnContext(ctx, "Workspace not found.", logger.ErrAttr(err))
+		c.AbortWithStatusJSON(http.StatusNotFound, errorResponse("Workspace not found."))
+
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Read workspace failed.", logger.ErrAttr(err))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, errorResponse("Read workspace failed."))
+
+		return
+	}
+
+	c.Set(ctxWorkspace, workspace.ID)
+
+	c.Next()
+}
+
+// getCurrentWorkspace returns the workspace Select switched to, or the
+// personal workspace of the user.
+func getCurrentWorkspace(c *gin.Context) domain.WorkspaceID {
+	if workspaceID, ok := c.Get(ctxWorkspace); ok {
+		return workspaceID.(domain.WorkspaceID)
+	}
+
+	return domain.PersonalWorkspace(getCurrentUser(c).ID)
+}
+
+func (ctl *Workspaces) GetWorkspaces(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaces, err := ctl.service.GetAll(ctx, curUser.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read workspaces failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read workspaces failed."))
+
+		return
+	}
+	if workspaces == nil {
+		workspaces = []domain.Workspace{}
+	}
+
+	c.JSON(http.StatusOK, workspaces)
+}
+
+func (ctl *Workspaces) CreateWorkspace(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	name, ok := readWorkspaceName(c)
+	if !ok {
+		return
+	}
+
+	workspace, err := ctl.service.Create(ctx, curUser.ID, name)
+	if err != nil {
+		slog.ErrorContext(ctx, "Create workspace failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create workspace failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, workspace)
+}
+
+func (ctl *Workspaces) RenameWorkspace(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	name, ok := readWorkspaceName(c)
+	if !ok {
+		return
+	}
+
+	if err = ctl.service.Rename(ctx, curUser.ID, workspaceID, name); err != nil {
+		slog.ErrorContext(ctx, "Rename workspace failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Rename workspace failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteWorkspace removes the workspace with all its lists.
+func (ctl *Workspaces) DeleteWorkspace(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	if err = ctl.service.Delete(ctx, curUser.ID, workspaceID); err != nil {
+		slog.ErrorContext(ctx, "Delete workspace failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Delete workspace failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ctl *Workspaces) GetMembers(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	members, err := ctl.service.GetMembers(ctx, curUser.ID, workspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read members failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read members failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, members)
+}
+
+// RemoveMember lets owners remove members. Members may remove themselves to
+// leave the workspace.
+func (ctl *Workspaces) RemoveMember(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("user"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse member id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse member id failed."))
+
+		return
+	}
+
+	if err = ctl.service.RemoveMember(ctx, curUser.ID, workspaceID, memberID); err != nil {
+		slog.ErrorContext(ctx, "Remove member failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Remove member failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Invite mails an invitation to the email, whether or not it is registered.
+func (ctl *Workspaces) Invite(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Email string `json:"email"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	token, err := ctl.secrets.generateToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "Create token failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Create token failed."))
+
+		return
+	}
+
+	invitation, err := ctl.service.Invite(ctx, curUser.ID, workspaceID, message.Email, token)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invite failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Invite failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+func (ctl *Workspaces) GetInvitations(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	invitations, err := ctl.service.GetInvitations(ctx, curUser.ID, workspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read invitations failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read invitations failed."))
+
+		return
+	}
+	if invitations == nil {
+		invitations = []domain.WorkspaceInvitation{}
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+func (ctl *Workspaces) RevokeInvitation(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	workspaceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse workspace id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse workspace id failed."))
+
+		return
+	}
+
+	invitationID, err := uuid.Parse(c.Param("invitation"))
+	if err != nil {
+		slog.ErrorContext(ctx, "Parse invitation id failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse invitation id failed."))
+
+		return
+	}
+
+	if err = ctl.service.RevokeInvitation(ctx, curUser.ID, workspaceID, invitationID); err != nil {
+		slog.ErrorContext(ctx, "Revoke invitation failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Revoke invitation failed."))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Accept joins the current user to the workspace of the mailed token.
+func (ctl *Workspaces) Accept(c *gin.Context) {
+	ctx, curUser := c.Request.Context(), getCurrentUser(c)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return
+	}
+
+	var message struct {
+		Token string `json:"token"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return
+	}
+
+	workspace, err := ctl.service.Accept(ctx, curUser.ID, message.Token)
+	if err != nil {
+		slog.ErrorContext(ctx, "Accept invitation failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Accept invitation failed."))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, workspace)
+}
+
+func (ctl *Workspaces) Close() error {
+	return ctl.service.Close()
+}
+
+// readWorkspaceName writes the error response itself when the body can't be
+// read.
+func readWorkspaceName(c *gin.Context) (string, bool) {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "Read request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Read body failed."))
+
+		return "", false
+	}
+
+	var message struct {
+		Name string `json:"name"`
+	}
+	if err = json.Unmarshal(body, &message); err != nil {
+		slog.ErrorContext(ctx, "Parse request body failed.", logger.ErrAttr(err))
+		c.JSON(http.StatusUnprocessableEntity, errorResponse("Parse body failed."))
+
+		return "", false
+	}
+
+	return message.Name, true
+}
@@ -0,0 +1,174 @@
+package controller_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"todo_list/internal/adapter/controller"
+	"todo_list/internal/domain"
+	mocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspacesSelect(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New())}
+	workspaceID := domain.WorkspaceID(uuid.New())
+	lists := []domain.List{{ID: domain.ListID(uuid.New()), WorkspaceID: workspaceID, Name: "Budget"}}
+
+	tests := []struct {
+		name         string
+		path         string
+		header       string
+		prepareMocks func(*mocks.MockWorkspaceInterface, *mocks.MockListInterface)
+		validation   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Personal",
+			path: "/list",
+			prepareMocks: func(workspaces *mocks.MockWorkspaceInterface, listsMock *mocks.MockListInterface) {
+				listsMock.EXPECT().GetAll(mock.Anything, user.ID, domain.PersonalWorkspace(user.ID)).Return(nil, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+			},
+		},
+		{
+			name:   "Header",
+			path:   "/list",
+			header: workspaceID.String(),
+			prepareMocks: func(workspaces *mocks.MockWorkspaceInterface, listsMock *mocks.MockListInterface) {
+				workspaces.EXPECT().Get(mock.Anything, user.ID, workspaceID).
+					Return(domain.Workspace{ID: workspaceID, Role: domain.WorkspaceRoleMember}, nil).
+					Once()
+				listsMock.EXPECT().GetAll(mock.Anything, user.ID, workspaceID).Return(lists, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				require.Contains(t, response.Body.String(), `"name":"Budget"`)
+			},
+		},
+		{
+			name: "Path segment",
+			path: "/w/" + workspaceID.String() + "/list",
+			// The path wins over the header.
+			header: uuid.NewString(),
+			prepareMocks: func(workspaces *mocks.MockWorkspaceInterface, listsMock *mocks.MockListInterface) {
+				workspaces.EXPECT().Get(mock.Anything, user.ID, workspaceID).
+					Return(domain.Workspace{ID: workspaceID, Role: domain.WorkspaceRoleOwner}, nil).
+					Once()
+				listsMock.EXPECT().GetAll(mock.Anything, user.ID, workspaceID).Return(lists, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+			},
+		},
+		{
+			name:   "Not a member",
+			path:   "/list",
+			header: workspaceID.String(),
+			prepareMocks: func(workspaces *mocks.MockWorkspaceInterface, listsMock *mocks.MockListInterface) {
+				workspaces.EXPECT().Get(mock.Anything, user.ID, workspaceID).
+					Return(domain.Workspace{}, errors.Join(domain.ErrWorkspaceServiceNotFound, sql.ErrNoRows)).
+					Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, response.Code)
+				require.Contains(t, response.Body.String(), "Workspace not found")
+			},
+		},
+		{
+			name:   "Invalid id",
+			path:   "/list",
+			header: "personal",
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			workspaces, listsMock := mocks.NewMockWorkspaceInterface(t), mocks.NewMockListInterface(t)
+			if test.prepareMocks != nil {
+				test.prepareMocks(workspaces, listsMock)
+			}
+
+			selectWorkspace := controller.NewWorkspaces(workspaces, secrets).Select
+			router, response := gin.New(), httptest.NewRecorder()
+			router.GET("/list", controller.WithUser(user), selectWorkspace, controller.NewLists(listsMock).GetUserListsAndTasks)
+			router.GET("/w/:workspace/list", controller.WithUser(user), selectWorkspace, controller.NewLists(listsMock).GetUserListsAndTasks)
+
+			request := httptest.NewRequest("GET", test.path, nil)
+			if test.header != "" {
+				request.Header.Set(controller.WorkspaceHeader, test.header)
+			}
+			router.ServeHTTP(response, request)
+
+			test.validation(t, response)
+		})
+	}
+}
+
+func TestWorkspacesAccept(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New())}
+	workspace := domain.Workspace{ID: domain.WorkspaceID(uuid.New()), Name: "Sales", Role: domain.WorkspaceRoleMember}
+
+	tests := []struct {
+		name         string
+		body         string
+		prepareMocks func(*mocks.MockWorkspaceInterface)
+		validation   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Success",
+			body: `{"token":"secret"}`,
+			prepareMocks: func(serviceMock *mocks.MockWorkspaceInterface) {
+				serviceMock.EXPECT().Accept(mock.Anything, user.ID, "secret").Return(workspace, nil).Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, response.Code)
+				require.Contains(t, response.Body.String(), `"name":"Sales"`)
+			},
+		},
+		{
+			name: "Invalid invitation",
+			body: `{"token":"secret"}`,
+			prepareMocks: func(serviceMock *mocks.MockWorkspaceInterface) {
+				serviceMock.EXPECT().Accept(mock.Anything, user.ID, "secret").
+					Return(domain.Workspace{}, domain.ErrWorkspaceServiceInvalidInvitation).
+					Once()
+			},
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+			},
+		},
+		{
+			name: "Invalid body",
+			body: `{`,
+			validation: func(t *testing.T, response *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serviceMock := mocks.NewMockWorkspaceInterface(t)
+			if test.prepareMocks != nil {
+				test.prepareMocks(serviceMock)
+			}
+
+			router, response := gin.New(), httptest.NewRecorder()
+			router.POST("/", controller.WithUser(user), controller.NewWorkspaces(serviceMock, secrets).Accept)
+			router.ServeHTTP(response, httptest.NewRequest("POST", "/", strings.NewReader(test.body)))
+
+			test.validation(t, response)
+		})
+	}
+}
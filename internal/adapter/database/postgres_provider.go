@@ -2,6 +2,9 @@ package database
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
 
 	"todo_list/internal/domain"
 
@@ -10,6 +13,10 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrProviderClosed is returned to callers still running once the provider
+// closed, such as work that outlived the shutdown drain.
+var ErrProviderClosed = errors.New("connection provider closed")
+
 var (
 	_ domain.ConnectionProvider = (*PostgresProvider)(nil)
 	_ domain.Connection         = (*PostgresConnection)(nil)
@@ -21,6 +28,8 @@ type (
 		pool        *pgxpool.Pool
 		connFactory func(*pgxpool.Conn) domain.Connection
 		txFactory   func(pgx.Tx) domain.Connection
+		closeOnce   sync.Once
+		closed      atomic.Bool
 	}
 
 	PostgresProviderOption func(*PostgresProvider)
@@ -34,11 +43,14 @@ type (
 	}
 )
 
+// Close closes the pool. Every service shares the provider, so only the
+// first call does. Later calls to Execute and ExecuteTx fail with
+// ErrProviderClosed.
 func (p *PostgresProvider) Close() error {
-	p.pool.Close()
-	p.pool = nil
-	p.connFactory = nil
-	p.txFactory = nil
+	p.closeOnce.Do(func() {
+		p.closed.Store(true)
+		p.pool.Close()
+	})
 
 	return nil
 }
@@ -99,7 +111,15 @@ func (p *PostgresProvider) ExecuteTx(ctx context.Context, receiver func(context.
 	})
 }
 
+// acquire sets app.user_id to the user the context acts on behalf of, and
+// app.bypass_rls for contexts acting on behalf of no one, for the row-level
+// security policies. Connections go back to the pool with them, so both are
+// set every time.
 func (p *PostgresProvider) acquire(ctx context.Context, f func(context.Context, *pgxpool.Conn) error) error {
+	if p.closed.Load() {
+		return ErrProviderClosed
+	}
+
 	ctx = context.WithoutCancel(ctx)
 	conn, err := p.pool.Acquire(ctx)
 	if err != nil {
@@ -107,6 +127,16 @@ func (p *PostgresProvider) acquire(ctx context.Context, f func(context.Context,
 	}
 	defer conn.Release()
 
+	var userID, bypass string
+	if id, ok := domain.AuthUser(ctx); ok {
+		userID = id.String()
+	} else if domain.IsWithoutAuthUser(ctx) {
+		bypass = "on"
+	}
+	if _, err = conn.Exec(ctx, "select set_config('app.user_id', $1, false), set_config('app.bypass_rls', $2, false)", userID, bypass); err != nil {
+		return err
+	}
+
 	return f(ctx, conn)
 }
 
@@ -0,0 +1,27 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"todo_list/internal/adapter/database"
+	"todo_list/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresProviderClosed(t *testing.T) {
+	// The pool connects lazily, so no database is needed.
+	pool, err := pgxpool.New(context.Background(), "postgres://localhost:1/todo_list")
+	require.NoError(t, err)
+	provider := database.NewPostgresProvider(pool)
+
+	require.NoError(t, provider.Close())
+	require.NoError(t, provider.Close())
+
+	err = provider.Execute(context.Background(), func(context.Context, domain.Connection) error { return nil })
+	require.ErrorIs(t, err, database.ErrProviderClosed)
+	err = provider.ExecuteTx(context.Background(), func(context.Context, domain.Connection) error { return nil })
+	require.ErrorIs(t, err, database.ErrProviderClosed)
+}
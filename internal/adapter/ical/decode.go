@@ -0,0 +1,121 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrDecode = errors.New("decode calendar failed")
+
+// Decode parses a single top level component, usually a VCALENDAR.
+func Decode(r io.Reader) (Component, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return Component{}, errors.Join(ErrDecode, err)
+	}
+
+	var stack []Component
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		property, err := parseLine(line)
+		if err != nil {
+			return Component{}, errors.Join(ErrDecode, err)
+		}
+
+		switch property.Name {
+		case "BEGIN":
+			stack = append(stack, Component{Name: strings.ToUpper(property.Value)})
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(property.Value) {
+				return Component{}, errors.Join(ErrDecode, fmt.Errorf("unexpected END:%s", property.Value))
+			}
+
+			done := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return done, nil
+			}
+			stack[len(stack)-1].Components = append(stack[len(stack)-1].Components, done)
+		default:
+			if len(stack) == 0 {
+				return Component{}, errors.Join(ErrDecode, fmt.Errorf("property %s outside of a component", property.Name))
+			}
+			stack[len(stack)-1].Properties = append(stack[len(stack)-1].Properties, property)
+		}
+	}
+
+	return Component{}, errors.Join(ErrDecode, errors.New("unexpected end of data"))
+}
+
+// unfold joins continuation lines, see RFC 5545, section 3.1.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+func parseLine(line string) (Property, error) {
+	var property Property
+
+	end := strings.IndexAny(line, ";:")
+	if end <= 0 {
+		return property, fmt.Errorf("malformed line %q", line)
+	}
+	property.Name = strings.ToUpper(line[:end])
+	line = line[end:]
+
+	for strings.HasPrefix(line, ";") {
+		line = line[1:]
+
+		eq := strings.IndexByte(line, '=')
+		if eq <= 0 {
+			return property, fmt.Errorf("malformed parameter of %s", property.Name)
+		}
+		name := strings.ToUpper(line[:eq])
+		line = line[eq+1:]
+
+		var value string
+		if strings.HasPrefix(line, `"`) {
+			quote := strings.IndexByte(line[1:], '"')
+			if quote < 0 {
+				return property, fmt.Errorf("unterminated quote in parameter %s", name)
+			}
+			value, line = line[1:quote+1], line[quote+2:]
+		} else {
+			stop := strings.IndexAny(line, ";:")
+			if stop < 0 {
+				return property, fmt.Errorf("malformed parameter %s", name)
+			}
+			value, line = line[:stop], line[stop:]
+		}
+
+		if property.Params == nil {
+			property.Params = make(map[string]string)
+		}
+		property.Params[name] = value
+	}
+
+	if !strings.HasPrefix(line, ":") {
+		return property, fmt.Errorf("missing value of %s", property.Name)
+	}
+	property.Value = line[1:]
+
+	return property, nil
+}
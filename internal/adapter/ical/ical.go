@@ -0,0 +1,203 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateTimeLayout      = "20060102T150405Z"
+	localDateTimeLayout = "20060102T150405"
+	dateLayout          = "20060102"
+
+	// maxLineOctets is the content line limit from RFC 5545, section 3.1.
+	maxLineOctets = 75
+)
+
+type (
+	Component struct {
+		Name       string
+		Properties []Property
+		Components []Component
+	}
+
+	// Property value is written as is, use TextProperty for free-form text.
+	Property struct {
+		Name   string
+		Params map[string]string
+		Value  string
+	}
+)
+
+func NewCalendar(name string) Component {
+	return Component{
+		Name: "VCALENDAR",
+		Properties: []Property{
+			{Name: "VERSION", Value: "2.0"},
+			{Name: "PRODID", Value: "-//todo_list//EN"},
+			{Name: "CALSCALE", Value: "GREGORIAN"},
+			TextProperty("X-WR-CALNAME", name),
+		},
+	}
+}
+
+func TextProperty(name, value string) Property {
+	return Property{Name: name, Value: EscapeText(value)}
+}
+
+// TextListProperty holds several text values, such as CATEGORIES.
+func TextListProperty(name string, values []string) Property {
+	escaped := make([]string, len(values))
+	for i, value := range values {
+		escaped[i] = EscapeText(value)
+	}
+
+	return Property{Name: name, Value: strings.Join(escaped, ",")}
+}
+
+func DateTimeProperty(name string, t time.Time) Property {
+	return Property{Name: name, Value: t.UTC().Format(dateTimeLayout)}
+}
+
+// DateProperty keeps only the date, as written in t's own location.
+func DateProperty(name string, t time.Time) Property {
+	return Property{Name: name, Params: map[string]string{"VALUE": "DATE"}, Value: t.Format(dateLayout)}
+}
+
+// Property returns the first property with the given name.
+func (c Component) Property(name string) (Property, bool) {
+	for _, p := range c.Properties {
+		if p.Name == name {
+			return p, true
+		}
+	}
+
+	return Property{}, false
+}
+
+// Component returns the first child component with the given name.
+func (c Component) Component(name string) (Component, bool) {
+	for _, child := range c.Components {
+		if child.Name == name {
+			return child, true
+		}
+	}
+
+	return Component{}, false
+}
+
+func Encode(w io.Writer, c Component) error {
+	writer := bufio.NewWriter(w)
+	if err := encodeComponent(writer, c); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+func encodeComponent(w *bufio.Writer, c Component) error {
+	if err := writeLine(w, "BEGIN:"+c.Name); err != nil {
+		return err
+	}
+
+	for _, p := range c.Properties {
+		if err := writeLine(w, encodeProperty(p)); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range c.Components {
+		if err := encodeComponent(w, child); err != nil {
+			return err
+		}
+	}
+
+	return writeLine(w, "END:"+c.Name)
+}
+
+func encodeProperty(p Property) string {
+	var line strings.Builder
+	line.WriteString(p.Name)
+
+	names := make([]string, 0, len(p.Params))
+	for name := range p.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := p.Params[name]
+		if strings.ContainsAny(value, ";:,") {
+			value = `"` + value + `"`
+		}
+		line.WriteString(";" + name + "=" + value)
+	}
+
+	line.WriteString(":" + p.Value)
+
+	return line.String()
+}
+
+// writeLine folds the line at maxLineOctets without splitting UTF-8 sequences.
+func writeLine(w *bufio.Writer, line string) error {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		if _, err := w.WriteString(line[:cut] + "\r\n "); err != nil {
+			return err
+		}
+		line = line[cut:]
+		// Continuation lines start with a space that counts against the limit.
+		limit = maxLineOctets - 1
+	}
+
+	_, err := w.WriteString(line + "\r\n")
+
+	return err
+}
+
+func EscapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
+
+func UnescapeText(value string) string {
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	).Replace(value)
+}
+
+// SplitTextList is the inverse of TextListProperty, splitting on unescaped
+// commas only.
+func SplitTextList(value string) []string {
+	var values []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, UnescapeText(value[start:i]))
+			start = i + 1
+		}
+	}
+
+	return append(values, UnescapeText(value[start:]))
+}
@@ -0,0 +1,213 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/ical"
+	"todo_list/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name      string
+		component ical.Component
+		expected  string
+	}{
+		{
+			name: "Escaped text",
+			component: ical.Component{
+				Name:       "VTODO",
+				Properties: []ical.Property{ical.TextProperty("SUMMARY", "Buy milk, bread; eggs\\ham\nsoon")},
+			},
+			expected: "BEGIN:VTODO\r\n" + `SUMMARY:Buy milk\, bread\; eggs\\ham\nsoon` + "\r\nEND:VTODO\r\n",
+		},
+		{
+			name: "Parameters",
+			component: ical.Component{
+				Name: "VEVENT",
+				Properties: []ical.Property{{
+					Name:   "ATTENDEE",
+					Params: map[string]string{"ROLE": "CHAIR", "CN": "Doe: John"},
+					Value:  "mailto:john@doe.foo",
+				}},
+			},
+			expected: "BEGIN:VEVENT\r\nATTENDEE;CN=\"Doe: John\";ROLE=CHAIR:mailto:john@doe.foo\r\nEND:VEVENT\r\n",
+		},
+		{
+			name: "Folded multibyte line",
+			component: ical.Component{
+				Name:       "VTODO",
+				Properties: []ical.Property{ical.TextProperty("SUMMARY", strings.Repeat("ж", 40))},
+			},
+			expected: "BEGIN:VTODO\r\n" +
+				"SUMMARY:" + strings.Repeat("ж", 33) + "\r\n" +
+				" " + strings.Repeat("ж", 7) + "\r\n" +
+				"END:VTODO\r\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var out strings.Builder
+			require.NoError(t, ical.Encode(&out, test.component))
+			require.Equal(t, test.expected, out.String())
+
+			for _, line := range strings.Split(out.String(), "\r\n") {
+				require.LessOrEqual(t, len(line), 75)
+			}
+		})
+	}
+}
+
+func TestTasks(t *testing.T) {
+	deadline := time.Date(2025, 3, 1, 9, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	task := domain.Task{
+		ID:        domain.TaskID(uuid.MustParse("5b3d7c1e-2f4a-4b6c-8d9e-0a1b2c3d4e5f")),
+		Priority:  domain.High,
+		Deadline:  &deadline,
+		Done:      true,
+		Name:      "Pay rent",
+		UpdatedAT: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var out strings.Builder
+	require.NoError(t, ical.Encode(&out, ical.Todo(task)))
+	require.Equal(t, "BEGIN:VTODO\r\n"+
+		"UID:5b3d7c1e-2f4a-4b6c-8d9e-0a1b2c3d4e5f\r\n"+
+		"DTSTAMP:20250201T000000Z\r\n"+
+		"LAST-MODIFIED:20250201T000000Z\r\n"+
+		"SUMMARY:Pay rent\r\n"+
+		"PRIORITY:1\r\n"+
+		"DUE:20250301T063000Z\r\n"+
+		"STATUS:COMPLETED\r\n"+
+		"END:VTODO\r\n", out.String())
+
+	event, ok := ical.Event(task)
+	require.True(t, ok)
+	start, ok := event.Property("DTSTART")
+	require.True(t, ok)
+	require.Equal(t, "20250301T063000Z", start.Value)
+
+	task.Deadline = nil
+	_, ok = ical.Event(task)
+	require.False(t, ok)
+}
+
+func TestDecode(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VTIMEZONE\r\n" +
+		"TZID:Europe/Moscow\r\n" +
+		"END:VTIMEZONE\r\n" +
+		"BEGIN:VTODO\r\n" +
+		"UID:some-client-uid\r\n" +
+		"SUMMARY:Call Bob\\, then\r\n" +
+		"  Alice\r\n" +
+		"PRIORITY:3\r\n" +
+		"DUE;TZID=\"Europe/Moscow\":20250301T093000\r\n" +
+		"STATUS:NEEDS-ACTION\r\n" +
+		"END:VTODO\r\n" +
+		"END:VCALENDAR\r\n"
+
+	calendar, err := ical.Decode(strings.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, "VCALENDAR", calendar.Name)
+	require.Len(t, calendar.Components, 2)
+
+	todo, ok := calendar.Component("VTODO")
+	require.True(t, ok)
+
+	task, err := ical.FromTodo(todo)
+	require.NoError(t, err)
+	require.Equal(t, "Call Bob, then Alice", task.Name)
+	require.Equal(t, "some-client-uid", task.ICalUID)
+	require.Equal(t, domain.High, task.Priority)
+	require.False(t, task.Done)
+	require.NotNil(t, task.Deadline)
+	require.True(t, time.Date(2025, 3, 1, 6, 30, 0, 0, time.UTC).Equal(*task.Deadline))
+}
+
+func TestDecodeFailed(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Unbalanced", data: "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nEND:VCALENDAR\r\n"},
+		{name: "Truncated", data: "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"},
+		{name: "Malformed line", data: "BEGIN:VCALENDAR\r\nVERSION\r\nEND:VCALENDAR\r\n"},
+		{name: "Unterminated quote", data: "BEGIN:VCALENDAR\r\nX;A=\"b:c\r\nEND:VCALENDAR\r\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ical.Decode(strings.NewReader(test.data))
+			require.ErrorIs(t, err, ical.ErrDecode)
+		})
+	}
+}
+
+func TestTodoRoundTrip(t *testing.T) {
+	deadline := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	task := domain.Task{
+		ID:         domain.TaskID(uuid.New()),
+		Priority:   domain.Low,
+		Deadline:   &deadline,
+		Done:       true,
+		Name:       "Multi\nline; with, specials\\",
+		Tags:       []string{"home", "a, b"},
+		Recurrence: "FREQ=WEEKLY;BYDAY=MO",
+	}
+
+	calendar := ical.NewCalendar("Tasks")
+	calendar.Components = append(calendar.Components, ical.Todo(task))
+
+	var out strings.Builder
+	require.NoError(t, ical.Encode(&out, calendar))
+
+	decoded, err := ical.Decode(strings.NewReader(out.String()))
+	require.NoError(t, err)
+	todo, ok := decoded.Component("VTODO")
+	require.True(t, ok)
+
+	parsed, err := ical.FromTodo(todo)
+	require.NoError(t, err)
+	require.Equal(t, task.Name, parsed.Name)
+	require.Equal(t, task.Priority, parsed.Priority)
+	require.Equal(t, task.Done, parsed.Done)
+	require.Equal(t, task.Tags, parsed.Tags)
+	require.Equal(t, task.Recurrence, parsed.Recurrence)
+	require.True(t, deadline.Equal(*parsed.Deadline))
+	require.Equal(t, task.ID.String(), parsed.ICalUID)
+
+	task.ICalUID = "some-client-uid"
+	uid, ok := ical.Todo(task).Property("UID")
+	require.True(t, ok)
+	require.Equal(t, task.ICalUID, uid.Value)
+}
+
+func TestAllDayTask(t *testing.T) {
+	deadline := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	task := domain.Task{ID: domain.TaskID(uuid.New()), Deadline: &deadline, AllDay: true, Name: "Birthday"}
+
+	due, ok := ical.Todo(task).Property("DUE")
+	require.True(t, ok)
+	require.Equal(t, "DATE", due.Params["VALUE"])
+	require.Equal(t, "20250301", due.Value)
+
+	event, ok := ical.Event(task)
+	require.True(t, ok)
+	start, ok := event.Property("DTSTART")
+	require.True(t, ok)
+	require.Equal(t, "20250301", start.Value)
+	end, ok := event.Property("DTEND")
+	require.True(t, ok)
+	require.Equal(t, "20250302", end.Value)
+
+	parsed, err := ical.FromTodo(ical.Todo(task))
+	require.NoError(t, err)
+	require.True(t, parsed.AllDay)
+	require.True(t, deadline.Equal(*parsed.Deadline))
+}
@@ -0,0 +1,210 @@
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+// Priorities follow RFC 5545: 1 is the highest, 9 is the lowest.
+var priorities = map[domain.Priority]string{
+	domain.High:   "1",
+	domain.Normal: "5",
+	domain.Low:    "9",
+}
+
+func Todo(task domain.Task) Component {
+	todo := Component{
+		Name: "VTODO",
+		Properties: []Property{
+			{Name: "UID", Value: uid(task)},
+			DateTimeProperty("DTSTAMP", stamp(task)),
+			DateTimeProperty("LAST-MODIFIED", stamp(task)),
+			TextProperty("SUMMARY", task.Name),
+		},
+	}
+
+	if priority, ok := priorities[task.Priority]; ok {
+		todo.Properties = append(todo.Properties, Property{Name: "PRIORITY", Value: priority})
+	}
+	if task.Deadline != nil {
+		todo.Properties = append(todo.Properties, deadlineProperty("DUE", task))
+	}
+	if task.Recurrence != "" {
+		todo.Properties = append(todo.Properties, Property{Name: "RRULE", Value: task.Recurrence})
+	}
+	if len(task.Tags) > 0 {
+		todo.Properties = append(todo.Properties, TextListProperty("CATEGORIES", task.Tags))
+	}
+	if task.Done {
+		todo.Properties = append(todo.Properties, Property{Name: "STATUS", Value: "COMPLETED"})
+		if task.CompletedAt != nil {
+			todo.Properties = append(todo.Properties, DateTimeProperty("COMPLETED", *task.CompletedAt))
+		}
+	} else {
+		todo.Properties = append(todo.Properties, Property{Name: "STATUS", Value: "NEEDS-ACTION"})
+	}
+
+	return todo
+}
+
+// Event renders a task as an instant event at its deadline, or as an all-day
+// event, for clients that ignore VTODO. Tasks without a deadline have nothing
+// to show.
+func Event(task domain.Task) (Component, bool) {
+	if task.Deadline == nil {
+		return Component{}, false
+	}
+
+	event := Component{
+		Name: "VEVENT",
+		Properties: []Property{
+			{Name: "UID", Value: task.ID.String()},
+			DateTimeProperty("DTSTAMP", stamp(task)),
+			DateTimeProperty("LAST-MODIFIED", stamp(task)),
+			deadlineProperty("DTSTART", task),
+			TextProperty("SUMMARY", task.Name),
+			{Name: "TRANSP", Value: "TRANSPARENT"},
+		},
+	}
+	if task.AllDay {
+		event.Properties = append(event.Properties, DateProperty("DTEND", task.Deadline.AddDate(0, 0, 1)))
+	}
+
+	if priority, ok := priorities[task.Priority]; ok {
+		event.Properties = append(event.Properties, Property{Name: "PRIORITY", Value: priority})
+	}
+
+	return event, true
+}
+
+func deadlineProperty(name string, task domain.Task) Property {
+	if task.AllDay {
+		return DateProperty(name, *task.Deadline)
+	}
+
+	return DateTimeProperty(name, *task.Deadline)
+}
+
+// uid is the UID a CalDAV client created the task with, which it must get
+// back, or else the ID.
+func uid(task domain.Task) string {
+	if task.ICalUID != "" {
+		return task.ICalUID
+	}
+
+	return task.ID.String()
+}
+
+func stamp(task domain.Task) time.Time {
+	if task.UpdatedAT.IsZero() {
+		return time.Now()
+	}
+
+	return task.UpdatedAT
+}
+
+// FromTodo maps a VTODO back to a task, its UID to ICalUID. ID and ListID are
+// left for the caller, as they come from the resource location rather than
+// from the calendar data.
+func FromTodo(todo Component) (domain.Task, error) {
+	if todo.Name != "VTODO" {
+		return domain.Task{}, fmt.Errorf("unexpected component %s", todo.Name)
+	}
+
+	task := domain.Task{Priority: domain.Normal}
+
+	if uid, ok := todo.Property("UID"); ok {
+		task.ICalUID = uid.Value
+	}
+
+	if summary, ok := todo.Property("SUMMARY"); ok {
+		task.Name = UnescapeText(summary.Value)
+	}
+
+	if priority, ok := todo.Property("PRIORITY"); ok {
+		value, err := strconv.Atoi(priority.Value)
+		if err != nil || value < 0 || value > 9 {
+			return domain.Task{}, fmt.Errorf("invalid priority %q", priority.Value)
+		}
+		switch {
+		case value >= 1 && value <= 4:
+			task.Priority = domain.High
+		case value >= 6:
+			task.Priority = domain.Low
+		}
+	}
+
+	if due, ok := todo.Property("DUE"); ok {
+		deadline, err := parseTime(due)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		task.Deadline = &deadline
+		task.AllDay = isDate(due)
+	}
+
+	if rule, ok := todo.Property("RRULE"); ok {
+		task.Recurrence = rule.Value
+	}
+
+	// Clients drop the property once the last category is removed.
+	task.Tags = []string{}
+	for _, p := range todo.Properties {
+		if p.Name == "CATEGORIES" {
+			task.Tags = append(task.Tags, SplitTextList(p.Value)...)
+		}
+	}
+
+	if status, ok := todo.Property("STATUS"); ok && strings.EqualFold(status.Value, "COMPLETED") {
+		task.Done = true
+	} else if _, ok := todo.Property("COMPLETED"); ok {
+		task.Done = true
+	}
+
+	return task, nil
+}
+
+func isDate(p Property) bool {
+	return p.Params["VALUE"] == "DATE" || len(p.Value) == len(dateLayout)
+}
+
+// parseTime accepts UTC, zoned and floating date-times and dates. Floating
+// values and unknown zones are read as UTC.
+func parseTime(p Property) (time.Time, error) {
+	if isDate(p) {
+		t, err := time.Parse(dateLayout, p.Value)
+		if err != nil {
+			return t, errors.Join(fmt.Errorf("invalid date %s", p.Name), err)
+		}
+
+		return t, nil
+	}
+
+	if strings.HasSuffix(p.Value, "Z") {
+		t, err := time.Parse(dateTimeLayout, p.Value)
+		if err != nil {
+			return t, errors.Join(fmt.Errorf("invalid date-time %s", p.Name), err)
+		}
+
+		return t, nil
+	}
+
+	location := time.UTC
+	if tzid, ok := p.Params["TZID"]; ok {
+		if loaded, err := time.LoadLocation(tzid); err == nil {
+			location = loaded
+		}
+	}
+
+	t, err := time.ParseInLocation(localDateTimeLayout, p.Value, location)
+	if err != nil {
+		return t, errors.Join(fmt.Errorf("invalid date-time %s", p.Name), err)
+	}
+
+	return t, nil
+}
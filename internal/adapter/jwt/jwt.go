@@ -0,0 +1,251 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Algorithms the key ring signs with.
+const (
+	EdDSA = "EdDSA"
+	ES256 = "ES256"
+)
+
+type (
+	header struct {
+		Algorithm string `json:"alg"`
+		Type      string `json:"typ"`
+		KeyID     string `json:"kid"`
+	}
+
+	// claims carry what the handlers need to know about the user, so
+	// requests are served without reading the user.
+	claims struct {
+		Subject    string `json:"sub"`
+		ID         string `json:"jti"`
+		IssuedAt   int64  `json:"iat"`
+		Expiry     int64  `json:"exp"`
+		Name       string `json:"name"`
+		Email      string `json:"email"`
+		TimeZone   string `json:"zoneinfo"`
+		Locale     string `json:"locale"`
+		VerifiedAt *int64 `json:"verified_at,omitempty"`
+		Role       string `json:"role,omitempty"`
+	}
+
+	jsonWebKey struct {
+		KeyType   string `json:"kty"`
+		KeyID     string `json:"kid"`
+		Use       string `json:"use"`
+		Algorithm string `json:"alg"`
+		Curve     string `json:"crv"`
+		X         string `json:"x"`
+		Y         string `json:"y,omitempty"`
+	}
+)
+
+func newClaims(user domain.User, issuedAt time.Time, ttl time.Duration) claims {
+	c := claims{
+		Subject:  user.ID.String(),
+		ID:       uuid.NewString(),
+		IssuedAt: issuedAt.Unix(),
+		Expiry:   issuedAt.Add(ttl).Unix(),
+		Name:     user.Name,
+		Email:    user.Email,
+		TimeZone: user.TimeZone,
+		Locale:   user.Locale,
+		Role:     user.Role,
+	}
+	if user.VerifiedAt != nil {
+		verifiedAt := user.VerifiedAt.Unix()
+		c.VerifiedAt = &verifiedAt
+	}
+
+	return c
+}
+
+func (c claims) user() (domain.User, error) {
+	userID, err := uuid.Parse(c.Subject)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("subject: %w", err)
+	}
+
+	user := domain.User{
+		ID:       userID,
+		Name:     c.Name,
+		Email:    c.Email,
+		TimeZone: c.TimeZone,
+		Locale:   c.Locale,
+		Role:     c.Role,
+	}
+	if c.VerifiedAt != nil {
+		verifiedAt := time.Unix(*c.VerifiedAt, 0)
+		user.VerifiedAt = &verifiedAt
+	}
+
+	return user, nil
+}
+
+// generateKey returns a new private key for the algorithm.
+func generateKey(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case EdDSA:
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+
+		return private, err
+	case ES256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+}
+
+func sign(algorithm string, private crypto.Signer, signingInput string) ([]byte, error) {
+	switch key := private.(type) {
+	case ed25519.PrivateKey:
+		if algorithm != EdDSA {
+			break
+		}
+
+		return ed25519.Sign(key, []byte(signingInput)), nil
+	case *ecdsa.PrivateKey:
+		if algorithm != ES256 {
+			break
+		}
+
+		digest := sha256.Sum256([]byte(signingInput))
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			return nil, err
+		}
+
+		signature := make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+
+		return signature, nil
+	}
+
+	return nil, fmt.Errorf("%T key for %s", private, algorithm)
+}
+
+func verify(algorithm string, public crypto.PublicKey, signingInput string, signature []byte) error {
+	switch key := public.(type) {
+	case ed25519.PublicKey:
+		if algorithm != EdDSA {
+			break
+		}
+		if !ed25519.Verify(key, []byte(signingInput), signature) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	case *ecdsa.PublicKey:
+		if algorithm != ES256 {
+			break
+		}
+		if len(signature) != 64 {
+			return errors.New("invalid signature")
+		}
+
+		digest := sha256.Sum256([]byte(signingInput))
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(key, digest[:], r, s) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%s token for a %T key", algorithm, public)
+}
+
+// encode returns the compact serialization of the signed claims.
+func encode(k *signingKey, c claims) (string, error) {
+	headerJSON, err := json.Marshal(header{Algorithm: k.algorithm, Type: "JWT", KeyID: k.id})
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	signature, err := sign(k.algorithm, k.private, signingInput)
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// decode splits the token and decodes its header, leaving the signature
+// check to the caller, who looks up the key by the header.
+func decode(token string) (header, string, []byte, []byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return header{}, "", nil, nil, errors.New("malformed token")
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return header{}, "", nil, nil, fmt.Errorf("header: %w", err)
+	}
+	var h header
+	if err = json.Unmarshal(headerJSON, &h); err != nil {
+		return header{}, "", nil, nil, fmt.Errorf("header: %w", err)
+	}
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return header{}, "", nil, nil, fmt.Errorf("claims: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return header{}, "", nil, nil, fmt.Errorf("signature: %w", err)
+	}
+
+	return h, parts[0] + "." + parts[1], claimsJSON, signature, nil
+}
+
+// publicJWK returns the public part of the key as a JSON Web Key.
+func publicJWK(k *signingKey) (jsonWebKey, error) {
+	jwk := jsonWebKey{KeyID: k.id, Use: "sig", Algorithm: k.algorithm}
+	switch public := k.private.Public().(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType, jwk.Curve = "OKP", "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	case *ecdsa.PublicKey:
+		ecdhKey, err := public.ECDH()
+		if err != nil {
+			return jsonWebKey{}, err
+		}
+		point := ecdhKey.Bytes()
+		if len(point) != 65 {
+			return jsonWebKey{}, errors.New("unexpected point size")
+		}
+		// The uncompressed point is 0x04 followed by X and Y.
+		jwk.KeyType, jwk.Curve = "EC", "P-256"
+		jwk.X = base64.RawURLEncoding.EncodeToString(point[1:33])
+		jwk.Y = base64.RawURLEncoding.EncodeToString(point[33:])
+	default:
+		return jsonWebKey{}, fmt.Errorf("unsupported key %T", public)
+	}
+
+	return jwk, nil
+}
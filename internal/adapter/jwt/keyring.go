@@ -0,0 +1,430 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var _ domain.JWTKeyRing = (*KeyRing)(nil)
+
+var (
+	errJWT             = errors.New("jwt key ring error")
+	ErrJWTIssue        = errors.Join(errJWT, errors.New("issue failed"))
+	ErrJWTVerify       = errors.Join(errJWT, errors.New("verify failed"))
+	ErrJWTRevoke       = errors.Join(errJWT, errors.New("revoke failed"))
+	ErrJWTJWKS         = errors.Join(errJWT, errors.New("jwks failed"))
+	ErrJWTSync         = errors.Join(errJWT, errors.New("sync failed"))
+	ErrJWTRotate       = errors.Join(errJWT, errors.New("rotate failed"))
+	ErrJWTInvalidToken = errors.Join(ErrJWTVerify, errors.New("invalid token"))
+)
+
+// syncInterval keeps tokens with unknown key IDs from making Verify read
+// the keys over and over.
+const syncInterval = 10 * time.Second
+
+type (
+	signingKey struct {
+		id        string
+		algorithm string
+		private   crypto.Signer
+		createdAt time.Time
+	}
+
+	keyRow struct {
+		ID        string
+		Algorithm string
+		SealedKey []byte
+		CreatedAt time.Time
+	}
+
+	revokedRow struct {
+		JTI       string
+		ExpiresAt time.Time
+	}
+
+	revokedUserRow struct {
+		UserID    domain.UserID
+		RevokedAt time.Time
+	}
+)
+
+// KeyRing keeps its keys in Postgres so every instance signs and verifies
+// with the same ones. The newest key signs, the older ones verify the
+// tokens they signed until those expire. Private keys are sealed with a
+// secret of the deployment, a database dump alone can't forge tokens.
+//
+// Revoked tokens and users are denied from memory. Sync reads the keys and
+// the denylists again, so a token revoked on one instance is denied by the
+// others once they synced.
+type KeyRing struct {
+	provider  domain.ConnectionProvider
+	algorithm string
+	seal      cipher.AEAD
+	ttl       time.Duration
+	rotation  time.Duration
+	now       func() time.Time
+
+	mu      sync.RWMutex
+	keys    map[string]*signingKey
+	current *signingKey
+	denied  map[string]time.Time
+	// deniedUsers holds when the users were revoked, their tokens issued
+	// until then are denied.
+	deniedUsers map[domain.UserID]time.Time
+	syncedAt    time.Time
+}
+
+// NewKeyRing signs tokens valid for ttl with keys of the algorithm, a new
+// one every rotation. It has no keys until Sync and Rotate.
+func NewKeyRing(provider domain.ConnectionProvider, algorithm, secret string, ttl, rotation time.Duration) (*KeyRing, error) {
+	if algorithm != EdDSA && algorithm != ES256 {
+		return nil, errors.Join(errJWT, fmt.Errorf("unsupported algorithm %q", algorithm))
+	}
+	if secret == "" {
+		return nil, errors.Join(errJWT, errors.New("empty key secret"))
+	}
+	if ttl <= 0 || rotation <= 0 {
+		return nil, errors.Join(errJWT, errors.New("ttl and rotation must be positive"))
+	}
+
+	sealKey := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(sealKey[:])
+	if err != nil {
+		return nil, errors.Join(errJWT, err)
+	}
+	seal, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Join(errJWT, err)
+	}
+
+	return &KeyRing{
+		provider:    provider,
+		algorithm:   algorithm,
+		seal:        seal,
+		ttl:         ttl,
+		rotation:    rotation,
+		now:         time.Now,
+		keys:        map[string]*signingKey{},
+		denied:      map[string]time.Time{},
+		deniedUsers: map[domain.UserID]time.Time{},
+	}, nil
+}
+
+// Issue implements domain.JWTKeyRing.
+func (r *KeyRing) Issue(_ context.Context, user domain.User) (string, time.Time, error) {
+	r.mu.RLock()
+	current := r.current
+	r.mu.RUnlock()
+	if current == nil {
+		return "", time.Time{}, errors.Join(ErrJWTIssue, errors.New("no signing key"))
+	}
+
+	c := newClaims(user, r.now(), r.ttl)
+	token, err := encode(current, c)
+	if err != nil {
+		return "", time.Time{}, errors.Join(ErrJWTIssue, err)
+	}
+
+	return token, time.Unix(c.Expiry, 0), nil
+}
+
+// Verify implements domain.JWTKeyRing. A key ID that isn't known yet makes
+// it sync first, the key may be a new one of another instance.
+func (r *KeyRing) Verify(ctx context.Context, token string) (domain.User, error) {
+	c, err := r.verify(ctx, token)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	user, err := c.user()
+	if err != nil {
+		return domain.User{}, errors.Join(ErrJWTInvalidToken, err)
+	}
+
+	r.mu.RLock()
+	_, denied := r.denied[c.ID]
+	revokedAt, userDenied := r.deniedUsers[user.ID]
+	r.mu.RUnlock()
+	if denied || userDenied && c.IssuedAt <= revokedAt.Unix() {
+		return domain.User{}, errors.Join(ErrJWTInvalidToken, errors.New("token revoked"))
+	}
+
+	return user, nil
+}
+
+// Revoke implements domain.JWTKeyRing.
+func (r *KeyRing) Revoke(ctx context.Context, token string) error {
+	c, err := r.verify(ctx, token)
+	if err != nil {
+		return errors.Join(ErrJWTRevoke, err)
+	}
+	expiresAt := time.Unix(c.Expiry, 0)
+
+	err = r.provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+		const query = `insert into revoked_tokens (jti, expires_at) values ($1, $2) on conflict (jti) do nothing`
+		_, err := connection.ExecContext(ctx, query, c.ID, expiresAt)
+
+		return err
+	})
+	if err != nil {
+		return errors.Join(ErrJWTRevoke, err)
+	}
+
+	r.mu.Lock()
+	r.denied[c.ID] = expiresAt
+	r.mu.Unlock()
+
+	return nil
+}
+
+// RevokeUser implements domain.JWTKeyRing. The user is denied until the
+// tokens issued so far have expired.
+func (r *KeyRing) RevokeUser(ctx context.Context, userID domain.UserID) error {
+	revokedAt := r.now()
+
+	err := r.provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+		const query = `insert into revoked_users (user_id, revoked_at, expires_at) values ($1, $2, $3)
+on conflict (user_id) do update set revoked_at = excluded.revoked_at, expires_at = excluded.expires_at`
+		_, err := connection.ExecContext(ctx, query, userID, revokedAt, revokedAt.Add(r.ttl))
+
+		return err
+	})
+	if err != nil {
+		return errors.Join(ErrJWTRevoke, err)
+	}
+
+	r.mu.Lock()
+	r.deniedUsers[userID] = revokedAt
+	r.mu.Unlock()
+
+	return nil
+}
+
+// JWKS implements domain.JWTKeyRing.
+func (r *KeyRing) JWKS() ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	set := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{Keys: []jsonWebKey{}}
+	for _, k := range r.keys {
+		jwk, err := publicJWK(k)
+		if err != nil {
+			return nil, errors.Join(ErrJWTJWKS, err)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		return nil, errors.Join(ErrJWTJWKS, err)
+	}
+
+	return data, nil
+}
+
+// Sync reads the unexpired keys, revoked tokens and revoked users, and
+// removes the expired ones.
+func (r *KeyRing) Sync(ctx context.Context) error {
+	var keyRows []keyRow
+	var revokedRows []revokedRow
+	var revokedUserRows []revokedUserRow
+	err := r.provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+		if _, err := connection.ExecContext(ctx, `delete from signing_keys where expires_at < now()`); err != nil {
+			return err
+		}
+		if _, err := connection.ExecContext(ctx, `delete from revoked_tokens where expires_at < now()`); err != nil {
+			return err
+		}
+		if _, err := connection.ExecContext(ctx, `delete from revoked_users where expires_at < now()`); err != nil {
+			return err
+		}
+
+		const keys = `select id, algorithm, sealed_key, created_at from signing_keys order by created_at`
+		if err := connection.SelectContext(ctx, &keyRows, keys); err != nil {
+			return err
+		}
+
+		if err := connection.SelectContext(ctx, &revokedRows, `select jti, expires_at from revoked_tokens`); err != nil {
+			return err
+		}
+
+		return connection.SelectContext(ctx, &revokedUserRows, `select user_id, revoked_at from revoked_users`)
+	})
+	if err != nil {
+		return errors.Join(ErrJWTSync, err)
+	}
+
+	keys, denied := make(map[string]*signingKey, len(keyRows)), make(map[string]time.Time, len(revokedRows))
+	var current *signingKey
+	for _, row := range keyRows {
+		private, err := r.unseal(row.ID, row.SealedKey)
+		if err != nil {
+			return errors.Join(ErrJWTSync, fmt.Errorf("key %s: %w", row.ID, err))
+		}
+
+		current = &signingKey{id: row.ID, algorithm: row.Algorithm, private: private, createdAt: row.CreatedAt}
+		keys[row.ID] = current
+	}
+	for _, row := range revokedRows {
+		denied[row.JTI] = row.ExpiresAt
+	}
+	deniedUsers := make(map[domain.UserID]time.Time, len(revokedUserRows))
+	for _, row := range revokedUserRows {
+		deniedUsers[row.UserID] = row.RevokedAt
+	}
+
+	r.mu.Lock()
+	r.keys, r.current, r.denied, r.deniedUsers, r.syncedAt = keys, current, denied, deniedUsers, r.now()
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Rotate adds a new signing key when the current one is older than the
+// rotation or there is none. Keys are kept until the last token they
+// signed expires.
+func (r *KeyRing) Rotate(ctx context.Context) error {
+	r.mu.RLock()
+	current := r.current
+	r.mu.RUnlock()
+	now := r.now()
+	if current != nil && now.Sub(current.createdAt) < r.rotation {
+		return nil
+	}
+
+	private, err := generateKey(r.algorithm)
+	if err != nil {
+		return errors.Join(ErrJWTRotate, err)
+	}
+	k := &signingKey{id: uuid.NewString(), algorithm: r.algorithm, private: private, createdAt: now}
+	sealed, err := r.sealKey(k.id, private)
+	if err != nil {
+		return errors.Join(ErrJWTRotate, err)
+	}
+
+	err = r.provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+		const query = `insert into signing_keys (id, algorithm, sealed_key, created_at, expires_at) values ($1, $2, $3, $4, $5)`
+		_, err := connection.ExecContext(ctx, query, k.id, k.algorithm, sealed, now, now.Add(r.rotation+r.ttl))
+
+		return err
+	})
+	if err != nil {
+		return errors.Join(ErrJWTRotate, err)
+	}
+
+	r.mu.Lock()
+	r.keys[k.id], r.current = k, k
+	r.mu.Unlock()
+
+	return nil
+}
+
+// verify checks the signature and the expiry, not the denylist.
+func (r *KeyRing) verify(ctx context.Context, token string) (claims, error) {
+	h, signingInput, claimsJSON, signature, err := decode(token)
+	if err != nil {
+		return claims{}, errors.Join(ErrJWTInvalidToken, err)
+	}
+
+	k, err := r.key(ctx, h.KeyID)
+	if err != nil {
+		return claims{}, errors.Join(ErrJWTInvalidToken, err)
+	}
+	if h.Algorithm != k.algorithm {
+		return claims{}, errors.Join(ErrJWTInvalidToken, fmt.Errorf("algorithm %q for a %s key", h.Algorithm, k.algorithm))
+	}
+	if err = verify(h.Algorithm, k.private.Public(), signingInput, signature); err != nil {
+		return claims{}, errors.Join(ErrJWTInvalidToken, err)
+	}
+
+	var c claims
+	if err = json.Unmarshal(claimsJSON, &c); err != nil {
+		return claims{}, errors.Join(ErrJWTInvalidToken, err)
+	}
+	if !r.now().Before(time.Unix(c.Expiry, 0)) {
+		return claims{}, errors.Join(ErrJWTInvalidToken, errors.New("token expired"))
+	}
+
+	return c, nil
+}
+
+func (r *KeyRing) key(ctx context.Context, keyID string) (*signingKey, error) {
+	r.mu.RLock()
+	k, ok := r.keys[keyID]
+	syncedAt := r.syncedAt
+	r.mu.RUnlock()
+	if ok {
+		return k, nil
+	}
+	if r.now().Sub(syncedAt) < syncInterval {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+
+	if err := r.Sync(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	k, ok = r.keys[keyID]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+
+	return k, nil
+}
+
+// sealKey encrypts the PKCS #8 form of the key, bound to its ID so sealed
+// keys can't be swapped between rows.
+func (r *KeyRing) sealKey(keyID string, private crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, r.seal.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return r.seal.Seal(nonce, nonce, der, []byte(keyID)), nil
+}
+
+func (r *KeyRing) unseal(keyID string, sealed []byte) (crypto.Signer, error) {
+	if len(sealed) < r.seal.NonceSize() {
+		return nil, errors.New("sealed key too short")
+	}
+
+	nonce, ciphertext := sealed[:r.seal.NonceSize()], sealed[r.seal.NonceSize():]
+	der, err := r.seal.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return nil, errors.New("unseal failed, was the key secret changed?")
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := private.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key %T", private)
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,244 @@
+package jwt
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDB keeps the rows of signing_keys, revoked_tokens and revoked_users
+// in memory.
+type fakeDB struct {
+	keys         []keyRow
+	revoked      []revokedRow
+	revokedUsers []revokedUserRow
+}
+
+var (
+	_ domain.ConnectionProvider = (*fakeDB)(nil)
+	_ domain.Connection         = (*fakeDB)(nil)
+)
+
+func (db *fakeDB) Close() error { return nil }
+
+func (db *fakeDB) Execute(ctx context.Context, receiver func(context.Context, domain.Connection) error) error {
+	return receiver(ctx, db)
+}
+
+func (db *fakeDB) ExecuteTx(ctx context.Context, receiver func(context.Context, domain.Connection) error) error {
+	return receiver(ctx, db)
+}
+
+func (db *fakeDB) GetContext(context.Context, any, string, ...any) error {
+	return errors.New("unexpected get")
+}
+
+func (db *fakeDB) SelectContext(_ context.Context, dest any, _ string, _ ...any) error {
+	switch dest := dest.(type) {
+	case *[]keyRow:
+		*dest = append([]keyRow(nil), db.keys...)
+	case *[]revokedRow:
+		*dest = append([]revokedRow(nil), db.revoked...)
+	case *[]revokedUserRow:
+		*dest = append([]revokedUserRow(nil), db.revokedUsers...)
+	default:
+		return errors.New("unexpected select")
+	}
+
+	return nil
+}
+
+func (db *fakeDB) ExecContext(_ context.Context, query string, args ...any) (int64, error) {
+	switch {
+	case strings.HasPrefix(query, "insert into signing_keys"):
+		db.keys = append(db.keys, keyRow{
+			ID:        args[0].(string),
+			Algorithm: args[1].(string),
+			SealedKey: args[2].([]byte),
+			CreatedAt: args[3].(time.Time),
+		})
+	case strings.HasPrefix(query, "insert into revoked_tokens"):
+		db.revoked = append(db.revoked, revokedRow{JTI: args[0].(string), ExpiresAt: args[1].(time.Time)})
+	case strings.HasPrefix(query, "insert into revoked_users"):
+		db.revokedUsers = append(db.revokedUsers, revokedUserRow{UserID: args[0].(domain.UserID), RevokedAt: args[1].(time.Time)})
+	case strings.HasPrefix(query, "delete from"):
+	default:
+		return 0, errors.New("unexpected exec")
+	}
+
+	return 1, nil
+}
+
+func TestKeyRing(t *testing.T) {
+	ctx := context.Background()
+	verifiedAt := time.Date(2025, time.February, 1, 10, 0, 0, 0, time.UTC)
+	user := domain.User{
+		ID:         domain.UserID(uuid.New()),
+		Name:       "Ann",
+		Email:      "ann@email.foo",
+		TimeZone:   "Europe/Moscow",
+		Locale:     "ru",
+		VerifiedAt: &verifiedAt,
+		Role:       domain.RoleAdmin,
+	}
+
+	for _, algorithm := range []string{EdDSA, ES256} {
+		t.Run(algorithm, func(t *testing.T) {
+			now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+			db := &fakeDB{}
+			newRing := func(t *testing.T, secret string) *KeyRing {
+				ring, err := NewKeyRing(db, algorithm, secret, 15*time.Minute, 24*time.Hour)
+				require.NoError(t, err)
+				ring.now = func() time.Time { return now }
+
+				return ring
+			}
+			ring := newRing(t, "secret")
+
+			_, _, err := ring.Issue(ctx, user)
+			require.ErrorIs(t, err, ErrJWTIssue)
+
+			require.NoError(t, ring.Rotate(ctx))
+			token, expiresAt, err := ring.Issue(ctx, user)
+			require.NoError(t, err)
+			require.Equal(t, now.Add(15*time.Minute), expiresAt.UTC())
+
+			got, err := ring.Verify(ctx, token)
+			require.NoError(t, err)
+			require.Equal(t, user.ID, got.ID)
+			require.Equal(t, user.Email, got.Email)
+			require.Equal(t, user.Locale, got.Locale)
+			require.True(t, verifiedAt.Equal(*got.VerifiedAt))
+			require.Equal(t, domain.RoleAdmin, got.Role)
+
+			t.Run("Tampered", func(t *testing.T) {
+				parts := strings.Split(token, ".")
+				forged := newClaims(domain.User{ID: domain.UserID(uuid.New())}, now, time.Hour)
+				claimsJSON, err := json.Marshal(forged)
+				require.NoError(t, err)
+				parts[1] = base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+				_, err = ring.Verify(ctx, strings.Join(parts, "."))
+				require.ErrorIs(t, err, ErrJWTInvalidToken)
+			})
+
+			t.Run("Other Instance", func(t *testing.T) {
+				other := newRing(t, "secret")
+
+				// The key isn't known, Verify syncs.
+				got, err := other.Verify(ctx, token)
+				require.NoError(t, err)
+				require.Equal(t, user.ID, got.ID)
+
+				_, err = newRing(t, "other secret").Verify(ctx, token)
+				require.ErrorIs(t, err, ErrJWTSync)
+			})
+
+			t.Run("JWKS", func(t *testing.T) {
+				data, err := ring.JWKS()
+				require.NoError(t, err)
+
+				var set struct {
+					Keys []jsonWebKey `json:"keys"`
+				}
+				require.NoError(t, json.Unmarshal(data, &set))
+				require.Len(t, set.Keys, 1)
+				require.Equal(t, db.keys[0].ID, set.Keys[0].KeyID)
+				require.Equal(t, algorithm, set.Keys[0].Algorithm)
+			})
+
+			t.Run("Rotation", func(t *testing.T) {
+				now = now.Add(time.Hour)
+				require.NoError(t, ring.Rotate(ctx))
+				require.Len(t, db.keys, 1)
+
+				now = now.Add(24 * time.Hour)
+				require.NoError(t, ring.Rotate(ctx))
+				require.Len(t, db.keys, 2)
+
+				rotated, _, err := ring.Issue(ctx, user)
+				require.NoError(t, err)
+				h, _, _, _, err := decode(rotated)
+				require.NoError(t, err)
+				require.Equal(t, db.keys[1].ID, h.KeyID)
+
+				// Tokens of the old key expired by now.
+				_, err = ring.Verify(ctx, token)
+				require.ErrorIs(t, err, ErrJWTInvalidToken)
+				_, err = ring.Verify(ctx, rotated)
+				require.NoError(t, err)
+			})
+
+			t.Run("Revoke", func(t *testing.T) {
+				token, _, err := ring.Issue(ctx, user)
+				require.NoError(t, err)
+
+				require.NoError(t, ring.Revoke(ctx, token))
+				_, err = ring.Verify(ctx, token)
+				require.ErrorIs(t, err, ErrJWTInvalidToken)
+
+				// Other instances deny it once they synced.
+				other := newRing(t, "secret")
+				require.NoError(t, other.Sync(ctx))
+				_, err = other.Verify(ctx, token)
+				require.ErrorIs(t, err, ErrJWTInvalidToken)
+			})
+
+			t.Run("Revoke User", func(t *testing.T) {
+				token, _, err := ring.Issue(ctx, user)
+				require.NoError(t, err)
+
+				require.NoError(t, ring.RevokeUser(ctx, user.ID))
+				_, err = ring.Verify(ctx, token)
+				require.ErrorIs(t, err, ErrJWTInvalidToken)
+
+				other := newRing(t, "secret")
+				require.NoError(t, other.Sync(ctx))
+				_, err = other.Verify(ctx, token)
+				require.ErrorIs(t, err, ErrJWTInvalidToken)
+
+				// Tokens issued after the revocation work.
+				now = now.Add(time.Second)
+				token, _, err = ring.Issue(ctx, user)
+				require.NoError(t, err)
+				_, err = other.Verify(ctx, token)
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestKeyRingAlgorithmMismatch(t *testing.T) {
+	ctx := context.Background()
+	ring, err := NewKeyRing(&fakeDB{}, EdDSA, "secret", time.Minute, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, ring.Rotate(ctx))
+
+	token, _, err := ring.Issue(ctx, domain.User{ID: domain.UserID(uuid.New())})
+	require.NoError(t, err)
+
+	for _, alg := range []string{ES256, "none", "HS256"} {
+		parts := strings.Split(token, ".")
+		h, _, _, _, err := decode(token)
+		require.NoError(t, err)
+		h.Algorithm = alg
+		headerJSON, err := json.Marshal(h)
+		require.NoError(t, err)
+		parts[0] = base64.RawURLEncoding.EncodeToString(headerJSON)
+
+		_, err = ring.Verify(ctx, strings.Join(parts, "."))
+		require.ErrorIs(t, err, ErrJWTInvalidToken, alg)
+	}
+
+	_, err = NewKeyRing(&fakeDB{}, "HS256", "secret", time.Minute, time.Hour)
+	require.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"todo_list/internal/adapter/logger"
+)
+
+var (
+	ErrLifecycle      = errors.New("lifecycle error")
+	ErrLifecycleServe = errors.Join(ErrLifecycle, errors.New("serve failed"))
+	ErrLifecycleDrain = errors.Join(ErrLifecycle, errors.New("drain failed"))
+	ErrLifecycleClose = errors.Join(ErrLifecycle, errors.New("close failed"))
+)
+
+type (
+	// Lifecycle owns the server, the background workers and the shared
+	// resources. It stops them in this order: the server reports itself
+	// stopping for a delay, so load balancers take it out, drains the
+	// requests in flight, the workers finish their round, then the resources
+	// are closed, each exactly once, the last added first. Resources are left
+	// open when the drain timed out, since requests or workers may still use
+	// them, and the process is about to exit anyway.
+	Lifecycle struct {
+		server   *http.Server
+		delay    time.Duration
+		drain    time.Duration
+		workers  []worker
+		closers  []closer
+		stopping atomic.Bool
+
+		mu      sync.Mutex
+		running map[string]bool
+	}
+
+	worker struct {
+		name string
+		run  func(context.Context)
+	}
+
+	closer struct {
+		name  string
+		close func() error
+	}
+)
+
+// New returns a lifecycle serving for delay once stopping, then giving the
+// server and the workers drain to stop.
+func New(server *http.Server, delay, drain time.Duration) *Lifecycle {
+	return &Lifecycle{server: server, delay: delay, drain: drain, running: map[string]bool{}}
+}
+
+// Stopping reports whether the shutdown began.
+func (l *Lifecycle) Stopping() bool {
+	return l.stopping.Load()
+}
+
+// Workers reports whether each worker runs, by name.
+func (l *Lifecycle) Workers() map[string]bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return maps.Clone(l.running)
+}
+
+func (l *Lifecycle) setRunning(name string, running bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.running[name] = running
+}
+
+// Go adds a worker running until its context is done.
+func (l *Lifecycle) Go(name string, run func(context.Context)) {
+	l.workers = append(l.workers, worker{name: name, run: run})
+	l.setRunning(name, false)
+}
+
+// OnClose adds a resource closed after the server and the workers stopped.
+func (l *Lifecycle) OnClose(name string, close func() error) {
+	l.closers = append(l.closers, closer{name: name, close: close})
+}
+
+// Run starts the workers and the server, and stops everything once ctx is
+// done or the server fails. The resources are closed even if starting
+// failed.
+func (l *Lifecycle) Run(ctx context.Context) error {
+	workersCtx, stopWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopWorkers()
+
+	var wg sync.WaitGroup
+	for _, w := range l.workers {
+		wg.Add(1)
+		l.setRunning(w.name, true)
+		go func() {
+			defer wg.Done()
+			w.run(workersCtx)
+			l.setRunning(w.name, false)
+			slog.DebugContext(ctx, "Worker stopped.", slog.String("worker", w.name))
+		}()
+	}
+
+	served := make(chan error, 1)
+	go func() { served <- l.server.ListenAndServe() }()
+
+	var errs []error
+	select {
+	case <-ctx.Done():
+		slog.InfoContext(ctx, "Shutting down.", slog.Duration("delay", l.delay), slog.Duration("drain", l.drain))
+		l.stopping.Store(true)
+		select {
+		case <-time.After(l.delay):
+		case err := <-served:
+			errs = append(errs, errors.Join(ErrLifecycleServe, err))
+		}
+	case err := <-served:
+		l.stopping.Store(true)
+		errs = append(errs, errors.Join(ErrLifecycleServe, err))
+	}
+
+	drainCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), l.drain)
+	defer cancel()
+
+	drained := true
+	if err := l.server.Shutdown(drainCtx); err != nil {
+		drained = false
+		errs = append(errs, errors.Join(ErrLifecycleDrain, errors.New("server"), err))
+		_ = l.server.Close()
+	}
+
+	stopWorkers()
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-drainCtx.Done():
+		drained = false
+		errs = append(errs, errors.Join(ErrLifecycleDrain, errors.New("workers"), drainCtx.Err()))
+	}
+
+	if !drained {
+		slog.ErrorContext(ctx, "Drain timed out, leaving the resources open.")
+
+		return errors.Join(errs...)
+	}
+
+	for i := len(l.closers) - 1; i >= 0; i-- {
+		c := l.closers[i]
+		if err := c.close(); err != nil {
+			slog.ErrorContext(ctx, "Close failed.", slog.String("resource", c.name), logger.ErrAttr(err))
+			errs = append(errs, errors.Join(ErrLifecycleClose, fmt.Errorf("close %s", c.name), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,203 @@
+package lifecycle_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/lifecycle"
+
+	"github.com/stretchr/testify/require"
+)
+
+// events records what happened, in order.
+type events struct {
+	mu   sync.Mutex
+	list []string
+}
+
+func (e *events) add(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.list = append(e.list, event)
+}
+
+func (e *events) get() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]string(nil), e.list...)
+}
+
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	return address
+}
+
+func waitServing(t *testing.T, address string) {
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			_ = conn.Close()
+		}
+
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestLifecycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(*testing.T)
+	}{
+		{
+			name: "Drains Requests Then Stops Workers Then Closes",
+			check: func(t *testing.T) {
+				var got events
+				started, release := make(chan struct{}), make(chan struct{})
+				address := freeAddress(t)
+				server := &http.Server{Addr: address, Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					close(started)
+					<-release
+					got.add("request")
+					_, _ = io.WriteString(w, "done")
+				})}
+
+				app := lifecycle.New(server, 0, time.Second)
+				app.Go("worker", func(ctx context.Context) {
+					<-ctx.Done()
+					got.add("worker")
+				})
+				app.OnClose("first", func() error {
+					got.add("first")
+					return nil
+				})
+				app.OnClose("second", func() error {
+					got.add("second")
+					return nil
+				})
+
+				ctx, stop := context.WithCancel(context.Background())
+				ran := make(chan error, 1)
+				go func() { ran <- app.Run(ctx) }()
+				waitServing(t, address)
+
+				responded := make(chan string, 1)
+				go func() {
+					response, err := http.Get("http://" + address)
+					if err != nil {
+						responded <- err.Error()
+						return
+					}
+					defer func() { _ = response.Body.Close() }()
+					body, _ := io.ReadAll(response.Body)
+					responded <- string(body)
+				}()
+				<-started
+				stop()
+
+				select {
+				case <-ran:
+					t.Fatal("stopped with a request in flight")
+				case <-time.After(50 * time.Millisecond):
+				}
+				close(release)
+
+				require.Equal(t, "done", <-responded)
+				require.NoError(t, <-ran)
+				require.Equal(t, []string{"request", "worker", "second", "first"}, got.get())
+			},
+		},
+		{
+			name: "Stopping During Delay",
+			check: func(t *testing.T) {
+				address := freeAddress(t)
+				server := &http.Server{Addr: address, Handler: http.NotFoundHandler()}
+
+				app := lifecycle.New(server, 100*time.Millisecond, time.Second)
+				app.Go("worker", func(ctx context.Context) { <-ctx.Done() })
+				require.Equal(t, map[string]bool{"worker": false}, app.Workers())
+
+				ctx, stop := context.WithCancel(context.Background())
+				ran := make(chan error, 1)
+				go func() { ran <- app.Run(ctx) }()
+				waitServing(t, address)
+				require.False(t, app.Stopping())
+				require.Equal(t, map[string]bool{"worker": true}, app.Workers())
+
+				stop()
+				require.Eventually(t, app.Stopping, time.Second, time.Millisecond)
+				response, err := http.Get("http://" + address)
+				require.NoError(t, err)
+				require.NoError(t, response.Body.Close())
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+
+				require.NoError(t, <-ran)
+				require.Equal(t, map[string]bool{"worker": false}, app.Workers())
+			},
+		},
+		{
+			name: "Drain Timeout Leaves Resources Open",
+			check: func(t *testing.T) {
+				var got events
+				address := freeAddress(t)
+				server := &http.Server{Addr: address, Handler: http.NotFoundHandler()}
+
+				app := lifecycle.New(server, 0, 10*time.Millisecond)
+				app.Go("stuck", func(ctx context.Context) { time.Sleep(time.Second) })
+				app.OnClose("resource", func() error {
+					got.add("resource")
+					return nil
+				})
+
+				ctx, stop := context.WithCancel(context.Background())
+				ran := make(chan error, 1)
+				go func() { ran <- app.Run(ctx) }()
+				waitServing(t, address)
+				stop()
+
+				err := <-ran
+
+				require.ErrorIs(t, err, lifecycle.ErrLifecycleDrain)
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+				require.Empty(t, got.get())
+			},
+		},
+		{
+			name: "Serve Failed Closes",
+			check: func(t *testing.T) {
+				var got events
+				server := &http.Server{Addr: "127.0.0.1:-1", Handler: http.NotFoundHandler()}
+
+				app := lifecycle.New(server, 0, time.Second)
+				app.Go("worker", func(ctx context.Context) {
+					<-ctx.Done()
+					got.add("worker")
+				})
+				app.OnClose("resource", func() error {
+					got.add("resource")
+					return errors.New("some error")
+				})
+
+				err := app.Run(context.Background())
+
+				require.ErrorIs(t, err, lifecycle.ErrLifecycleServe)
+				require.ErrorIs(t, err, lifecycle.ErrLifecycleClose)
+				require.ErrorContains(t, err, "some error")
+				require.Equal(t, []string{"worker", "resource"}, got.get())
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, test.check)
+	}
+}
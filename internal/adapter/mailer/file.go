@@ -0,0 +1,80 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var (
+	_ domain.Mailer = (*File)(nil)
+	_ domain.Mailer = (*Log)(nil)
+)
+
+// File appends every mail to a file instead of sending it, for development
+// and tests. Messages are separated by an empty line.
+type File struct {
+	path string
+	from string
+	mu   sync.Mutex
+}
+
+func NewFile(path, from string) (*File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, errors.Join(errMailer, err)
+	}
+
+	return &File{path: path, from: from}, nil
+}
+
+// Send implements domain.Mailer.
+func (m *File) Send(_ context.Context, mail domain.Mail) error {
+	msg, err := message(m.from, mail, time.Now())
+	if err != nil {
+		return errors.Join(ErrMailerSend, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return errors.Join(ErrMailerSend, err)
+	}
+
+	_, err = file.Write(append(msg, "\r\n\r\n"...))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Join(ErrMailerSend, err)
+	}
+
+	return nil
+}
+
+// logLink matches the links of mail bodies, which carry tokens.
+var logLink = regexp.MustCompile(`https?://\S+`)
+
+// Log writes every mail to the log instead of sending it, for development.
+// Links are redacted, reset and confirmation links would let anyone reading
+// the log take over the account, use File to follow them.
+type Log struct{}
+
+func NewLog() *Log {
+	return &Log{}
+}
+
+// Send implements domain.Mailer.
+func (m *Log) Send(ctx context.Context, mail domain.Mail) error {
+	slog.InfoContext(ctx, "Mail.", slog.String("to", mail.To), slog.String("subject", mail.Subject), slog.String("body", logLink.ReplaceAllString(mail.Body, "[link redacted]")))
+
+	return nil
+}
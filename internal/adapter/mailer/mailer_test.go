@@ -0,0 +1,69 @@
+package mailer_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"todo_list/internal/adapter/mailer"
+	"todo_list/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "mail", "sent.txt")
+
+	m, err := mailer.NewFile(path, "To-do <noreply@todo.example>")
+	require.NoError(t, err)
+
+	require.NoError(t, m.Send(ctx, domain.Mail{To: "ann@email.foo", Subject: "Сброс пароля", Body: "line 1\nline 2\n"}))
+	require.NoError(t, m.Send(ctx, domain.Mail{To: "bob@email.foo", Subject: "Hello", Body: "hi"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	content := string(data)
+	require.Contains(t, content, "From: To-do <noreply@todo.example>\r\nTo: ann@email.foo\r\n")
+	require.Contains(t, content, "Subject: =?utf-8?q?")
+	require.Contains(t, content, "\r\n\r\nline 1\r\nline 2\r\n")
+	require.Equal(t, 2, strings.Count(content, "MIME-Version: 1.0"))
+}
+
+func TestLogRedactsLinks(t *testing.T) {
+	var logged bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logged, nil)))
+
+	body := "Open\n\nhttps://todo.example/reset-password?token=secret\n\nwithin an hour.\n"
+	require.NoError(t, mailer.NewLog().Send(context.Background(), domain.Mail{To: "ann@email.foo", Subject: "Reset", Body: body}))
+
+	require.Contains(t, logged.String(), "ann@email.foo")
+	require.Contains(t, logged.String(), "[link redacted]")
+	require.NotContains(t, logged.String(), "secret")
+}
+
+func TestHeaderInjection(t *testing.T) {
+	m, err := mailer.NewFile(filepath.Join(t.TempDir(), "sent.txt"), "noreply@todo.example")
+	require.NoError(t, err)
+
+	for _, mail := range []domain.Mail{
+		{To: "ann@email.foo\r\nBcc: eve@email.foo", Subject: "Hello"},
+		{To: "ann@email.foo", Subject: "Hello\nBcc: eve@email.foo"},
+		{To: "not an address", Subject: "Hello"},
+	} {
+		require.ErrorIs(t, m.Send(context.Background(), mail), mailer.ErrMailerSend)
+	}
+}
+
+func TestSMTPAddress(t *testing.T) {
+	_, err := mailer.NewSMTP("localhost", "", "", "noreply@todo.example")
+	require.Error(t, err)
+
+	_, err = mailer.NewSMTP("localhost:25", "user", "password", "noreply@todo.example")
+	require.NoError(t, err)
+}
@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var (
+	errMailer     = errors.New("mailer error")
+	ErrMailerSend = errors.Join(errMailer, errors.New("send failed"))
+)
+
+// message formats the mail as a plain text RFC 5322 message. Header values
+// are checked for line breaks, which would let the recipient or subject add
+// headers of their own.
+func message(from string, m domain.Mail, date time.Time) ([]byte, error) {
+	if strings.ContainsAny(m.To+m.Subject, "\r\n") {
+		return nil, errors.New("line break in header")
+	}
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return nil, fmt.Errorf("recipient: %w", err)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/smtp"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.Mailer = (*SMTP)(nil)
+
+// SMTP delivers mail through a relay, upgrading to TLS when the relay
+// offers STARTTLS.
+type SMTP struct {
+	address string
+	auth    smtp.Auth
+	from    string
+}
+
+// NewSMTP authenticates with PLAIN when username is not empty. The standard
+// library refuses to send the password over a connection without TLS unless
+// the relay is on localhost.
+func NewSMTP(address, username, password, from string) (*SMTP, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Join(errMailer, err)
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTP{address: address, auth: auth, from: from}, nil
+}
+
+// Send implements domain.Mailer.
+func (m *SMTP) Send(_ context.Context, mail domain.Mail) error {
+	msg, err := message(m.from, mail, time.Now())
+	if err != nil {
+		return errors.Join(ErrMailerSend, err)
+	}
+
+	if err = smtp.SendMail(m.address, m.auth, m.from, []string{mail.To}, msg); err != nil {
+		return errors.Join(ErrMailerSend, err)
+	}
+
+	return nil
+}
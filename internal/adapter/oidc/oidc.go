@@ -0,0 +1,212 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.IdentityProvider = (*Provider)(nil)
+
+var (
+	errOIDC             = errors.New("oidc provider error")
+	ErrOIDCDiscovery    = errors.Join(errOIDC, errors.New("discovery failed"))
+	ErrOIDCExchange     = errors.Join(errOIDC, errors.New("exchange failed"))
+	ErrOIDCInvalidToken = errors.Join(ErrOIDCExchange, errors.New("invalid id token"))
+)
+
+const (
+	// scope asks for the claims linking the identity to a user.
+	scope = "openid email profile"
+	// maxResponseSize is plenty for discovery documents, key sets and
+	// token responses.
+	maxResponseSize = 1 << 20
+	// keysRefreshInterval keeps tokens with unknown key IDs from making us
+	// fetch the key set over and over.
+	keysRefreshInterval = time.Minute
+)
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect provider found by discovery. Its signing
+// keys are fetched when a token needs one that isn't known yet, so key
+// rotation at the provider just works.
+type Provider struct {
+	client       *http.Client
+	config       discovery
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	now          func() time.Time
+
+	mu            sync.Mutex
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// New reads the discovery document of the issuer. Without clientSecret the
+// client is a public one, which PKCE alone protects.
+func New(ctx context.Context, issuer, clientID, clientSecret, redirectURL string) (*Provider, error) {
+	p := &Provider{
+		client:       &http.Client{Timeout: 10 * time.Second},
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		now:          time.Now,
+	}
+
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, wellKnown, &p.config); err != nil {
+		return nil, errors.Join(ErrOIDCDiscovery, err)
+	}
+	if p.config.Issuer != issuer {
+		return nil, errors.Join(ErrOIDCDiscovery, fmt.Errorf("issuer %q doesn't match %q", p.config.Issuer, issuer))
+	}
+	if p.config.AuthorizationEndpoint == "" || p.config.TokenEndpoint == "" || p.config.JWKSURI == "" {
+		return nil, errors.Join(ErrOIDCDiscovery, errors.New("missing endpoint"))
+	}
+
+	return p, nil
+}
+
+// AuthCodeURL implements domain.IdentityProvider.
+func (p *Provider) AuthCodeURL(state string, nonce string, challenge string) string {
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.clientID},
+		"redirect_uri":          {p.redirectURL},
+		"scope":                 {scope},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {challenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(p.config.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return p.config.AuthorizationEndpoint + separator + query.Encode()
+}
+
+// Exchange implements domain.IdentityProvider.
+func (p *Provider) Exchange(ctx context.Context, code string, verifier string, nonce string) (domain.Identity, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.redirectURL},
+		"code_verifier": {verifier},
+		"client_id":     {p.clientID},
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return domain.Identity{}, errors.Join(ErrOIDCExchange, err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if p.clientSecret != "" {
+		// RFC 6749 form-encodes the credentials before the basic scheme.
+		request.SetBasicAuth(url.QueryEscape(p.clientID), url.QueryEscape(p.clientSecret))
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return domain.Identity{}, errors.Join(ErrOIDCExchange, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err = json.NewDecoder(io.LimitReader(response.Body, maxResponseSize)).Decode(&token); err != nil {
+		return domain.Identity{}, errors.Join(ErrOIDCExchange, fmt.Errorf("token response %s: %w", response.Status, err))
+	}
+	if response.StatusCode != http.StatusOK {
+		return domain.Identity{}, errors.Join(ErrOIDCExchange, fmt.Errorf("token response %s: %s %s", response.Status, token.Error, token.ErrorDescription))
+	}
+	if token.IDToken == "" {
+		return domain.Identity{}, errors.Join(ErrOIDCExchange, errors.New("no id token"))
+	}
+
+	claims, err := p.verify(ctx, token.IDToken, nonce)
+	if err != nil {
+		return domain.Identity{}, errors.Join(ErrOIDCInvalidToken, err)
+	}
+
+	return domain.Identity{
+		Issuer:        claims.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+// key returns the signing key with the ID, fetching the key set again when
+// the key isn't known.
+func (p *Provider) key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[keyID]; ok {
+		return key, nil
+	}
+	if p.now().Sub(p.keysFetchedAt) < keysRefreshInterval {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+
+	var set keySet
+	if err := p.getJSON(ctx, p.config.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+	keys, err := set.publicKeys()
+	if err != nil {
+		return nil, err
+	}
+	p.keys, p.keysFetchedAt = keys, p.now()
+
+	if key, ok := p.keys[keyID]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown key %q", keyID)
+}
+
+func (p *Provider) getJSON(ctx context.Context, target string, value any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: %s", target, response.Status)
+	}
+
+	return json.NewDecoder(io.LimitReader(response.Body, maxResponseSize)).Decode(value)
+}
@@ -0,0 +1,251 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/oidc"
+	"todo_list/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clientID     = "todo"
+	clientSecret = "client secret"
+	redirectURL  = "http://app.localhost/oidc/callback"
+	authCode     = "some code"
+)
+
+// mockIdP is a provider serving discovery, its keys and a token endpoint
+// that checks the PKCE verifier and answers with the claims, signed by
+// sign.
+type mockIdP struct {
+	*httptest.Server
+	rsaKey    *rsa.PrivateKey
+	ecKey     *ecdsa.PrivateKey
+	challenge string
+	claims    map[string]any
+	sign      func(t *testing.T, idp *mockIdP, claims map[string]any) string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	idp := &mockIdP{rsaKey: rsaKey, ecKey: ecKey, sign: signRS256}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"jwks_uri":               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": encodeBigInt(rsaKey.N), "e": encodeBigInt(big.NewInt(int64(rsaKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encodeBigInt(ecKey.X), "y": encodeBigInt(ecKey.Y)},
+			{"kty": "OKP", "kid": "unknown type", "crv": "Ed25519", "x": "AA"},
+		}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		verifier := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		switch {
+		case !ok || id != clientID || secret != url.QueryEscape(clientSecret):
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		case r.PostFormValue("code") != authCode || r.PostFormValue("redirect_uri") != redirectURL ||
+			base64.RawURLEncoding.EncodeToString(verifier[:]) != idp.challenge:
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		default:
+			writeJSON(w, http.StatusOK, map[string]string{"id_token": idp.sign(t, idp, idp.claims), "token_type": "Bearer"})
+		}
+	})
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		modify func(*mockIdP)
+		err    error
+	}{
+		{
+			name:   "RS256",
+			modify: func(*mockIdP) {},
+		},
+		{
+			name:   "ES256",
+			modify: func(idp *mockIdP) { idp.sign = signES256 },
+		},
+		{
+			name:   "Audience List",
+			modify: func(idp *mockIdP) { idp.claims["aud"] = []string{"other", clientID}; idp.claims["azp"] = clientID },
+		},
+		{
+			name:   "Audience List Without Authorized Party",
+			modify: func(idp *mockIdP) { idp.claims["aud"] = []string{"other", clientID} },
+			err:    oidc.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "Other Audience",
+			modify: func(idp *mockIdP) { idp.claims["aud"] = "other" },
+			err:    oidc.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "Other Issuer",
+			modify: func(idp *mockIdP) { idp.claims["iss"] = "http://evil.localhost" },
+			err:    oidc.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "Expired",
+			modify: func(idp *mockIdP) { idp.claims["exp"] = time.Now().Add(-2 * time.Minute).Unix() },
+			err:    oidc.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "Wrong Nonce",
+			modify: func(idp *mockIdP) { idp.claims["nonce"] = "replayed" },
+			err:    oidc.ErrOIDCInvalidToken,
+		},
+		{
+			name: "Other Key",
+			modify: func(idp *mockIdP) {
+				other, err := rsa.GenerateKey(rand.Reader, 2048)
+				require.NoError(t, err)
+				idp.sign = func(t *testing.T, _ *mockIdP, claims map[string]any) string {
+					return signRS256(t, &mockIdP{rsaKey: other}, claims)
+				}
+			},
+			err: oidc.ErrOIDCInvalidToken,
+		},
+		{
+			name: "Algorithm None",
+			modify: func(idp *mockIdP) {
+				idp.sign = func(t *testing.T, _ *mockIdP, claims map[string]any) string {
+					return encodeSegment(t, map[string]string{"alg": "none", "kid": "rsa"}) + "." + encodeSegment(t, claims) + "."
+				}
+			},
+			err: oidc.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "Wrong Verifier",
+			modify: func(idp *mockIdP) { idp.challenge = "something else" },
+			err:    oidc.ErrOIDCExchange,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			idp := newMockIdP(t)
+			provider, err := oidc.New(ctx, idp.URL, clientID, clientSecret, redirectURL)
+			require.NoError(t, err)
+
+			verifier := "a verifier long enough for the rules of RFC 7636"
+			sum := sha256.Sum256([]byte(verifier))
+			authURL, err := url.Parse(provider.AuthCodeURL("some state", "some nonce", base64.RawURLEncoding.EncodeToString(sum[:])))
+			require.NoError(t, err)
+			query := authURL.Query()
+			require.Equal(t, idp.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+			require.Equal(t, "some state", query.Get("state"))
+			require.Equal(t, "S256", query.Get("code_challenge_method"))
+
+			idp.challenge = query.Get("code_challenge")
+			idp.claims = map[string]any{
+				"iss":            idp.URL,
+				"sub":            "subject 1",
+				"aud":            clientID,
+				"exp":            time.Now().Add(time.Minute).Unix(),
+				"iat":            time.Now().Unix(),
+				"nonce":          query.Get("nonce"),
+				"email":          "ann@email.foo",
+				"email_verified": "true",
+				"name":           "Ann",
+			}
+			test.modify(idp)
+
+			identity, err := provider.Exchange(ctx, authCode, verifier, "some nonce")
+
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, domain.Identity{
+				Issuer:        idp.URL,
+				Subject:       "subject 1",
+				Email:         "ann@email.foo",
+				EmailVerified: true,
+				Name:          "Ann",
+			}, identity)
+		})
+	}
+}
+
+func TestProviderDiscovery(t *testing.T) {
+	idp := newMockIdP(t)
+
+	_, err := oidc.New(context.Background(), idp.URL+"/", clientID, "", redirectURL)
+
+	require.ErrorIs(t, err, oidc.ErrOIDCDiscovery)
+}
+
+func signRS256(t *testing.T, idp *mockIdP, claims map[string]any) string {
+	signed := encodeSegment(t, map[string]string{"alg": "RS256", "kid": "rsa"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idp.rsaKey, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signES256(t *testing.T, idp *mockIdP, claims map[string]any) string {
+	signed := encodeSegment(t, map[string]string{"alg": "ES256", "kid": "ec"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	r, s, err := ecdsa.Sign(rand.Reader, idp.ecKey, digest[:])
+	require.NoError(t, err)
+
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeSegment(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func encodeBigInt(value *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(value.Bytes())
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,232 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// clockSkew is how far the clocks of the provider and ours may be apart.
+const clockSkew = time.Minute
+
+type (
+	claims struct {
+		Issuer          string   `json:"iss"`
+		Subject         string   `json:"sub"`
+		Audience        audience `json:"aud"`
+		AuthorizedParty string   `json:"azp"`
+		Expiry          float64  `json:"exp"`
+		IssuedAt        float64  `json:"iat"`
+		Nonce           string   `json:"nonce"`
+		Email           string   `json:"email"`
+		EmailVerified   flexBool `json:"email_verified"`
+		Name            string   `json:"name"`
+	}
+
+	// audience is a single audience or a list of them.
+	audience []string
+
+	// flexBool also takes "true" and "false", which some providers send
+	// for email_verified.
+	flexBool bool
+
+	keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	jsonWebKey struct {
+		KeyType string `json:"kty"`
+		KeyID   string `json:"kid"`
+		Use     string `json:"use"`
+		N       string `json:"n"`
+		E       string `json:"e"`
+		Curve   string `json:"crv"`
+		X       string `json:"x"`
+		Y       string `json:"y"`
+	}
+)
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+
+		return nil
+	}
+
+	return json.Unmarshal(data, (*[]string)(a))
+}
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case `true`, `"true"`:
+		*b = true
+	case `false`, `"false"`, `null`:
+		*b = false
+	default:
+		return fmt.Errorf("not a boolean: %s", data)
+	}
+
+	return nil
+}
+
+// verify checks the signature and the claims of the ID token as OpenID
+// Connect Core 3.1.3.7 asks for the authorization code flow.
+func (p *Provider) verify(ctx context.Context, token string, nonce string) (claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims{}, errors.New("malformed token")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return claims{}, fmt.Errorf("header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return claims{}, fmt.Errorf("signature: %w", err)
+	}
+
+	key, err := p.key(ctx, header.KeyID)
+	if err != nil {
+		return claims{}, err
+	}
+	if err = verifySignature(header.Algorithm, key, parts[0]+"."+parts[1], signature); err != nil {
+		return claims{}, err
+	}
+
+	var c claims
+	if err = decodeSegment(parts[1], &c); err != nil {
+		return claims{}, fmt.Errorf("claims: %w", err)
+	}
+
+	now := p.now()
+	switch {
+	case c.Issuer != p.config.Issuer:
+		return claims{}, fmt.Errorf("issuer %q", c.Issuer)
+	case c.Subject == "":
+		return claims{}, errors.New("no subject")
+	case !slices.Contains(c.Audience, p.clientID):
+		return claims{}, fmt.Errorf("audience %q", c.Audience)
+	case len(c.Audience) > 1 && c.AuthorizedParty != p.clientID:
+		return claims{}, fmt.Errorf("authorized party %q", c.AuthorizedParty)
+	case now.Add(-clockSkew).After(unixTime(c.Expiry)):
+		return claims{}, errors.New("token expired")
+	case now.Add(clockSkew).Before(unixTime(c.IssuedAt)):
+		return claims{}, errors.New("token issued in the future")
+	case c.Nonce != nonce:
+		return claims{}, errors.New("nonce mismatch")
+	}
+
+	return c, nil
+}
+
+// verifySignature supports the algorithms providers sign ID tokens with in
+// practice. "none" and the HMAC ones are rejected, as the key set can't
+// hold keys for them.
+func verifySignature(algorithm string, key crypto.PublicKey, signed string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signed))
+
+	switch algorithm {
+	case "RS256":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("RS256 token for a non-RSA key")
+		}
+
+		return rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], signature)
+	case "ES256":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(signature) != 64 {
+			return errors.New("ES256 token for a non-P-256 key")
+		}
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(ecKey, digest[:], r, s) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+}
+
+// publicKeys returns the signing keys by ID, skipping the ones of unknown
+// types so a provider adding a new type doesn't break the others.
+func (s keySet) publicKeys() (map[string]crypto.PublicKey, error) {
+	keys := make(map[string]crypto.PublicKey, len(s.Keys))
+	for _, k := range s.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		switch {
+		case k.KeyType == "RSA":
+			n, err := decodeBigInt(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", k.KeyID, err)
+			}
+			e, err := decodeBigInt(k.E)
+			if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+				return nil, fmt.Errorf("key %q: invalid exponent", k.KeyID)
+			}
+			keys[k.KeyID] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case k.KeyType == "EC" && k.Curve == "P-256":
+			x, err := decodeBigInt(k.X)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", k.KeyID, err)
+			}
+			y, err := decodeBigInt(k.Y)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", k.KeyID, err)
+			}
+			key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+			// ECDH checks the point is on the curve.
+			if _, err = key.ECDH(); err != nil {
+				return nil, fmt.Errorf("key %q: %w", k.KeyID, err)
+			}
+			keys[k.KeyID] = key
+		}
+	}
+
+	return keys, nil
+}
+
+func decodeSegment(segment string, value any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, value)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty value")
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(int64(seconds), 0)
+}
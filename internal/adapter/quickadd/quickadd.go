@@ -0,0 +1,598 @@
+// Package quickadd parses a single line such as
+//
+//	Pay rent every month on the 1st !high #finance @home tomorrow 9am
+//
+// into a task. The words it recognises are taken out of the line and the rest
+// becomes the task name:
+//
+//   - !high, !normal, !low or !1 to !3 set the priority;
+//   - #name names the target list;
+//   - @name adds a tag;
+//   - dates, times and recurrences are written in the user's language.
+package quickadd
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var (
+	errQuickAdd  = errors.New("quick add error")
+	ErrEmptyName = errors.Join(errQuickAdd, errors.New("empty task name"))
+)
+
+type (
+	// Result is the parsed task. The list is only named, it is up to the
+	// caller to find it among the user's lists.
+	Result struct {
+		Task domain.Task `json:"task"`
+		List string      `json:"list,omitempty"`
+	}
+
+	// Options describe the user the line is parsed for. Relative dates are
+	// counted from Now in Location.
+	Options struct {
+		Now      time.Time
+		Location *time.Location
+		Locale   string
+	}
+
+	unit int
+
+	clock struct {
+		hour, minute int
+	}
+
+	rule struct {
+		unit     unit
+		interval int
+		weekday  *time.Weekday
+		monthDay int
+	}
+
+	parser struct {
+		vocabulary vocabulary
+		now        time.Time
+		today      time.Time
+		location   *time.Location
+
+		words []string
+		lower []string
+
+		name   []string
+		date   *time.Time
+		clock  *clock
+		rule   *rule
+		result Result
+	}
+)
+
+const (
+	day unit = iota
+	week
+	month
+	year
+)
+
+var (
+	frequencies = [...]string{day: "DAILY", week: "WEEKLY", month: "MONTHLY", year: "YEARLY"}
+	byDay       = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+)
+
+// Parse never fails on words it doesn't understand, they are left in the
+// name. Only a line with nothing but recognised words is an error.
+func Parse(line string, options Options) (Result, error) {
+	location := options.Location
+	if location == nil {
+		location = time.UTC
+	}
+	v, ok := vocabularies[options.Locale]
+	if !ok {
+		v = vocabularies[domain.DefaultLocale]
+	}
+
+	now := options.Now.In(location)
+	p := &parser{
+		vocabulary: v,
+		now:        now,
+		today:      time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		location:   location,
+		words:      strings.Fields(line),
+		result:     Result{Task: domain.Task{Priority: domain.Normal, Tags: []string{}}},
+	}
+	for _, word := range p.words {
+		p.lower = append(p.lower, strings.ToLower(word))
+	}
+
+	for i := 0; i < len(p.words); {
+		n := p.match(i)
+		if n == 0 {
+			p.name = append(p.name, p.words[i])
+			n = 1
+		}
+		i += n
+	}
+
+	task := &p.result.Task
+	if task.Name = strings.Join(p.name, " "); task.Name == "" {
+		return Result{}, ErrEmptyName
+	}
+	task.Deadline, task.AllDay = p.deadline()
+	if p.rule != nil {
+		task.Recurrence = p.rule.String()
+	}
+
+	return p.result, nil
+}
+
+// match returns the number of words recognised starting at i.
+func (p *parser) match(i int) int {
+	for _, matcher := range []func(int) int{p.priority, p.list, p.tag, p.recurrence, p.dateAt, p.clockAt} {
+		if n := matcher(i); n > 0 {
+			return n
+		}
+	}
+
+	return 0
+}
+
+func (p *parser) priority(i int) int {
+	word, ok := strings.CutPrefix(p.lower[i], "!")
+	if !ok {
+		return 0
+	}
+	priority, ok := p.vocabulary.priorities[word]
+	if !ok {
+		return 0
+	}
+
+	p.result.Task.Priority = priority
+
+	return 1
+}
+
+func (p *parser) list(i int) int {
+	name, ok := strings.CutPrefix(p.words[i], "#")
+	if !ok || name == "" {
+		return 0
+	}
+
+	p.result.List = name
+
+	return 1
+}
+
+func (p *parser) tag(i int) int {
+	tag, ok := strings.CutPrefix(p.words[i], "@")
+	if !ok || tag == "" {
+		return 0
+	}
+
+	if !slices.Contains(p.result.Task.Tags, tag) {
+		p.result.Task.Tags = append(p.result.Task.Tags, tag)
+	}
+
+	return 1
+}
+
+// recurrence matches "daily", "every week", "every 2 days", "every monday"
+// and monthly rules with a day, such as "every month on the 1st".
+func (p *parser) recurrence(i int) int {
+	if p.rule != nil {
+		return 0
+	}
+
+	v := p.vocabulary
+	var r rule
+	n := 0
+	if u, ok := v.frequencies[p.lower[i]]; ok {
+		r, n = rule{unit: u, interval: 1}, 1
+	} else if slices.Contains(v.every, p.lower[i]) && i+1 < len(p.lower) {
+		next := p.lower[i+1]
+		if u, ok := v.units[next]; ok {
+			r, n = rule{unit: u, interval: 1}, 2
+		} else if weekday, ok := v.weekdays[next]; ok {
+			r, n = rule{unit: week, interval: 1, weekday: &weekday}, 2
+		} else if count, err := strconv.Atoi(next); err == nil && count > 0 && i+2 < len(p.lower) {
+			if u, ok := v.units[p.lower[i+2]]; ok {
+				r, n = rule{unit: u, interval: count}, 3
+			}
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+
+	if r.unit == month {
+		if monthDay, m := p.monthDay(i + n); m > 0 {
+			r.monthDay = monthDay
+			n += m
+		}
+	}
+
+	p.rule = &r
+
+	return n
+}
+
+func (p *parser) monthDay(i int) (int, int) {
+	j := i
+	for j < len(p.lower) && slices.Contains(p.vocabulary.monthDayPrefixes, p.lower[j]) {
+		j++
+	}
+	if j >= len(p.lower) {
+		return 0, 0
+	}
+	monthDay, ok := p.ordinal(p.lower[j])
+	if !ok {
+		return 0, 0
+	}
+	j++
+	if j < len(p.lower) && slices.Contains(p.vocabulary.monthDaySuffixes, p.lower[j]) {
+		j++
+	}
+
+	return monthDay, j - i
+}
+
+// ordinal reads a day of month such as 1, 1st or 1-го.
+func (p *parser) ordinal(word string) (int, bool) {
+	for _, suffix := range p.vocabulary.ordinalSuffixes {
+		if trimmed, ok := strings.CutSuffix(word, suffix); ok {
+			word = trimmed
+
+			break
+		}
+	}
+
+	value, err := strconv.Atoi(word)
+	if err != nil || value < 1 || value > 31 {
+		return 0, false
+	}
+
+	return value, true
+}
+
+func (p *parser) dateAt(i int) int {
+	if p.date != nil {
+		return 0
+	}
+
+	date, n := p.parseDate(i)
+	if n == 0 && slices.Contains(p.vocabulary.datePrepositions, p.lower[i]) {
+		if date, n = p.parseDate(i + 1); n > 0 {
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+
+	p.date = &date
+
+	return n
+}
+
+func (p *parser) parseDate(i int) (time.Time, int) {
+	if i >= len(p.lower) {
+		return time.Time{}, 0
+	}
+	v := p.vocabulary
+	word := p.lower[i]
+
+	if days, n := lookup(p.lower[i:], v.days); n > 0 {
+		return p.today.AddDate(0, 0, days), n
+	}
+
+	if weekday, ok := v.weekdays[word]; ok {
+		return nextWeekday(p.today, weekday, false), 1
+	}
+	if slices.Contains(v.next, word) && i+1 < len(p.lower) {
+		if weekday, ok := v.weekdays[p.lower[i+1]]; ok {
+			return nextWeekday(p.today, weekday, true), 2
+		}
+	}
+
+	if slices.Contains(v.in, word) && i+1 < len(p.lower) {
+		if u, ok := v.units[p.lower[i+1]]; ok {
+			return add(p.today, u, 1), 2
+		}
+		count, err := strconv.Atoi(p.lower[i+1])
+		if slices.Contains(v.one, p.lower[i+1]) {
+			count, err = 1, nil
+		}
+		if err == nil && count > 0 && i+2 < len(p.lower) {
+			if u, ok := v.units[p.lower[i+2]]; ok {
+				return add(p.today, u, count), 3
+			}
+		}
+	}
+
+	if m, ok := v.months[word]; ok && i+1 < len(p.lower) {
+		if monthDay, ok := p.ordinal(p.lower[i+1]); ok {
+			return p.calendarDate(i+2, m, monthDay, 2)
+		}
+	}
+	if monthDay, ok := p.ordinal(word); ok && i+1 < len(p.lower) {
+		if m, ok := v.months[p.lower[i+1]]; ok {
+			return p.calendarDate(i+2, m, monthDay, 2)
+		}
+	}
+
+	if date, err := time.Parse(time.DateOnly, word); err == nil {
+		return date, 1
+	}
+
+	return p.numericDate(word)
+}
+
+// calendarDate finishes a date written with a month name, taking the year
+// that follows it if any.
+func (p *parser) calendarDate(i int, m time.Month, monthDay int, n int) (time.Time, int) {
+	if i < len(p.lower) && len(p.lower[i]) == 4 {
+		if y, err := strconv.Atoi(p.lower[i]); err == nil {
+			if date, ok := makeDate(y, m, monthDay); ok {
+				return date, n + 1
+			}
+		}
+	}
+
+	return p.upcoming(m, monthDay, n)
+}
+
+// numericDate reads 3/10 or 3/10/2025 in English and 10.03 or 10.03.2025 in
+// Russian.
+func (p *parser) numericDate(word string) (time.Time, int) {
+	parts := strings.Split(word, p.vocabulary.separator)
+	if len(parts) < 2 || len(parts) > 3 {
+		return time.Time{}, 0
+	}
+
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, 0
+		}
+		numbers[i] = number
+	}
+
+	monthDay, m := numbers[1], numbers[0]
+	if p.vocabulary.dayFirst {
+		monthDay, m = numbers[0], numbers[1]
+	}
+	if m < 1 || m > 12 {
+		return time.Time{}, 0
+	}
+
+	if len(numbers) == 3 {
+		date, ok := makeDate(numbers[2], time.Month(m), monthDay)
+		if !ok {
+			return time.Time{}, 0
+		}
+
+		return date, 1
+	}
+
+	return p.upcoming(time.Month(m), monthDay, 1)
+}
+
+// upcoming returns the date in this year, or in the next one if it is past.
+func (p *parser) upcoming(m time.Month, monthDay int, n int) (time.Time, int) {
+	date, ok := makeDate(p.today.Year(), m, monthDay)
+	if ok && date.Before(p.today) {
+		date, ok = makeDate(p.today.Year()+1, m, monthDay)
+	}
+	if !ok {
+		return time.Time{}, 0
+	}
+
+	return date, n
+}
+
+func (p *parser) clockAt(i int) int {
+	if p.clock != nil {
+		return 0
+	}
+
+	c, n := p.parseClock(i, false)
+	if n == 0 && slices.Contains(p.vocabulary.timePrepositions, p.lower[i]) {
+		if c, n = p.parseClock(i+1, true); n > 0 {
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+
+	p.clock = &c
+
+	return n
+}
+
+// parseClock matches 21:00, 9am, 9:30 pm and the like. A bare hour is only
+// taken after a preposition, as in "at 9", since it may be part of the name.
+func (p *parser) parseClock(i int, bare bool) (clock, int) {
+	if i >= len(p.lower) {
+		return clock{}, 0
+	}
+	word := p.lower[i]
+
+	if c, ok := p.vocabulary.clocks[word]; ok {
+		return c, 1
+	}
+
+	for suffix, pm := range p.vocabulary.meridiems {
+		if hour, ok := strings.CutSuffix(word, suffix); ok {
+			if c, ok := parseHourMinute(hour); ok && c.meridiem(pm) {
+				return c, 1
+			}
+		}
+	}
+
+	c, ok := parseHourMinute(word)
+	if !ok {
+		return clock{}, 0
+	}
+	if i+1 < len(p.lower) {
+		if pm, ok := p.vocabulary.meridiems[p.lower[i+1]]; ok && c.meridiem(pm) {
+			return c, 2
+		}
+	}
+	if bare || strings.Contains(word, ":") {
+		return c, 1
+	}
+
+	return clock{}, 0
+}
+
+// meridiem turns a 12-hour clock into a 24-hour one.
+func (c *clock) meridiem(pm bool) bool {
+	if c.hour < 1 || c.hour > 12 {
+		return false
+	}
+	if pm && c.hour != 12 {
+		c.hour += 12
+	}
+	if !pm && c.hour == 12 {
+		c.hour = 0
+	}
+
+	return true
+}
+
+func parseHourMinute(word string) (clock, bool) {
+	hour, minute, hasMinute := strings.Cut(word, ":")
+
+	var c clock
+	var err error
+	if c.hour, err = strconv.Atoi(hour); err != nil || c.hour < 0 || c.hour > 23 {
+		return clock{}, false
+	}
+	if hasMinute {
+		if len(minute) != 2 {
+			return clock{}, false
+		}
+		if c.minute, err = strconv.Atoi(minute); err != nil || c.minute < 0 || c.minute > 59 {
+			return clock{}, false
+		}
+	}
+
+	return c, true
+}
+
+// deadline follows the rules of domain.Task: a date without a time is an
+// all-day deadline at midnight UTC. A time without a date is the next such
+// time, and a recurrence without a date starts at its first occurrence.
+func (p *parser) deadline() (*time.Time, bool) {
+	date := p.date
+	if date == nil && p.rule != nil {
+		first := p.rule.first(p.today)
+		date = &first
+	}
+	if date == nil && p.clock != nil {
+		today := p.today
+		if p.at(today).Before(p.now) {
+			today = today.AddDate(0, 0, 1)
+		}
+		date = &today
+	}
+
+	if date == nil {
+		return nil, false
+	}
+	if p.clock == nil {
+		return date, true
+	}
+
+	deadline := p.at(*date)
+
+	return &deadline, false
+}
+
+func (p *parser) at(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), p.clock.hour, p.clock.minute, 0, 0, p.location)
+}
+
+func (r rule) first(today time.Time) time.Time {
+	switch {
+	case r.weekday != nil:
+		return nextWeekday(today, *r.weekday, false)
+	case r.monthDay > 0:
+		for months := 0; months <= 12; months++ {
+			date, ok := makeDate(today.Year(), today.Month()+time.Month(months), r.monthDay)
+			if ok && !date.Before(today) {
+				return date
+			}
+		}
+	}
+
+	return today
+}
+
+// String returns the rule as an RRULE value.
+func (r rule) String() string {
+	value := "FREQ=" + frequencies[r.unit]
+	if r.interval > 1 {
+		value += ";INTERVAL=" + strconv.Itoa(r.interval)
+	}
+	if r.weekday != nil {
+		value += ";BYDAY=" + byDay[*r.weekday]
+	}
+	if r.monthDay > 0 {
+		value += ";BYMONTHDAY=" + strconv.Itoa(r.monthDay)
+	}
+
+	return value
+}
+
+// lookup matches the longest phrase of up to three words.
+func lookup[T any](words []string, phrases map[string]T) (T, int) {
+	for n := min(3, len(words)); n > 0; n-- {
+		if value, ok := phrases[strings.Join(words[:n], " ")]; ok {
+			return value, n
+		}
+	}
+
+	var zero T
+
+	return zero, 0
+}
+
+// nextWeekday returns the closest such day, today included unless strict.
+func nextWeekday(today time.Time, weekday time.Weekday, strict bool) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 && strict {
+		days = 7
+	}
+
+	return today.AddDate(0, 0, days)
+}
+
+func add(date time.Time, u unit, count int) time.Time {
+	switch u {
+	case week:
+		return date.AddDate(0, 0, 7*count)
+	case month:
+		return date.AddDate(0, count, 0)
+	case year:
+		return date.AddDate(count, 0, 0)
+	default:
+		return date.AddDate(0, 0, count)
+	}
+}
+
+// makeDate rejects dates time.Date would roll over, such as February 30.
+func makeDate(y int, m time.Month, d int) (time.Time, bool) {
+	date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if date.Day() != d {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
@@ -0,0 +1,161 @@
+package quickadd_test
+
+import (
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/quickadd"
+	"todo_list/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	// Saturday, March 9, 2024, 17:00 in Moscow.
+	now := time.Date(2024, time.March, 9, 14, 0, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) *time.Time {
+		value := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+		return &value
+	}
+	at := func(month time.Month, day, hour, minute int) *time.Time {
+		value := time.Date(2024, month, day, hour, minute, 0, 0, moscow)
+
+		return &value
+	}
+
+	tests := []struct {
+		name     string
+		line     string
+		locale   string
+		expected quickadd.Result
+	}{
+		{
+			name: "Everything",
+			line: "Pay rent every month on the 1st !high #finance @home tomorrow 9am",
+			expected: quickadd.Result{
+				Task: domain.Task{Name: "Pay rent", Priority: domain.High, Deadline: at(time.March, 10, 9, 0),
+					Tags: []string{"home"}, Recurrence: "FREQ=MONTHLY;BYMONTHDAY=1"},
+				List: "finance",
+			},
+		},
+		{
+			name:     "Name only",
+			line:     "Buy 3 apples",
+			expected: quickadd.Result{Task: domain.Task{Name: "Buy 3 apples", Priority: domain.Normal, Tags: []string{}}},
+		},
+		{
+			name: "Date without time is all-day",
+			line: "Dentist on march 12",
+			expected: quickadd.Result{Task: domain.Task{Name: "Dentist", Priority: domain.Normal, Deadline: date(2024, time.March, 12),
+				AllDay: true, Tags: []string{}}},
+		},
+		{
+			name: "Past date is next year",
+			line: "Taxes 1/15",
+			expected: quickadd.Result{Task: domain.Task{Name: "Taxes", Priority: domain.Normal,
+				Deadline: date(2025, time.January, 15), AllDay: true, Tags: []string{}}},
+		},
+		{
+			name: "Passed time is tomorrow",
+			line: "Call mom at 9",
+			expected: quickadd.Result{Task: domain.Task{Name: "Call mom", Priority: domain.Normal, Deadline: at(time.March, 10, 9, 0),
+				Tags: []string{}}},
+		},
+		{
+			name: "Weekday and period",
+			line: "Standup next saturday 10:30 !low",
+			expected: quickadd.Result{Task: domain.Task{Name: "Standup", Priority: domain.Low, Deadline: at(time.March, 16, 10, 30),
+				Tags: []string{}}},
+		},
+		{
+			name: "Relative period",
+			line: "Renew passport in 2 weeks",
+			expected: quickadd.Result{Task: domain.Task{Name: "Renew passport", Priority: domain.Normal, Deadline: date(2024, time.March, 23),
+				AllDay: true, Tags: []string{}}},
+		},
+		{
+			name: "Recurrence starts at first occurrence",
+			line: "Gym every tuesday 7pm @health @health",
+			expected: quickadd.Result{Task: domain.Task{Name: "Gym", Priority: domain.Normal, Deadline: at(time.March, 12, 19, 0),
+				Tags: []string{"health"}, Recurrence: "FREQ=WEEKLY;BYDAY=TU"}},
+		},
+		{
+			name: "Interval",
+			line: "Water plants every 3 days",
+			expected: quickadd.Result{Task: domain.Task{Name: "Water plants", Priority: domain.Normal, Deadline: date(2024, time.March, 9),
+				AllDay: true, Tags: []string{}, Recurrence: "FREQ=DAILY;INTERVAL=3"}},
+		},
+		{
+			name:   "Russian",
+			line:   "Оплатить квартиру ежемесячно 5 числа !высокий #Дом завтра в 9 утра",
+			locale: "ru",
+			expected: quickadd.Result{
+				Task: domain.Task{Name: "Оплатить квартиру", Priority: domain.High, Deadline: at(time.March, 10, 9, 0),
+					Tags: []string{}, Recurrence: "FREQ=MONTHLY;BYMONTHDAY=5"},
+				List: "Дом",
+			},
+		},
+		{
+			name:   "Russian date",
+			line:   "Позвонить 15 марта в 14:00",
+			locale: "ru",
+			expected: quickadd.Result{Task: domain.Task{Name: "Позвонить", Priority: domain.Normal, Deadline: at(time.March, 15, 14, 0),
+				Tags: []string{}}},
+		},
+		{
+			name:   "Russian relative",
+			line:   "Отчёт через 2 дня в 3 дня",
+			locale: "ru",
+			expected: quickadd.Result{Task: domain.Task{Name: "Отчёт", Priority: domain.Normal, Deadline: at(time.March, 11, 15, 0),
+				Tags: []string{}}},
+		},
+		{
+			name:   "Russian weekday",
+			line:   "Уборка каждую субботу",
+			locale: "ru",
+			expected: quickadd.Result{Task: domain.Task{Name: "Уборка", Priority: domain.Normal, Deadline: date(2024, time.March, 9),
+				AllDay: true, Tags: []string{}, Recurrence: "FREQ=WEEKLY;BYDAY=SA"}},
+		},
+		{
+			name:   "Words of another locale stay in the name",
+			line:   "Позвонить tomorrow",
+			locale: "ru",
+			expected: quickadd.Result{Task: domain.Task{Name: "Позвонить tomorrow", Priority: domain.Normal,
+				Tags: []string{}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			locale := test.locale
+			if locale == "" {
+				locale = "en"
+			}
+
+			result, err := quickadd.Parse(test.line, quickadd.Options{Now: now, Location: moscow, Locale: locale})
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected.List, result.List)
+			require.Equal(t, test.expected.Task.Name, result.Task.Name)
+			require.Equal(t, test.expected.Task.Priority, result.Task.Priority)
+			require.Equal(t, test.expected.Task.Tags, result.Task.Tags)
+			require.Equal(t, test.expected.Task.Recurrence, result.Task.Recurrence)
+			require.Equal(t, test.expected.Task.AllDay, result.Task.AllDay)
+			if test.expected.Task.Deadline == nil {
+				require.Nil(t, result.Task.Deadline)
+			} else {
+				require.NotNil(t, result.Task.Deadline)
+				require.True(t, test.expected.Task.Deadline.Equal(*result.Task.Deadline),
+					"expected %s, got %s", test.expected.Task.Deadline, result.Task.Deadline)
+			}
+		})
+	}
+}
+
+func TestParseEmptyName(t *testing.T) {
+	_, err := quickadd.Parse("tomorrow !high #work", quickadd.Options{Now: time.Now(), Locale: "en"})
+
+	require.ErrorIs(t, err, quickadd.ErrEmptyName)
+}
@@ -0,0 +1,134 @@
+package quickadd
+
+import (
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+// vocabulary holds the words of one locale, all in lower case.
+type vocabulary struct {
+	priorities map[string]domain.Priority
+
+	// days are phrases of up to three words counted from today.
+	days     map[string]int
+	weekdays map[string]time.Weekday
+	months   map[string]time.Month
+
+	next  []string // before a weekday, skips today
+	in    []string // before a period, as in "in 3 days"
+	one   []string // stand for the count of a period, as in "in a week"
+	units map[string]unit
+
+	every       []string
+	frequencies map[string]unit
+
+	datePrepositions []string
+	timePrepositions []string
+
+	monthDayPrefixes []string
+	monthDaySuffixes []string
+	ordinalSuffixes  []string
+
+	// meridiems map to true for the afternoon.
+	meridiems map[string]bool
+	clocks    map[string]clock
+
+	// separator and dayFirst describe numeric dates such as 3/10.
+	separator string
+	dayFirst  bool
+}
+
+var vocabularies = map[string]vocabulary{
+	"en": {
+		priorities: map[string]domain.Priority{
+			"high": domain.High, "h": domain.High, "1": domain.High,
+			"normal": domain.Normal, "medium": domain.Normal, "n": domain.Normal, "2": domain.Normal,
+			"low": domain.Low, "l": domain.Low, "3": domain.Low,
+		},
+		days: map[string]int{
+			"today": 0, "tonight": 0, "tomorrow": 1, "day after tomorrow": 2,
+		},
+		weekdays: map[string]time.Weekday{
+			"monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday, "thursday": time.Thursday,
+			"friday": time.Friday, "saturday": time.Saturday, "sunday": time.Sunday,
+		},
+		months: map[string]time.Month{
+			"january": time.January, "jan": time.January,
+			"february": time.February, "feb": time.February,
+			"march": time.March, "mar": time.March,
+			"april": time.April, "apr": time.April,
+			"may":  time.May,
+			"june": time.June, "jun": time.June,
+			"july": time.July, "jul": time.July,
+			"august": time.August, "aug": time.August,
+			"september": time.September, "sep": time.September, "sept": time.September,
+			"october": time.October, "oct": time.October,
+			"november": time.November, "nov": time.November,
+			"december": time.December, "dec": time.December,
+		},
+		next: []string{"next"},
+		in:   []string{"in"},
+		one:  []string{"a", "an"},
+		units: map[string]unit{
+			"day": day, "days": day,
+			"week": week, "weeks": week,
+			"month": month, "months": month,
+			"year": year, "years": year,
+		},
+		every: []string{"every"},
+		frequencies: map[string]unit{
+			"daily": day, "weekly": week, "monthly": month, "yearly": year, "annually": year,
+		},
+		datePrepositions: []string{"on"},
+		timePrepositions: []string{"at"},
+		monthDayPrefixes: []string{"on", "the"},
+		ordinalSuffixes:  []string{"st", "nd", "rd", "th"},
+		meridiems:        map[string]bool{"am": false, "pm": true},
+		clocks:           map[string]clock{"noon": {hour: 12}, "midnight": {}},
+		separator:        "/",
+	},
+	"ru": {
+		priorities: map[string]domain.Priority{
+			"высокий": domain.High, "в": domain.High, "high": domain.High, "1": domain.High,
+			"обычный": domain.Normal, "средний": domain.Normal, "normal": domain.Normal, "2": domain.Normal,
+			"низкий": domain.Low, "н": domain.Low, "low": domain.Low, "3": domain.Low,
+		},
+		days: map[string]int{
+			"сегодня": 0, "завтра": 1, "послезавтра": 2,
+		},
+		weekdays: map[string]time.Weekday{
+			"понедельник": time.Monday, "вторник": time.Tuesday,
+			"среда": time.Wednesday, "среду": time.Wednesday,
+			"четверг": time.Thursday,
+			"пятница": time.Friday, "пятницу": time.Friday,
+			"суббота": time.Saturday, "субботу": time.Saturday,
+			"воскресенье": time.Sunday,
+		},
+		months: map[string]time.Month{
+			"января": time.January, "февраля": time.February, "марта": time.March, "апреля": time.April,
+			"мая": time.May, "июня": time.June, "июля": time.July, "августа": time.August,
+			"сентября": time.September, "октября": time.October, "ноября": time.November, "декабря": time.December,
+		},
+		next: []string{"следующий", "следующую", "следующее"},
+		in:   []string{"через"},
+		units: map[string]unit{
+			"день": day, "дня": day, "дней": day,
+			"неделю": week, "недели": week, "недель": week,
+			"месяц": month, "месяца": month, "месяцев": month,
+			"год": year, "года": year, "лет": year,
+		},
+		every: []string{"каждый", "каждую", "каждое", "каждые"},
+		frequencies: map[string]unit{
+			"ежедневно": day, "еженедельно": week, "ежемесячно": month, "ежегодно": year,
+		},
+		datePrepositions: []string{"в", "во"},
+		timePrepositions: []string{"в"},
+		monthDaySuffixes: []string{"числа"},
+		ordinalSuffixes:  []string{"-го", "-е"},
+		meridiems:        map[string]bool{"утра": false, "ночи": false, "дня": true, "вечера": true},
+		clocks:           map[string]clock{"полдень": {hour: 12}, "полночь": {}},
+		separator:        ".",
+		dayFirst:         true,
+	},
+}
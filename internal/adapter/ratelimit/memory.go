@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.RateLimitStore = (*Memory)(nil)
+
+type (
+	// Memory keeps the limits of a single instance.
+	Memory struct {
+		mu       sync.Mutex
+		buckets  map[string]bucket
+		failures map[string]failure
+		now      func() time.Time
+	}
+
+	bucket struct {
+		tokens    float64
+		updatedAt time.Time
+	}
+
+	failure struct {
+		count       int
+		lastFailed  time.Time
+		lockedUntil time.Time
+	}
+)
+
+func NewMemory() *Memory {
+	return &Memory{
+		buckets:  map[string]bucket{},
+		failures: map[string]failure{},
+		now:      time.Now,
+	}
+}
+
+// Take implements domain.RateLimitStore.
+func (s *Memory) Take(_ context.Context, key string, limit domain.RateLimit) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = bucket{tokens: float64(limit.Burst), updatedAt: now}
+	}
+
+	var wait time.Duration
+	b.tokens, wait = take(b.tokens, now.Sub(b.updatedAt), limit)
+	b.updatedAt = now
+	s.buckets[key] = b
+
+	return wait, nil
+}
+
+// Fail implements domain.RateLimitStore.
+func (s *Memory) Fail(_ context.Context, key string, lockout domain.Lockout) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	f := s.failures[key]
+	if now.Sub(f.lastFailed) > lockout.Reset {
+		f.count = 0
+	}
+	f.count++
+	f.lastFailed = now
+
+	delay := lockoutDelay(f.count, lockout)
+	if delay > 0 {
+		f.lockedUntil = now.Add(delay)
+	}
+	s.failures[key] = f
+
+	return delay, nil
+}
+
+// Locked implements domain.RateLimitStore.
+func (s *Memory) Locked(_ context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return max(s.failures[key].lockedUntil.Sub(s.now()), 0), nil
+}
+
+// Clear implements domain.RateLimitStore.
+func (s *Memory) Clear(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.failures, key)
+
+	return nil
+}
+
+// Sweep implements domain.RateLimitStore.
+func (s *Memory) Sweep(_ context.Context, before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	swept := 0
+	for key, b := range s.buckets {
+		if b.updatedAt.Before(before) {
+			delete(s.buckets, key)
+			swept++
+		}
+	}
+	for key, f := range s.failures {
+		if f.lastFailed.Before(before) && f.lockedUntil.Before(before) {
+			delete(s.failures, key)
+			swept++
+		}
+	}
+
+	return swept, nil
+}
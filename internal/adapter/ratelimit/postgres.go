@@ -0,0 +1,144 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.RateLimitStore = (*Postgres)(nil)
+
+// Postgres shares the limits between instances. Times come from the
+// database, so the clocks of the instances don't matter.
+type Postgres struct {
+	provider domain.ConnectionProvider
+}
+
+func NewPostgres(provider domain.ConnectionProvider) *Postgres {
+	return &Postgres{provider: provider}
+}
+
+// Take implements domain.RateLimitStore. The row is locked while the bucket
+// is refilled, so concurrent requests take tokens one after another.
+func (s *Postgres) Take(ctx context.Context, key string, limit domain.RateLimit) (time.Duration, error) {
+	var wait time.Duration
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		const insert = `insert into rate_limit_buckets (key, tokens) values ($1, $2) on conflict (key) do nothing`
+		if _, err := connection.ExecContext(ctx, insert, key, float64(limit.Burst)); err != nil {
+			return err
+		}
+
+		const query = `select tokens, extract(epoch from now() - updated_at)::float8 as elapsed
+from rate_limit_buckets where key = $1 for update`
+		var b struct {
+			Tokens  float64
+			Elapsed float64
+		}
+		if err := connection.GetContext(ctx, &b, query, key); err != nil {
+			return err
+		}
+
+		var tokens float64
+		tokens, wait = take(b.Tokens, time.Duration(b.Elapsed*float64(time.Second)), limit)
+
+		const update = `update rate_limit_buckets set tokens = $2, updated_at = now() where key = $1`
+		_, err := connection.ExecContext(ctx, update, key, tokens)
+
+		return err
+	})
+	if err != nil {
+		return 0, errors.Join(ErrRateLimitTake, err)
+	}
+
+	return wait, nil
+}
+
+// Fail implements domain.RateLimitStore.
+func (s *Postgres) Fail(ctx context.Context, key string, lockout domain.Lockout) (time.Duration, error) {
+	var delay time.Duration
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		const upsert = `insert into rate_limit_failures as f (key, failures) values ($1, 1)
+on conflict (key) do update set
+    failures = case when f.last_failed_at < now() - make_interval(secs => $2) then 1 else f.failures + 1 end,
+    last_failed_at = now()
+returning failures`
+		var failures int
+		if err := connection.GetContext(ctx, &failures, upsert, key, lockout.Reset.Seconds()); err != nil {
+			return err
+		}
+
+		delay = lockoutDelay(failures, lockout)
+		if delay <= 0 {
+			return nil
+		}
+
+		const update = `update rate_limit_failures set locked_until = now() + make_interval(secs => $2) where key = $1`
+		_, err := connection.ExecContext(ctx, update, key, delay.Seconds())
+
+		return err
+	})
+	if err != nil {
+		return 0, errors.Join(ErrRateLimitFail, err)
+	}
+
+	return delay, nil
+}
+
+// Locked implements domain.RateLimitStore.
+func (s *Postgres) Locked(ctx context.Context, key string) (time.Duration, error) {
+	var seconds float64
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+		const query = `select coalesce(extract(epoch from locked_until - now())::float8, 0)
+from rate_limit_failures where key = $1`
+
+		return connection.GetContext(ctx, &seconds, query, key)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Join(ErrRateLimitLocked, err)
+	}
+
+	return max(time.Duration(seconds*float64(time.Second)), 0), nil
+}
+
+// Clear implements domain.RateLimitStore.
+func (s *Postgres) Clear(ctx context.Context, key string) error {
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+		_, err := connection.ExecContext(ctx, `delete from rate_limit_failures where key = $1`, key)
+
+		return err
+	})
+	if err != nil {
+		return errors.Join(ErrRateLimitClear, err)
+	}
+
+	return nil
+}
+
+// Sweep implements domain.RateLimitStore.
+func (s *Postgres) Sweep(ctx context.Context, before time.Time) (int, error) {
+	var swept int64
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		buckets, err := connection.ExecContext(ctx, `delete from rate_limit_buckets where updated_at < $1`, before)
+		if err != nil {
+			return err
+		}
+
+		const query = `delete from rate_limit_failures
+where last_failed_at < $1 and (locked_until is null or locked_until < $1)`
+		failures, err := connection.ExecContext(ctx, query, before)
+		swept = buckets + failures
+
+		return err
+	})
+	if err != nil {
+		return 0, errors.Join(ErrRateLimitSweep, err)
+	}
+
+	return int(swept), nil
+}
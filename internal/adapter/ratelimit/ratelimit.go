@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var (
+	errRateLimit       = errors.New("rate limit store error")
+	ErrRateLimitTake   = errors.Join(errRateLimit, errors.New("take failed"))
+	ErrRateLimitFail   = errors.Join(errRateLimit, errors.New("fail failed"))
+	ErrRateLimitLocked = errors.Join(errRateLimit, errors.New("read lockout failed"))
+	ErrRateLimitClear  = errors.Join(errRateLimit, errors.New("clear failed"))
+	ErrRateLimitSweep  = errors.Join(errRateLimit, errors.New("sweep failed"))
+)
+
+// take refills the bucket for the elapsed time and takes a token from it.
+// It returns the tokens left and, when there was no token to take, how long
+// until there is one.
+func take(tokens float64, elapsed time.Duration, limit domain.RateLimit) (float64, time.Duration) {
+	tokens = math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+	if tokens >= 1 {
+		return tokens - 1, 0
+	}
+	if limit.Rate <= 0 {
+		return tokens, time.Duration(math.MaxInt64)
+	}
+
+	return tokens, time.Duration(math.Ceil((1 - tokens) / limit.Rate * float64(time.Second)))
+}
+
+// lockoutDelay returns how long a key is locked out for after its failures.
+func lockoutDelay(failures int, lockout domain.Lockout) time.Duration {
+	if failures < lockout.Threshold {
+		return 0
+	}
+
+	delay := lockout.Delay
+	for i := lockout.Threshold; i < failures && delay < lockout.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, lockout.MaxDelay)
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTake(t *testing.T) {
+	limit := domain.RateLimit{Rate: 0.5, Burst: 2}
+
+	tokens, wait := take(2, 0, limit)
+	require.Equal(t, 1.0, tokens)
+	require.Zero(t, wait)
+
+	tokens, wait = take(0, time.Second, limit)
+	require.Equal(t, 0.5, tokens)
+	require.Equal(t, time.Second, wait)
+
+	tokens, wait = take(0, time.Hour, limit)
+	require.Equal(t, 1.0, tokens)
+	require.Zero(t, wait)
+}
+
+func TestLockoutDelay(t *testing.T) {
+	lockout := domain.Lockout{Threshold: 3, Delay: time.Minute, MaxDelay: 5 * time.Minute}
+
+	for failures, want := range []time.Duration{0, 0, 0, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute} {
+		require.Equal(t, want, lockoutDelay(failures, lockout), failures)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemory()
+	store.now = func() time.Time { return now }
+
+	t.Run("Take", func(t *testing.T) {
+		limit := domain.RateLimit{Rate: 1, Burst: 2}
+		for _, want := range []time.Duration{0, 0, time.Second} {
+			wait, err := store.Take(ctx, "ip:1", limit)
+			require.NoError(t, err)
+			require.Equal(t, want, wait)
+		}
+
+		wait, err := store.Take(ctx, "ip:2", limit)
+		require.NoError(t, err)
+		require.Zero(t, wait)
+
+		now = now.Add(time.Second)
+		wait, err = store.Take(ctx, "ip:1", limit)
+		require.NoError(t, err)
+		require.Zero(t, wait)
+	})
+
+	t.Run("Lockout", func(t *testing.T) {
+		lockout := domain.Lockout{Threshold: 2, Delay: time.Minute, MaxDelay: time.Hour, Reset: 24 * time.Hour}
+
+		delay, err := store.Fail(ctx, "login:ann", lockout)
+		require.NoError(t, err)
+		require.Zero(t, delay)
+		delay, err = store.Fail(ctx, "login:ann", lockout)
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, delay)
+
+		locked, err := store.Locked(ctx, "login:ann")
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, locked)
+
+		now = now.Add(time.Minute)
+		locked, err = store.Locked(ctx, "login:ann")
+		require.NoError(t, err)
+		require.Zero(t, locked)
+
+		delay, err = store.Fail(ctx, "login:ann", lockout)
+		require.NoError(t, err)
+		require.Equal(t, 2*time.Minute, delay)
+
+		require.NoError(t, store.Clear(ctx, "login:ann"))
+		delay, err = store.Fail(ctx, "login:ann", lockout)
+		require.NoError(t, err)
+		require.Zero(t, delay)
+
+		now = now.Add(25 * time.Hour)
+		delay, err = store.Fail(ctx, "login:ann", lockout)
+		require.NoError(t, err)
+		require.Zero(t, delay)
+	})
+
+	t.Run("Sweep", func(t *testing.T) {
+		swept, err := store.Sweep(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 2, swept)
+
+		swept, err = store.Sweep(ctx, now.Add(time.Second))
+		require.NoError(t, err)
+		require.Equal(t, 1, swept)
+	})
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+// accessibleLists selects IDs of the lists of the workspace $2 the user $1
+// owns or is a member of, or of all its lists when the user owns the
+// workspace. Users outside the workspace access none of them.
+const accessibleLists = `select l.id from lists l
+join workspace_members w on w.workspace_id = l.workspace_id and w.user_id = $1
+where l.workspace_id = $2
+    and (w.role = 'owner' or l.user_id = $1 or exists (select 1 from list_members m where m.list_id = l.id and m.user_id = $1))`
+
+// listExists reports whether the user may access the list in the workspace.
+// Every repository that works with data inside a list authorizes through it.
+func listExists(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.ListID,
+) (bool, error) {
+	const query = `select 1 from lists where id = $3 and id in (` + accessibleLists + `)`
+
+	var tmp int
+	if err := connection.GetContext(ctx, &tmp, query, userID, workspaceID, listID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// taskExists reports whether the user may access the list of the task in the
+// workspace.
+func taskExists(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	taskID domain.TaskID,
+) (bool, error) {
+	var listID domain.ListID
+	if err := connection.GetContext(ctx, &listID, "select list_id from tasks where id = $1", taskID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+		}
+
+		return false, err
+	}
+
+	return listExists(ctx, connection, userID, workspaceID, listID)
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.AccessTokensRepository = (*AccessTokens)(nil)
+
+var (
+	errAccessTokens           = errors.New("access tokens repository error")
+	ErrAccessTokensCreate     = errors.Join(errAccessTokens, errors.New("create failed"))
+	ErrAccessTokensReadByHash = errors.Join(errAccessTokens, errors.New("read by hash failed"))
+	ErrAccessTokensReadAll    = errors.Join(errAccessTokens, errors.New("read all failed"))
+	ErrAccessTokensRevoke     = errors.Join(errAccessTokens, errors.New("revoke failed"))
+	ErrAccessTokensRevokeAll  = errors.Join(errAccessTokens, errors.New("revoke all failed"))
+)
+
+type AccessTokens struct{}
+
+func NewAccessTokens() *AccessTokens {
+	return &AccessTokens{}
+}
+
+func (r AccessTokens) Create(ctx context.Context, connection domain.Connection, token domain.AccessToken) error {
+	const query = `
+insert into access_tokens
+    (id, user_id, name, token_hash, scopes, created_at, expires_at)
+values
+    ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := connection.ExecContext(ctx, query, token.ID, token.UserID, token.Name, token.TokenHash, token.Scopes,
+		token.CreatedAt, token.ExpiresAt)
+	if err != nil {
+		return errors.Join(ErrAccessTokensCreate, err)
+	}
+
+	return nil
+}
+
+func (r AccessTokens) ReadByHash(ctx context.Context, connection domain.Connection, tokenHash string) (domain.AccessToken, error) {
+	const query = `
+select id, user_id, name, token_hash, scopes, created_at, expires_at, revoked_at from access_tokens
+where token_hash = $1 and revoked_at is null and (expires_at is null or expires_at > now())`
+
+	var token domain.AccessToken
+	if err := connection.GetContext(ctx, &token, query, tokenHash); err != nil {
+		return token, errors.Join(ErrAccessTokensReadByHash, err)
+	}
+
+	return token, nil
+}
+
+func (r AccessTokens) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID) ([]domain.AccessToken, error) {
+	const query = `
+select id, user_id, name, token_hash, scopes, created_at, expires_at, revoked_at from access_tokens
+where user_id = $1 order by created_at`
+
+	var tokens []domain.AccessToken
+	if err := connection.SelectContext(ctx, &tokens, query, userID); err != nil {
+		return nil, errors.Join(ErrAccessTokensReadAll, err)
+	}
+
+	return tokens, nil
+}
+
+func (r AccessTokens) Revoke(ctx context.Context, connection domain.Connection, userID domain.UserID, tokenID domain.AccessTokenID) error {
+	const query = `update access_tokens set revoked_at = now() where user_id = $1 and id = $2 and revoked_at is null`
+
+	updated, err := connection.ExecContext(ctx, query, userID, tokenID)
+	if err != nil {
+		return errors.Join(ErrAccessTokensRevoke, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrAccessTokensRevoke, errors.New("token not found or already revoked"))
+	}
+
+	return nil
+}
+
+func (r AccessTokens) RevokeAll(ctx context.Context, connection domain.Connection, userID domain.UserID) error {
+	const query = `update access_tokens set revoked_at = now() where user_id = $1 and revoked_at is null`
+
+	if _, err := connection.ExecContext(ctx, query, userID); err != nil {
+		return errors.Join(ErrAccessTokensRevokeAll, err)
+	}
+
+	return nil
+}
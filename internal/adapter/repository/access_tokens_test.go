@@ -0,0 +1,132 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokensIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewAccessTokens()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+
+		now := time.Now()
+		newToken := func(hash string, expiresAt *time.Time) domain.AccessToken {
+			token := domain.AccessToken{
+				ID:        domain.AccessTokenID(uuid.New()),
+				UserID:    user.ID,
+				Name:      hash,
+				TokenHash: hash,
+				Scopes:    []domain.Scope{domain.ScopeListsRead, domain.ScopeTasksRead},
+				CreatedAt: now,
+				ExpiresAt: expiresAt,
+			}
+			require.NoError(t, repo.Create(ctx, connection, token))
+
+			return token
+		}
+		forever := newToken("forever", nil)
+		past := now.Add(-time.Minute)
+		newToken("expired", &past)
+
+		token, err := repo.ReadByHash(ctx, connection, forever.TokenHash)
+		require.NoError(t, err)
+		require.Equal(t, forever.Scopes, token.Scopes)
+		require.Equal(t, user.ID, token.UserID)
+
+		_, err = repo.ReadByHash(ctx, connection, "expired")
+		require.ErrorIs(t, err, repository.ErrAccessTokensReadByHash)
+
+		require.NoError(t, repo.Revoke(ctx, connection, user.ID, forever.ID))
+		_, err = repo.ReadByHash(ctx, connection, forever.TokenHash)
+		require.ErrorIs(t, err, repository.ErrAccessTokensReadByHash)
+		require.ErrorIs(t, repo.Revoke(ctx, connection, user.ID, forever.ID), repository.ErrAccessTokensRevoke)
+
+		tokens, err := repo.ReadAll(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Len(t, tokens, 2)
+		require.NotNil(t, tokens[0].RevokedAt)
+
+		return nil
+	})
+}
+
+func TestAccessTokensUnit(t *testing.T) {
+	validToken := domain.AccessToken{
+		ID:        domain.AccessTokenID(uuid.New()),
+		UserID:    domain.UserID(uuid.New()),
+		Name:      "script",
+		TokenHash: "some hash",
+		Scopes:    []domain.Scope{domain.ScopeTasksRead},
+		CreatedAt: time.Now(),
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.AccessTokens, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.AccessTokens, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validToken.ID, validToken.UserID, validToken.Name, validToken.TokenHash,
+						validToken.Scopes, validToken.CreatedAt, validToken.ExpiresAt).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, validToken)
+
+				require.ErrorIs(t, err, repository.ErrAccessTokensCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read By Hash DB Error",
+			check: func(t *testing.T, repo *repository.AccessTokens, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validToken.TokenHash).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadByHash(ctx, connection, validToken.TokenHash)
+
+				require.ErrorIs(t, err, repository.ErrAccessTokensReadByHash)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Revoke Not Found",
+			check: func(t *testing.T, repo *repository.AccessTokens, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validToken.UserID, validToken.ID).
+					Return(0, nil).
+					Once()
+
+				err := repo.Revoke(ctx, connection, validToken.UserID, validToken.ID)
+
+				require.ErrorIs(t, err, repository.ErrAccessTokensRevoke)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewAccessTokens(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.AccountDeletionsRepository = (*AccountDeletions)(nil)
+
+var (
+	errAccountDeletions          = errors.New("account deletions repository error")
+	ErrAccountDeletionsCreate    = errors.Join(errAccountDeletions, errors.New("create failed"))
+	ErrAccountDeletionsRead      = errors.Join(errAccountDeletions, errors.New("read failed"))
+	ErrAccountDeletionsDelete    = errors.Join(errAccountDeletions, errors.New("delete failed"))
+	ErrAccountDeletionsReadDue   = errors.Join(errAccountDeletions, errors.New("read due failed"))
+	ErrAccountDeletionsDeleteDue = errors.Join(errAccountDeletions, errors.New("delete due failed"))
+)
+
+type AccountDeletions struct{}
+
+func NewAccountDeletions() *AccountDeletions {
+	return &AccountDeletions{}
+}
+
+func (r AccountDeletions) Create(ctx context.Context, connection domain.Connection, deletion domain.AccountDeletion) error {
+	const query = `insert into account_deletions (user_id, requested_at, delete_at) values ($1, $2, $3)
+on conflict (user_id) do update set requested_at = excluded.requested_at, delete_at = excluded.delete_at`
+
+	_, err := connection.ExecContext(ctx, query, deletion.UserID, deletion.RequestedAt, deletion.DeleteAt)
+	if err != nil {
+		return errors.Join(ErrAccountDeletionsCreate, err)
+	}
+
+	return nil
+}
+
+func (r AccountDeletions) Read(ctx context.Context, connection domain.Connection, userID domain.UserID) (domain.AccountDeletion, error) {
+	const query = `select user_id, requested_at, delete_at from account_deletions where user_id = $1`
+
+	var deletion domain.AccountDeletion
+	if err := connection.GetContext(ctx, &deletion, query, userID); err != nil {
+		return deletion, errors.Join(ErrAccountDeletionsRead, err)
+	}
+
+	return deletion, nil
+}
+
+func (r AccountDeletions) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID) error {
+	const query = `delete from account_deletions where user_id = $1`
+
+	deleted, err := connection.ExecContext(ctx, query, userID)
+	if err != nil {
+		return errors.Join(ErrAccountDeletionsDelete, err)
+	}
+	if deleted <= 0 {
+		return errors.Join(ErrAccountDeletionsDelete, errors.New("no deletion scheduled"))
+	}
+
+	return nil
+}
+
+func (r AccountDeletions) ReadDue(ctx context.Context, connection domain.Connection, now time.Time, limit int) ([]domain.UserID, error) {
+	const query = `select user_id from account_deletions where delete_at <= $1 order by delete_at limit $2`
+
+	var userIDs []domain.UserID
+	if err := connection.SelectContext(ctx, &userIDs, query, now, limit); err != nil {
+		return nil, errors.Join(ErrAccountDeletionsReadDue, err)
+	}
+
+	return userIDs, nil
+}
+
+func (r AccountDeletions) DeleteDue(ctx context.Context, connection domain.Connection, userID domain.UserID, now time.Time) (bool, error) {
+	const query = `delete from account_deletions where user_id = $1 and delete_at <= $2`
+
+	deleted, err := connection.ExecContext(ctx, query, userID, now)
+	if err != nil {
+		return false, errors.Join(ErrAccountDeletionsDeleteDue, err)
+	}
+
+	return deleted > 0, nil
+}
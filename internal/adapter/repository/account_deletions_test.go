@@ -0,0 +1,107 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountDeletionsIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewAccountDeletions()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		now := time.Now().UTC().Truncate(time.Second)
+
+		require.NoError(t, repo.Create(ctx, connection, domain.AccountDeletion{UserID: user.ID, RequestedAt: now, DeleteAt: now.Add(time.Hour)}))
+		// Scheduling again replaces the deletion.
+		deletion := domain.AccountDeletion{UserID: user.ID, RequestedAt: now, DeleteAt: now.Add(-time.Minute)}
+		require.NoError(t, repo.Create(ctx, connection, deletion))
+
+		read, err := repo.Read(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.True(t, deletion.DeleteAt.Equal(read.DeleteAt))
+
+		due, err := repo.ReadDue(ctx, connection, now, 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.UserID{user.ID}, due)
+
+		deleted, err := repo.DeleteDue(ctx, connection, user.ID, now)
+		require.NoError(t, err)
+		require.True(t, deleted)
+		require.NoError(t, repo.Create(ctx, connection, deletion))
+
+		// A deletion that isn't due yet stays.
+		deleted, err = repo.DeleteDue(ctx, connection, user.ID, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		require.NoError(t, repo.Delete(ctx, connection, user.ID))
+		// Cancelled before the purge, there is nothing to purge.
+		deleted, err = repo.DeleteDue(ctx, connection, user.ID, now)
+		require.NoError(t, err)
+		require.False(t, deleted)
+		_, err = repo.Read(ctx, connection, user.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		require.ErrorIs(t, repo.Delete(ctx, connection, user.ID), repository.ErrAccountDeletionsDelete)
+
+		return nil
+	})
+}
+
+func TestAccountDeletionsUnit(t *testing.T) {
+	userID := domain.UserID(uuid.New())
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.AccountDeletions, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Read DB Error",
+			check: func(t *testing.T, repo *repository.AccountDeletions, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, userID).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Read(ctx, connection, userID)
+
+				require.ErrorIs(t, err, repository.ErrAccountDeletionsRead)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Delete Not Found",
+			check: func(t *testing.T, repo *repository.AccountDeletions, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, userID).
+					Return(0, nil).
+					Once()
+
+				err := repo.Delete(ctx, connection, userID)
+
+				require.ErrorIs(t, err, repository.ErrAccountDeletionsDelete)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewAccountDeletions(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
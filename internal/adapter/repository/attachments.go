@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.AttachmentsRepository = (*Attachments)(nil)
+
+var (
+	errAttachments             = errors.New("attachments repository error")
+	ErrAttachmentsCreate       = errors.Join(errAttachments, errors.New("create failed"))
+	ErrAttachmentsRead         = errors.Join(errAttachments, errors.New("read failed"))
+	ErrAttachmentsReadAll      = errors.Join(errAttachments, errors.New("read all failed"))
+	ErrAttachmentsDelete       = errors.Join(errAttachments, errors.New("delete failed"))
+	ErrAttachmentsUsedStorage  = errors.Join(errAttachments, errors.New("used storage failed"))
+	ErrAttachmentsReadOrphans  = errors.Join(errAttachments, errors.New("read orphans failed"))
+	ErrAttachmentsDeleteOrphan = errors.Join(errAttachments, errors.New("delete orphan failed"))
+	ErrAttachmentsReadByUser   = errors.Join(errAttachments, errors.New("read by user failed"))
+)
+
+type Attachments struct{}
+
+func NewAttachments() *Attachments {
+	return &Attachments{}
+}
+
+func (r Attachments) Create(ctx context.Context, connection domain.Connection, attachment domain.Attachment) error {
+	const query = `insert into attachments
+    (id, task_id, user_id, name, content_type, size, storage_key, created_at)
+values
+    ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := connection.ExecContext(ctx, query, attachment.ID, attachment.TaskID, attachment.UserID, attachment.Name,
+		attachment.ContentType, attachment.Size, attachment.StorageKey, attachment.CreatedAt)
+	if err != nil {
+		return errors.Join(ErrAttachmentsCreate, err)
+	}
+
+	return nil
+}
+
+// Read checks the user may access the list of the attachment's task in the
+// workspace.
+func (r Attachments) Read(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	attachmentID domain.AttachmentID,
+) (domain.Attachment, error) {
+	const query = `select a.id, a.task_id, a.user_id, a.name, a.content_type, a.size, a.storage_key, a.created_at
+from attachments a
+join tasks t on t.id = a.task_id
+where t.list_id in (` + accessibleLists + `) and a.id = $3`
+
+	var attachment domain.Attachment
+	if err := connection.GetContext(ctx, &attachment, query, userID, workspaceID, attachmentID); err != nil {
+		return attachment, errors.Join(ErrAttachmentsRead, err)
+	}
+
+	return attachment, nil
+}
+
+func (r Attachments) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	taskID domain.TaskID,
+) ([]domain.Attachment, error) {
+	const query = `select a.id, a.task_id, a.user_id, a.name, a.content_type, a.size, a.storage_key, a.created_at
+from attachments a
+join tasks t on t.id = a.task_id
+where t.list_id in (` + accessibleLists + `) and a.task_id = $3
+order by a.created_at`
+
+	var attachments []domain.Attachment
+	if err := connection.SelectContext(ctx, &attachments, query, userID, workspaceID, taskID); err != nil {
+		return nil, errors.Join(ErrAttachmentsReadAll, err)
+	}
+
+	return attachments, nil
+}
+
+func (r Attachments) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	attachmentID domain.AttachmentID,
+) error {
+	const query = `delete from attachments a
+using tasks t
+where t.id = a.task_id and t.list_id in (` + accessibleLists + `) and a.id = $3`
+
+	deleted, err := connection.ExecContext(ctx, query, userID, workspaceID, attachmentID)
+	if err != nil {
+		return errors.Join(ErrAttachmentsDelete, err)
+	}
+	if deleted <= 0 {
+		return errors.Join(ErrAttachmentsDelete, errors.New("attachment not found or access denied"))
+	}
+
+	return nil
+}
+
+// UsedStorage doesn't count orphans waiting for the sweep. The lock on the
+// user row doesn't block the key share locks of foreign keys to it.
+func (r Attachments) UsedStorage(ctx context.Context, connection domain.Connection, userID domain.UserID) (int64, error) {
+	const lock = `select id from users where id = $1 for no key update`
+	if _, err := connection.ExecContext(ctx, lock, userID); err != nil {
+		return 0, errors.Join(ErrAttachmentsUsedStorage, err)
+	}
+
+	const query = `select coalesce(sum(size), 0) from attachments where user_id = $1 and task_id is not null`
+
+	var used int64
+	if err := connection.GetContext(ctx, &used, query, userID); err != nil {
+		return 0, errors.Join(ErrAttachmentsUsedStorage, err)
+	}
+
+	return used, nil
+}
+
+// ReadOrphans returns attachments whose task is gone. Only ID and storage key are filled.
+func (r Attachments) ReadOrphans(ctx context.Context, connection domain.Connection, limit int) ([]domain.Attachment, error) {
+	const query = `select id, storage_key from attachments where task_id is null order by created_at limit $1`
+
+	var attachments []domain.Attachment
+	if err := connection.SelectContext(ctx, &attachments, query, limit); err != nil {
+		return nil, errors.Join(ErrAttachmentsReadOrphans, err)
+	}
+
+	return attachments, nil
+}
+
+func (r Attachments) DeleteOrphan(ctx context.Context, connection domain.Connection, attachmentID domain.AttachmentID) error {
+	const query = `delete from attachments where id = $1 and task_id is null`
+
+	if _, err := connection.ExecContext(ctx, query, attachmentID); err != nil {
+		return errors.Join(ErrAttachmentsDeleteOrphan, err)
+	}
+
+	return nil
+}
+
+func (r Attachments) ReadByUser(ctx context.Context, connection domain.Connection, userID domain.UserID) ([]domain.Attachment, error) {
+	const query = `select id, task_id, user_id, name, content_type, size, storage_key, created_at
+from attachments
+where user_id = $1 and task_id is not null
+order by created_at`
+
+	var attachments []domain.Attachment
+	if err := connection.SelectContext(ctx, &attachments, query, userID); err != nil {
+		return nil, errors.Join(ErrAttachmentsReadByUser, err)
+	}
+
+	return attachments, nil
+}
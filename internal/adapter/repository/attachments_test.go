@@ -0,0 +1,192 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentsIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewAttachments()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
+		list := fixtureCreateList(t, ctx, connection, user.ID)
+		task := fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "task name")
+		attachment := domain.Attachment{
+			ID:          domain.AttachmentID(uuid.New()),
+			TaskID:      task.ID,
+			UserID:      user.ID,
+			Name:        "screenshot.png",
+			ContentType: "image/png",
+			Size:        42,
+			StorageKey:  uuid.NewString(),
+			CreatedAt:   time.Now(),
+		}
+		require.NoError(t, repo.Create(ctx, connection, attachment))
+
+		readAttachment, err := repo.Read(ctx, connection, user.ID, workspaceID, attachment.ID)
+		require.NoError(t, err)
+		require.Equal(t, attachment.StorageKey, readAttachment.StorageKey)
+
+		_, err = repo.Read(ctx, connection, domain.UserID(uuid.New()), workspaceID, attachment.ID)
+		require.Error(t, err)
+
+		attachments, err := repo.ReadAll(ctx, connection, user.ID, workspaceID, task.ID)
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+
+		used, err := repo.UsedStorage(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), used)
+
+		require.NoError(t, repository.NewTasks().Delete(ctx, connection, user.ID, workspaceID, task.ID))
+
+		orphans, err := repo.ReadOrphans(ctx, connection, 10)
+		require.NoError(t, err)
+		require.Len(t, orphans, 1)
+		require.Equal(t, attachment.StorageKey, orphans[0].StorageKey)
+
+		used, err = repo.UsedStorage(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Zero(t, used)
+
+		require.NoError(t, repo.DeleteOrphan(ctx, connection, attachment.ID))
+
+		orphans, err = repo.ReadOrphans(ctx, connection, 10)
+		require.NoError(t, err)
+		require.Empty(t, orphans)
+
+		return nil
+	})
+}
+
+func TestAttachmentsUnit(t *testing.T) {
+	workspaceID := domain.WorkspaceID(uuid.New())
+	validAttachment := domain.Attachment{
+		ID:          domain.AttachmentID(uuid.New()),
+		TaskID:      domain.TaskID(uuid.New()),
+		UserID:      domain.UserID(uuid.New()),
+		Name:        "screenshot.png",
+		ContentType: "image/png",
+		Size:        42,
+		StorageKey:  uuid.NewString(),
+		CreatedAt:   time.Now(),
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.Attachments, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.Attachments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validAttachment.ID, validAttachment.TaskID, validAttachment.UserID,
+						validAttachment.Name, validAttachment.ContentType, validAttachment.Size, validAttachment.StorageKey,
+						validAttachment.CreatedAt).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, validAttachment)
+
+				require.ErrorIs(t, err, repository.ErrAttachmentsCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read DB Error",
+			check: func(t *testing.T, repo *repository.Attachments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validAttachment.UserID, workspaceID, validAttachment.ID).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Read(ctx, connection, validAttachment.UserID, workspaceID, validAttachment.ID)
+
+				require.ErrorIs(t, err, repository.ErrAttachmentsRead)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Delete not found",
+			check: func(t *testing.T, repo *repository.Attachments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validAttachment.UserID, workspaceID, validAttachment.ID).
+					Return(0, nil).
+					Once()
+
+				err := repo.Delete(ctx, connection, validAttachment.UserID, workspaceID, validAttachment.ID)
+
+				require.ErrorIs(t, err, repository.ErrAttachmentsDelete)
+				require.ErrorContains(t, err, "not found")
+			},
+		},
+		{
+			name: "Used Storage DB Error",
+			check: func(t *testing.T, repo *repository.Attachments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validAttachment.UserID).
+					Return(1, nil).
+					Once()
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validAttachment.UserID).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.UsedStorage(ctx, connection, validAttachment.UserID)
+
+				require.ErrorIs(t, err, repository.ErrAttachmentsUsedStorage)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Used Storage Lock Error",
+			check: func(t *testing.T, repo *repository.Attachments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validAttachment.UserID).
+					Return(0, errors.New("some error")).
+					Once()
+
+				_, err := repo.UsedStorage(ctx, connection, validAttachment.UserID)
+
+				require.ErrorIs(t, err, repository.ErrAttachmentsUsedStorage)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read Orphans DB Error",
+			check: func(t *testing.T, repo *repository.Attachments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, 10).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadOrphans(ctx, connection, 10)
+
+				require.ErrorIs(t, err, repository.ErrAttachmentsReadOrphans)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewAttachments(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.AuditLogRepository = (*AuditLog)(nil)
+
+var (
+	errAuditLog        = errors.New("audit log repository error")
+	ErrAuditLogCreate  = errors.Join(errAuditLog, errors.New("create failed"))
+	ErrAuditLogReadAll = errors.Join(errAuditLog, errors.New("read all failed"))
+)
+
+type AuditLog struct{}
+
+func NewAuditLog() *AuditLog {
+	return &AuditLog{}
+}
+
+func (r AuditLog) Create(ctx context.Context, connection domain.Connection, entry domain.AuditEntry) error {
+	const query = `insert into admin_audit_log (id, admin_id, action, target_id, details, created_at) values ($1, $2, $3, $4, $5, $6)`
+
+	_, err := connection.ExecContext(ctx, query, entry.ID, entry.AdminID, entry.Action, entry.TargetID, entry.Details, entry.CreatedAt)
+	if err != nil {
+		return errors.Join(ErrAuditLogCreate, err)
+	}
+
+	return nil
+}
+
+func (r AuditLog) ReadAll(ctx context.Context, connection domain.Connection, page domain.Page) ([]domain.AuditEntry, error) {
+	const query = `select id, admin_id, action, target_id, details, created_at
+from admin_audit_log
+order by created_at desc, id
+limit $1 offset $2`
+
+	entries := []domain.AuditEntry{}
+	if err := connection.SelectContext(ctx, &entries, query, page.Limit, page.Offset); err != nil {
+		return nil, errors.Join(ErrAuditLogReadAll, err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,94 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewAuditLog()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		admin := fixtureCreateUser(t, ctx, connection)
+		now := time.Now().Truncate(time.Microsecond)
+
+		older := domain.AuditEntry{ID: domain.AuditEntryID(uuid.New()), AdminID: admin.ID, Action: domain.AuditSearchUsers,
+			Details: `query "ann"`, CreatedAt: now.Add(-time.Minute)}
+		newer := domain.AuditEntry{ID: domain.AuditEntryID(uuid.New()), AdminID: admin.ID, Action: domain.AuditDisable,
+			TargetID: &admin.ID, CreatedAt: now}
+		require.NoError(t, repo.Create(ctx, connection, older))
+		require.NoError(t, repo.Create(ctx, connection, newer))
+
+		entries, err := repo.ReadAll(ctx, connection, domain.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, newer.ID, entries[0].ID)
+		require.Equal(t, admin.ID, *entries[0].TargetID)
+		require.Nil(t, entries[1].TargetID)
+
+		entries, err = repo.ReadAll(ctx, connection, domain.Page{Limit: 10, Offset: 1})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, older.Details, entries[0].Details)
+
+		return nil
+	})
+}
+
+func TestAuditLogUnit(t *testing.T) {
+	entry := domain.AuditEntry{ID: domain.AuditEntryID(uuid.New()), AdminID: domain.UserID(uuid.New()), Action: domain.AuditEnable}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.AuditLog, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.AuditLog, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, entry.ID, entry.AdminID, entry.Action, entry.TargetID, entry.Details, entry.CreatedAt).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, entry)
+
+				require.ErrorIs(t, err, repository.ErrAuditLogCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read All DB Error",
+			check: func(t *testing.T, repo *repository.AuditLog, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, 50, 0).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadAll(ctx, connection, domain.Page{Limit: 50})
+
+				require.ErrorIs(t, err, repository.ErrAuditLogReadAll)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewAuditLog(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
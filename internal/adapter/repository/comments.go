@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.CommentsRepository = (*Comments)(nil)
+
+var (
+	errComments             = errors.New("comments repository error")
+	ErrCommentsCreate       = errors.Join(errComments, errors.New("create failed"))
+	ErrCommentsReadAll      = errors.Join(errComments, errors.New("read all failed"))
+	ErrCommentsUpdate       = errors.Join(errComments, errors.New("update failed"))
+	ErrCommentsDelete       = errors.Join(errComments, errors.New("delete failed"))
+	ErrCommentsForbidden    = errors.Join(errComments, errors.New("comment not found or access denied"))
+	ErrCommentsReadByAuthor = errors.Join(errComments, errors.New("read by author failed"))
+)
+
+type Comments struct{}
+
+func NewComments() *Comments {
+	return &Comments{}
+}
+
+func (r Comments) Create(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	comment domain.Comment,
+) error {
+	exists, err := taskExists(ctx, connection, userID, workspaceID, comment.TaskID)
+	if err != nil {
+		return errors.Join(ErrCommentsCreate, err)
+	}
+	if !exists {
+		return errors.Join(ErrCommentsCreate, errors.New("task not found or access denied"))
+	}
+
+	const query = `insert into comments (id, task_id, author_id, body, created_at, updated_at) values ($1, $2, $3, $4, $5, $6)`
+
+	_, err = connection.ExecContext(ctx, query, comment.ID, comment.TaskID, comment.AuthorID, comment.Body, comment.CreatedAt, comment.UpdatedAt)
+	if err != nil {
+		return errors.Join(ErrCommentsCreate, err)
+	}
+
+	return nil
+}
+
+// ReadAll returns a page of the task's comments, oldest first.
+func (r Comments) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	taskID domain.TaskID, page domain.Page,
+) ([]domain.Comment, error) {
+	exists, err := taskExists(ctx, connection, userID, workspaceID, taskID)
+	if err != nil {
+		return nil, errors.Join(ErrCommentsReadAll, err)
+	}
+	if !exists {
+		return nil, errors.Join(ErrCommentsReadAll, errors.New("task not found or access denied"))
+	}
+
+	const query = `select id, task_id, author_id, body, created_at, updated_at
+from comments
+where task_id = $1
+order by created_at, id
+limit $2 offset $3`
+
+	comments := []domain.Comment{}
+	if err = connection.SelectContext(ctx, &comments, query, taskID, page.Limit, page.Offset); err != nil {
+		return nil, errors.Join(ErrCommentsReadAll, err)
+	}
+
+	return comments, nil
+}
+
+// Update changes the body of a comment. Only the author may edit it, and only
+// while they still have access to the task.
+func (r Comments) Update(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	comment domain.Comment,
+) (domain.Comment, error) {
+	if err := r.checkAuthor(ctx, connection, userID, workspaceID, comment.ID); err != nil {
+		return domain.Comment{}, errors.Join(ErrCommentsUpdate, err)
+	}
+
+	const query = `update comments set body = $2, updated_at = $3 where id = $1
+returning id, task_id, author_id, body, created_at, updated_at`
+
+	var updated domain.Comment
+	if err := connection.GetContext(ctx, &updated, query, comment.ID, comment.Body, comment.UpdatedAt); err != nil {
+		return domain.Comment{}, errors.Join(ErrCommentsUpdate, err)
+	}
+
+	return updated, nil
+}
+
+func (r Comments) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	commentID domain.CommentID,
+) error {
+	if err := r.checkAuthor(ctx, connection, userID, workspaceID, commentID); err != nil {
+		return errors.Join(ErrCommentsDelete, err)
+	}
+
+	const query = `delete from comments where id = $1`
+
+	if _, err := connection.ExecContext(ctx, query, commentID); err != nil {
+		return errors.Join(ErrCommentsDelete, err)
+	}
+
+	return nil
+}
+
+func (r Comments) checkAuthor(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	commentID domain.CommentID,
+) error {
+	var comment struct {
+		TaskID   domain.TaskID
+		AuthorID domain.UserID
+	}
+	if err := connection.GetContext(ctx, &comment, "select task_id, author_id from comments where id = $1", commentID); err != nil {
+		return err
+	}
+	if comment.AuthorID != userID {
+		return ErrCommentsForbidden
+	}
+
+	exists, err := taskExists(ctx, connection, userID, workspaceID, comment.TaskID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrCommentsForbidden
+	}
+
+	return nil
+}
+
+func (r Comments) ReadByAuthor(ctx context.Context, connection domain.Connection, userID domain.UserID) ([]domain.Comment, error) {
+	const query = `select id, task_id, author_id, body, created_at, updated_at
+from comments
+where author_id = $1
+order by created_at, id`
+
+	comments := []domain.Comment{}
+	if err := connection.SelectContext(ctx, &comments, query, userID); err != nil {
+		return nil, errors.Join(ErrCommentsReadByAuthor, err)
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,170 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentsIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewComments()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
+		list := fixtureCreateList(t, ctx, connection, user.ID)
+		task := fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "task name")
+
+		now := time.Now()
+		for _, body := range []string{"first", "second", "third"} {
+			comment := domain.Comment{
+				ID:        domain.CommentID(uuid.New()),
+				TaskID:    task.ID,
+				AuthorID:  user.ID,
+				Body:      body,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			require.NoError(t, repo.Create(ctx, connection, user.ID, workspaceID, comment))
+			now = now.Add(time.Second)
+		}
+
+		comments, err := repo.ReadAll(ctx, connection, user.ID, workspaceID, task.ID, domain.Page{Limit: 2, Offset: 1})
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+		require.Equal(t, "second", comments[0].Body)
+
+		readTask, err := repository.NewTasks().Read(ctx, connection, user.ID, workspaceID, task.ID)
+		require.NoError(t, err)
+		require.Equal(t, 3, readTask.CommentCount)
+
+		comment := comments[0]
+		comment.Body = "edited"
+		updated, err := repo.Update(ctx, connection, user.ID, workspaceID, comment)
+		require.NoError(t, err)
+		require.Equal(t, "edited", updated.Body)
+
+		_, err = repo.Update(ctx, connection, uuid.New(), workspaceID, comment)
+		require.ErrorIs(t, err, repository.ErrCommentsForbidden)
+
+		_, err = repo.ReadAll(ctx, connection, uuid.New(), workspaceID, task.ID, domain.Page{Limit: 10})
+		require.ErrorContains(t, err, "not found or access denied")
+
+		require.NoError(t, repo.Delete(ctx, connection, user.ID, workspaceID, comment.ID))
+
+		comments, err = repo.ReadAll(ctx, connection, user.ID, workspaceID, task.ID, domain.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+
+		return nil
+	})
+}
+
+func TestCommentsUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	validComment := domain.Comment{
+		ID:        domain.CommentID(uuid.New()),
+		TaskID:    domain.TaskID(uuid.New()),
+		AuthorID:  userID,
+		Body:      "some comment",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	listID := domain.ListID(uuid.New())
+	ctx := context.Background()
+
+	mockTaskExists := func(connection *dbMocks.MockConnection) {
+		connection.EXPECT().
+			GetContext(mock.Anything, mock.Anything, "select list_id from tasks where id = $1", validComment.TaskID).
+			Run(func(_ context.Context, dest any, _ string, _ ...any) { *dest.(*domain.ListID) = listID }).
+			Return(nil).
+			Once()
+		connection.EXPECT().
+			GetContext(mock.Anything, mock.Anything, mock.Anything, userID, workspaceID, listID).
+			Return(nil).
+			Once()
+	}
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.Comments, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.Comments, connection *dbMocks.MockConnection) {
+				mockTaskExists(connection)
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validComment.ID, validComment.TaskID, validComment.AuthorID,
+						validComment.Body, validComment.CreatedAt, validComment.UpdatedAt).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, userID, workspaceID, validComment)
+
+				require.ErrorIs(t, err, repository.ErrCommentsCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read All DB Error",
+			check: func(t *testing.T, repo *repository.Comments, connection *dbMocks.MockConnection) {
+				mockTaskExists(connection)
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, validComment.TaskID, 10, 20).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadAll(ctx, connection, userID, workspaceID, validComment.TaskID, domain.Page{Limit: 10, Offset: 20})
+
+				require.ErrorIs(t, err, repository.ErrCommentsReadAll)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Update by another author",
+			check: func(t *testing.T, repo *repository.Comments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validComment.ID).
+					Return(nil).
+					Once()
+
+				_, err := repo.Update(ctx, connection, userID, workspaceID, validComment)
+
+				require.ErrorIs(t, err, repository.ErrCommentsUpdate)
+				require.ErrorIs(t, err, repository.ErrCommentsForbidden)
+			},
+		},
+		{
+			name: "Delete DB Error",
+			check: func(t *testing.T, repo *repository.Comments, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validComment.ID).
+					Return(errors.New("some error")).
+					Once()
+
+				err := repo.Delete(ctx, connection, userID, workspaceID, validComment.ID)
+
+				require.ErrorIs(t, err, repository.ErrCommentsDelete)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewComments(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
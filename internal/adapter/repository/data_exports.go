@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.DataExportsRepository = (*DataExports)(nil)
+
+var (
+	errDataExports            = errors.New("data exports repository error")
+	ErrDataExportsCreate      = errors.Join(errDataExports, errors.New("create failed"))
+	ErrDataExportsReadAll     = errors.Join(errDataExports, errors.New("read all failed"))
+	ErrDataExportsClaim       = errors.Join(errDataExports, errors.New("claim failed"))
+	ErrDataExportsComplete    = errors.Join(errDataExports, errors.New("complete failed"))
+	ErrDataExportsFail        = errors.Join(errDataExports, errors.New("fail failed"))
+	ErrDataExportsReadByHash  = errors.Join(errDataExports, errors.New("read by hash failed"))
+	ErrDataExportsReadExpired = errors.Join(errDataExports, errors.New("read expired failed"))
+	ErrDataExportsDelete      = errors.Join(errDataExports, errors.New("delete failed"))
+)
+
+const dataExportColumns = `id, user_id, status, storage_key, size, coalesce(token_hash, '') as token_hash, created_at, expires_at`
+
+type DataExports struct{}
+
+func NewDataExports() *DataExports {
+	return &DataExports{}
+}
+
+func (r DataExports) Create(ctx context.Context, connection domain.Connection, export domain.DataExport) error {
+	const query = `insert into data_exports (id, user_id, status, created_at) values ($1, $2, $3, $4)`
+
+	_, err := connection.ExecContext(ctx, query, export.ID, export.UserID, export.Status, export.CreatedAt)
+	if err != nil {
+		return errors.Join(ErrDataExportsCreate, err)
+	}
+
+	return nil
+}
+
+func (r DataExports) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID) ([]domain.DataExport, error) {
+	const query = `select ` + dataExportColumns + ` from data_exports where user_id = $1 order by created_at desc`
+
+	exports := []domain.DataExport{}
+	if err := connection.SelectContext(ctx, &exports, query, userID); err != nil {
+		return nil, errors.Join(ErrDataExportsReadAll, err)
+	}
+
+	return exports, nil
+}
+
+// Claim skips the rows other workers locked, so every export is built once.
+func (r DataExports) Claim(ctx context.Context, connection domain.Connection, stale time.Duration) (domain.DataExport, error) {
+	const query = `update data_exports set status = 'running', started_at = now()
+where id = (
+    select id from data_exports
+    where user_id is not null and (status = 'pending' or status = 'running' and started_at < now() - make_interval(secs => $1))
+    order by created_at
+    limit 1
+    for update skip locked
+)
+returning ` + dataExportColumns
+
+	var export domain.DataExport
+	if err := connection.GetContext(ctx, &export, query, stale.Seconds()); err != nil {
+		return export, errors.Join(ErrDataExportsClaim, err)
+	}
+
+	return export, nil
+}
+
+func (r DataExports) Complete(ctx context.Context, connection domain.Connection, export domain.DataExport) error {
+	const query = `update data_exports set status = 'ready', storage_key = $2, size = $3, token_hash = $4, expires_at = $5
+where id = $1 and status = 'running'`
+
+	updated, err := connection.ExecContext(ctx, query, export.ID, export.StorageKey, export.Size, export.TokenHash, export.ExpiresAt)
+	if err != nil {
+		return errors.Join(ErrDataExportsComplete, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrDataExportsComplete, errors.New("export not found or not running"))
+	}
+
+	return nil
+}
+
+func (r DataExports) Fail(ctx context.Context, connection domain.Connection, exportID domain.DataExportID) error {
+	const query = `update data_exports set status = 'failed' where id = $1`
+
+	if _, err := connection.ExecContext(ctx, query, exportID); err != nil {
+		return errors.Join(ErrDataExportsFail, err)
+	}
+
+	return nil
+}
+
+func (r DataExports) ReadByHash(ctx context.Context, connection domain.Connection, tokenHash string) (domain.DataExport, error) {
+	const query = `select ` + dataExportColumns + ` from data_exports
+where token_hash = $1 and status = 'ready' and expires_at > now() and user_id is not null`
+
+	var export domain.DataExport
+	if err := connection.GetContext(ctx, &export, query, tokenHash); err != nil {
+		return export, errors.Join(ErrDataExportsReadByHash, err)
+	}
+
+	return export, nil
+}
+
+// ReadExpired returns the exports of deleted users too. Failed exports are
+// kept for a day, so the user can see what happened.
+func (r DataExports) ReadExpired(ctx context.Context, connection domain.Connection, limit int) ([]domain.DataExport, error) {
+	const query = `select id, storage_key from data_exports
+where user_id is null or expires_at < now() or status = 'failed' and created_at < now() - interval '1 day'
+order by created_at
+limit $1`
+
+	var exports []domain.DataExport
+	if err := connection.SelectContext(ctx, &exports, query, limit); err != nil {
+		return nil, errors.Join(ErrDataExportsReadExpired, err)
+	}
+
+	return exports, nil
+}
+
+func (r DataExports) Delete(ctx context.Context, connection domain.Connection, exportID domain.DataExportID) error {
+	const query = `delete from data_exports where id = $1`
+
+	if _, err := connection.ExecContext(ctx, query, exportID); err != nil {
+		return errors.Join(ErrDataExportsDelete, err)
+	}
+
+	return nil
+}
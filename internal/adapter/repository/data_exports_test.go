@@ -0,0 +1,103 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataExportsIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewDataExports()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+
+		export := domain.DataExport{ID: uuid.New(), UserID: user.ID, Status: domain.DataExportPending, CreatedAt: time.Now()}
+		require.NoError(t, repo.Create(ctx, connection, export))
+
+		claimed, err := repo.Claim(ctx, connection, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, export.ID, claimed.ID)
+		require.Equal(t, domain.DataExportRunning, claimed.Status)
+		_, err = repo.Claim(ctx, connection, time.Hour)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		expiresAt := time.Now().Add(time.Hour)
+		claimed.StorageKey, claimed.Size, claimed.TokenHash, claimed.ExpiresAt = "key", 42, "hash", &expiresAt
+		require.NoError(t, repo.Complete(ctx, connection, claimed))
+		require.ErrorIs(t, repo.Complete(ctx, connection, claimed), repository.ErrDataExportsComplete)
+
+		ready, err := repo.ReadByHash(ctx, connection, "hash")
+		require.NoError(t, err)
+		require.Equal(t, domain.DataExportReady, ready.Status)
+		require.EqualValues(t, 42, ready.Size)
+
+		expired, err := repo.ReadExpired(ctx, connection, 10)
+		require.NoError(t, err)
+		require.Empty(t, expired)
+
+		require.NoError(t, repo.Delete(ctx, connection, export.ID))
+		exports, err := repo.ReadAll(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Empty(t, exports)
+
+		return nil
+	})
+}
+
+func TestDataExportsUnit(t *testing.T) {
+	export := domain.DataExport{ID: uuid.New(), UserID: domain.UserID(uuid.New()), Status: domain.DataExportRunning}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.DataExports, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Claim DB Error",
+			check: func(t *testing.T, repo *repository.DataExports, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, float64(60)).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Claim(ctx, connection, time.Minute)
+
+				require.ErrorIs(t, err, repository.ErrDataExportsClaim)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Complete Not Running",
+			check: func(t *testing.T, repo *repository.DataExports, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, export.ID, export.StorageKey, export.Size, export.TokenHash, export.ExpiresAt).
+					Return(0, nil).
+					Once()
+
+				err := repo.Complete(ctx, connection, export)
+
+				require.ErrorIs(t, err, repository.ErrDataExportsComplete)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewDataExports(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.FeedTokensRepository = (*FeedTokens)(nil)
+
+var (
+	errFeedTokens           = errors.New("feed tokens repository error")
+	ErrFeedTokensCreate     = errors.Join(errFeedTokens, errors.New("create failed"))
+	ErrFeedTokensReadByHash = errors.Join(errFeedTokens, errors.New("read by hash failed"))
+	ErrFeedTokensReadAll    = errors.Join(errFeedTokens, errors.New("read all failed"))
+	ErrFeedTokensRevoke     = errors.Join(errFeedTokens, errors.New("revoke failed"))
+	ErrFeedTokensRevokeAll  = errors.Join(errFeedTokens, errors.New("revoke all failed"))
+)
+
+type FeedTokens struct{}
+
+func NewFeedTokens() *FeedTokens {
+	return &FeedTokens{}
+}
+
+func (r FeedTokens) Create(ctx context.Context, connection domain.Connection, token domain.FeedToken) error {
+	const query = `insert into feed_tokens (id, user_id, workspace_id, token_hash, created_at) values ($1, $2, $3, $4, $5)`
+
+	_, err := connection.ExecContext(ctx, query, token.ID, token.UserID, token.WorkspaceID, token.TokenHash, token.CreatedAt)
+	if err != nil {
+		return errors.Join(ErrFeedTokensCreate, err)
+	}
+
+	return nil
+}
+
+func (r FeedTokens) ReadByHash(ctx context.Context, connection domain.Connection, tokenHash string) (domain.FeedToken, error) {
+	const query = `select id, user_id, workspace_id, token_hash, created_at, revoked_at from feed_tokens
+where token_hash = $1 and revoked_at is null`
+
+	var token domain.FeedToken
+	if err := connection.GetContext(ctx, &token, query, tokenHash); err != nil {
+		return token, errors.Join(ErrFeedTokensReadByHash, err)
+	}
+
+	return token, nil
+}
+
+func (r FeedTokens) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID) ([]domain.FeedToken, error) {
+	const query = `select id, user_id, workspace_id, token_hash, created_at, revoked_at from feed_tokens
+where user_id = $1 and workspace_id = $2 order by created_at`
+
+	var tokens []domain.FeedToken
+	if err := connection.SelectContext(ctx, &tokens, query, userID, workspaceID); err != nil {
+		return nil, errors.Join(ErrFeedTokensReadAll, err)
+	}
+
+	return tokens, nil
+}
+
+func (r FeedTokens) Revoke(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID, tokenID domain.FeedTokenID) error {
+	const query = `update feed_tokens set revoked_at = now()
+where user_id = $1 and workspace_id = $2 and id = $3 and revoked_at is null`
+
+	updated, err := connection.ExecContext(ctx, query, userID, workspaceID, tokenID)
+	if err != nil {
+		return errors.Join(ErrFeedTokensRevoke, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrFeedTokensRevoke, errors.New("token not found or already revoked"))
+	}
+
+	return nil
+}
+
+func (r FeedTokens) RevokeAll(ctx context.Context, connection domain.Connection, userID domain.UserID) error {
+	const query = `update feed_tokens set revoked_at = now() where user_id = $1 and revoked_at is null`
+
+	if _, err := connection.ExecContext(ctx, query, userID); err != nil {
+		return errors.Join(ErrFeedTokensRevokeAll, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedTokensIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewFeedTokens()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		token := domain.FeedToken{
+			ID:          domain.FeedTokenID(uuid.New()),
+			UserID:      user.ID,
+			WorkspaceID: domain.PersonalWorkspace(user.ID),
+			TokenHash:   "some token hash",
+			CreatedAt:   time.Now(),
+		}
+		require.NoError(t, repo.Create(ctx, connection, token))
+
+		readToken, err := repo.ReadByHash(ctx, connection, token.TokenHash)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, readToken.UserID)
+		require.Equal(t, token.WorkspaceID, readToken.WorkspaceID)
+
+		tokens, err := repo.ReadAll(ctx, connection, user.ID, token.WorkspaceID)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+
+		otherWorkspace := domain.WorkspaceID(uuid.New())
+		tokens, err = repo.ReadAll(ctx, connection, user.ID, otherWorkspace)
+		require.NoError(t, err)
+		require.Empty(t, tokens)
+		require.Error(t, repo.Revoke(ctx, connection, user.ID, otherWorkspace, token.ID))
+
+		require.NoError(t, repo.Revoke(ctx, connection, user.ID, token.WorkspaceID, token.ID))
+		require.Error(t, repo.Revoke(ctx, connection, user.ID, token.WorkspaceID, token.ID))
+
+		_, err = repo.ReadByHash(ctx, connection, token.TokenHash)
+		require.Error(t, err)
+
+		return nil
+	})
+}
+
+func TestFeedTokensUnit(t *testing.T) {
+	validToken := domain.FeedToken{
+		ID:          domain.FeedTokenID(uuid.New()),
+		UserID:      domain.UserID(uuid.New()),
+		WorkspaceID: domain.WorkspaceID(uuid.New()),
+		TokenHash:   "some token hash",
+		CreatedAt:   time.Now(),
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.FeedTokens, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.FeedTokens, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validToken.ID, validToken.UserID, validToken.WorkspaceID, validToken.TokenHash, validToken.CreatedAt).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, validToken)
+
+				require.ErrorIs(t, err, repository.ErrFeedTokensCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read by Hash DB Error",
+			check: func(t *testing.T, repo *repository.FeedTokens, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validToken.TokenHash).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadByHash(ctx, connection, validToken.TokenHash)
+
+				require.ErrorIs(t, err, repository.ErrFeedTokensReadByHash)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read All DB Error",
+			check: func(t *testing.T, repo *repository.FeedTokens, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, validToken.UserID, validToken.WorkspaceID).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadAll(ctx, connection, validToken.UserID, validToken.WorkspaceID)
+
+				require.ErrorIs(t, err, repository.ErrFeedTokensReadAll)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Revoke not found",
+			check: func(t *testing.T, repo *repository.FeedTokens, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validToken.UserID, validToken.WorkspaceID, validToken.ID).
+					Return(0, nil).
+					Once()
+
+				err := repo.Revoke(ctx, connection, validToken.UserID, validToken.WorkspaceID, validToken.ID)
+
+				require.ErrorIs(t, err, repository.ErrFeedTokensRevoke)
+				require.ErrorContains(t, err, "not found")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewFeedTokens(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
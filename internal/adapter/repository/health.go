@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.HealthRepository = (*Health)(nil)
+
+var (
+	errHealth                  = errors.New("health repository error")
+	ErrHealthPing              = errors.Join(errHealth, errors.New("ping failed"))
+	ErrHealthReadSchemaVersion = errors.Join(errHealth, errors.New("read schema version failed"))
+)
+
+type Health struct{}
+
+func NewHealth() *Health {
+	return &Health{}
+}
+
+func (r Health) Ping(ctx context.Context, connection domain.Connection) error {
+	var one int
+	if err := connection.GetContext(ctx, &one, "select 1"); err != nil {
+		return errors.Join(ErrHealthPing, err)
+	}
+
+	return nil
+}
+
+func (r Health) ReadSchemaVersion(ctx context.Context, connection domain.Connection) (int, error) {
+	const query = `select version from schema_version`
+
+	var version int
+	if err := connection.GetContext(ctx, &version, query); err != nil {
+		return 0, errors.Join(ErrHealthReadSchemaVersion, err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewHealth()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+		require.NoError(t, repo.Ping(ctx, connection))
+
+		version, err := repo.ReadSchemaVersion(ctx, connection)
+		require.NoError(t, err)
+		require.Equal(t, domain.SchemaVersion, version)
+
+		return nil
+	})
+}
+
+func TestHealthUnit(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.Health, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Ping DB Error",
+			check: func(t *testing.T, repo *repository.Health, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, "select 1").
+					Return(errors.New("some error")).
+					Once()
+
+				err := repo.Ping(ctx, connection)
+
+				require.ErrorIs(t, err, repository.ErrHealthPing)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read Schema Version DB Error",
+			check: func(t *testing.T, repo *repository.Health, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadSchemaVersion(ctx, connection)
+
+				require.ErrorIs(t, err, repository.ErrHealthReadSchemaVersion)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewHealth(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
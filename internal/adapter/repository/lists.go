@@ -16,6 +16,10 @@ var (
 	ErrListsUpdate  = errors.Join(errLists, errors.New("update failed"))
 	ErrListsDelete  = errors.Join(errLists, errors.New("delete failed"))
 	ErrListsReadAll = errors.Join(errLists, errors.New("read all failed"))
+
+	ErrListsReadMembers  = errors.Join(errLists, errors.New("read members failed"))
+	ErrListsAddMember    = errors.Join(errLists, errors.New("add member failed"))
+	ErrListsRemoveMember = errors.Join(errLists, errors.New("remove member failed"))
 )
 
 type Lists struct{}
@@ -26,33 +30,40 @@ func NewLists() *Lists {
 
 func (r Lists) Create(ctx context.Context, connection domain.Connection, list domain.List) error {
 	const query = `insert into lists
-    (id, user_id, name, updated_at)
-	values
-    ($1, $2, $3, default)`
+    (id, user_id, workspace_id, name, updated_at)
+select $1, $2, $3, $4, now()
+where exists (select 1 from workspace_members where workspace_id = $3 and user_id = $2)`
 
-	_, err := connection.ExecContext(ctx, query, list.ID, list.UserID, list.Name)
+	created, err := connection.ExecContext(ctx, query, list.ID, list.UserID, list.WorkspaceID, list.Name)
 	if err != nil {
 		return errors.Join(ErrListsCreate, err)
 	}
+	if created <= 0 {
+		return errors.Join(ErrListsCreate, errors.New("workspace not found or access denied"))
+	}
 
 	return nil
 }
 
-func (r Lists) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID, listID domain.ListID) error {
-	const query = `delete from lists where user_id = $1 and id = $2`
+func (r Lists) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.ListID,
+) error {
+	const query = `delete from lists where user_id = $1 and workspace_id = $2 and id = $3`
 
-	if _, err := connection.ExecContext(ctx, query, userID, listID); err != nil {
+	if _, err := connection.ExecContext(ctx, query, userID, workspaceID, listID); err != nil {
 		return errors.Join(ErrListsDelete, err)
 	}
 
 	return nil
 }
 
-func (r Lists) Read(ctx context.Context, connection domain.Connection, userID domain.UserID, listID domain.ListID) (domain.List, error) {
-	const query = `select id, user_id, name, updated_at from lists where user_id = $1 and id = $2`
+func (r Lists) Read(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.ListID,
+) (domain.List, error) {
+	const query = `select id, user_id, workspace_id, name, updated_at from lists where user_id = $1 and workspace_id = $2 and id = $3`
 
 	var list domain.List
-	if err := connection.GetContext(ctx, &list, query, userID, listID); err != nil {
+	if err := connection.GetContext(ctx, &list, query, userID, workspaceID, listID); err != nil {
 		return list, errors.Join(ErrListsRead, err)
 	}
 
@@ -60,26 +71,110 @@ func (r Lists) Read(ctx context.Context, connection domain.Connection, userID do
 }
 
 func (r Lists) Update(ctx context.Context, connection domain.Connection, list domain.List) error {
-	const query = `update lists set name = $3, updated_at = default where user_id = $1 and id = $2`
+	const query = `update lists set name = $4, updated_at = default where user_id = $1 and workspace_id = $2 and id = $3`
 
 	if list.Tasks != nil {
 		return errors.Join(ErrListsUpdate, errors.New("task updates are not supported"))
 	}
 
-	if _, err := connection.ExecContext(ctx, query, list.UserID, list.ID, list.Name); err != nil {
+	if _, err := connection.ExecContext(ctx, query, list.UserID, list.WorkspaceID, list.ID, list.Name); err != nil {
 		return errors.Join(ErrListsUpdate, err)
 	}
 
 	return nil
 }
 
-func (r Lists) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID) ([]domain.List, error) {
-	const query = `select id, user_id, name, updated_at from lists where user_id = $1`
+// ReadAll returns the lists of the workspace the user owns along with the
+// lists shared with them.
+func (r Lists) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID) ([]domain.List, error) {
+	const query = `select id, user_id, workspace_id, name, updated_at from lists where id in (` + accessibleLists + `)`
 
 	var lists []domain.List
-	if err := connection.SelectContext(ctx, &lists, query, userID); err != nil {
+	if err := connection.SelectContext(ctx, &lists, query, userID, workspaceID); err != nil {
 		return nil, errors.Join(ErrListsReadAll, err)
 	}
 
 	return lists, nil
 }
+
+// ReadMembers returns the owner first, then the users the list is shared with.
+func (r Lists) ReadMembers(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.ListID,
+) ([]domain.Member, error) {
+	exists, err := listExists(ctx, connection, userID, workspaceID, listID)
+	if err != nil {
+		return nil, errors.Join(ErrListsReadMembers, err)
+	}
+	if !exists {
+		return nil, errors.Join(ErrListsReadMembers, errors.New("list not found or access denied"))
+	}
+
+	const query = `select u.id, u.name, u.email, true as owner
+from users u join lists l on l.user_id = u.id
+where l.id = $1
+union all
+select u.id, u.name, u.email, false as owner
+from users u join list_members m on m.user_id = u.id
+where m.list_id = $1
+order by owner desc, name`
+
+	var members []domain.Member
+	if err = connection.SelectContext(ctx, &members, query, listID); err != nil {
+		return nil, errors.Join(ErrListsReadMembers, err)
+	}
+
+	return members, nil
+}
+
+// AddMember shares the list with the member of its workspace registered with
+// the email. Only the owner may share a list.
+func (r Lists) AddMember(ctx context.Context, connection domain.Connection, ownerID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.ListID, email string,
+) error {
+	const query = `insert into list_members (list_id, user_id)
+select l.id, u.id from lists l
+join workspace_members w on w.workspace_id = l.workspace_id
+join users u on u.id = w.user_id
+where l.user_id = $1 and l.workspace_id = $2 and l.id = $3 and u.email = $4 and u.id <> l.user_id`
+
+	added, err := connection.ExecContext(ctx, query, ownerID, workspaceID, listID, email)
+	if err != nil {
+		return errors.Join(ErrListsAddMember, err)
+	}
+	if added <= 0 {
+		return errors.Join(ErrListsAddMember, errors.New("list or user not found or access denied"))
+	}
+
+	return nil
+}
+
+// RemoveMember lets the owner remove anyone and members leave on their own.
+// The removed member's assignments in the list are dropped too, before the
+// member leaves: row-level security hides the tasks from a member leaving on
+// their own afterwards. The transaction of the caller brings them back when
+// the member isn't removed.
+func (r Lists) RemoveMember(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.ListID, memberID domain.UserID,
+) error {
+	const unassign = `delete from task_assignees a
+using tasks t
+where t.id = a.task_id and t.list_id = $1 and a.user_id = $2`
+
+	if _, err := connection.ExecContext(ctx, unassign, listID, memberID); err != nil {
+		return errors.Join(ErrListsRemoveMember, err)
+	}
+
+	const query = `delete from list_members m
+using lists l
+where l.id = m.list_id and l.workspace_id = $2 and m.list_id = $3 and m.user_id = $4 and (l.user_id = $1 or m.user_id = $1)`
+
+	removed, err := connection.ExecContext(ctx, query, userID, workspaceID, listID, memberID)
+	if err != nil {
+		return errors.Join(ErrListsRemoveMember, err)
+	}
+	if removed <= 0 {
+		return errors.Join(ErrListsRemoveMember, errors.New("member not found or access denied"))
+	}
+
+	return nil
+}
@@ -17,46 +17,117 @@ import (
 )
 
 func TestListsIntegration(t *testing.T) {
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 	repoList := repository.NewLists()
 	provider := cleanTablesAndCreateProvider(ctx, t)
 	defer func() { _ = provider.Close() }()
 
 	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
 		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
 
 		list := fixtureCreateList(t, ctx, connection, user.ID)
 
 		list.Name = "new list name"
 		require.NoError(t, repoList.Update(ctx, connection, list))
 
-		newList, err := repoList.Read(ctx, connection, list.UserID, list.ID)
+		newList, err := repoList.Read(ctx, connection, list.UserID, workspaceID, list.ID)
 		require.NoError(t, err)
 		require.Equal(t, list.Name, newList.Name)
 
-		allListsBeforeDelete, err := repoList.ReadAll(ctx, connection, user.ID)
+		allListsBeforeDelete, err := repoList.ReadAll(ctx, connection, user.ID, workspaceID)
 		require.NoError(t, err)
 
-		require.NoError(t, repoList.Delete(ctx, connection, user.ID, list.ID))
+		require.NoError(t, repoList.Delete(ctx, connection, user.ID, workspaceID, list.ID))
 
-		allListsAfterDelete, err := repoList.ReadAll(ctx, connection, user.ID)
+		allListsAfterDelete, err := repoList.ReadAll(ctx, connection, user.ID, workspaceID)
 		require.NoError(t, err)
 		require.Equal(t, len(allListsBeforeDelete)-1, len(allListsAfterDelete))
 
-		_, err = repoList.Read(ctx, connection, list.UserID, list.ID)
+		_, err = repoList.Read(ctx, connection, list.UserID, workspaceID, list.ID)
 		require.ErrorIs(t, err, sql.ErrNoRows)
 
 		return nil
 	})
 }
 
+func TestListsIntegrationMembers(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+	repoList := repository.NewLists()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		owner := fixtureCreateUser(t, ctx, connection)
+		member := domain.User{
+			ID:           domain.UserID(uuid.New()),
+			Name:         "member name",
+			Email:        "member@email.foo",
+			PasswordHash: "some password hash",
+			Token:        "another secret token",
+		}
+		require.NoError(t, repository.NewUsers().Create(ctx, connection, member))
+
+		workspaces := repository.NewWorkspaces()
+		workspaceID := domain.WorkspaceID(uuid.New())
+		require.NoError(t, workspaces.Create(ctx, connection, domain.Workspace{ID: workspaceID, Name: "team", CreatedAt: time.Now()}, owner.ID))
+
+		list := domain.List{ID: domain.ListID(uuid.New()), UserID: owner.ID, WorkspaceID: workspaceID, Name: "shared list"}
+		require.NoError(t, repoList.Create(ctx, connection, list))
+		require.Error(t, repoList.Create(ctx, connection, domain.List{ID: domain.ListID(uuid.New()), UserID: member.ID, WorkspaceID: workspaceID}))
+		task := domain.Task{ID: domain.TaskID(uuid.New()), ListID: list.ID, Priority: "low", Name: "shared task"}
+		require.NoError(t, repository.NewTasks().Create(ctx, connection, owner.ID, workspaceID, task))
+
+		// Lists are shared with members of the workspace only.
+		require.Error(t, repoList.AddMember(ctx, connection, owner.ID, workspaceID, list.ID, member.Email))
+		require.NoError(t, workspaces.AddMember(ctx, connection, workspaceID, member.ID, domain.WorkspaceRoleMember))
+
+		require.Error(t, repoList.AddMember(ctx, connection, member.ID, workspaceID, list.ID, owner.Email))
+		require.NoError(t, repoList.AddMember(ctx, connection, owner.ID, workspaceID, list.ID, member.Email))
+
+		lists, err := repoList.ReadAll(ctx, connection, member.ID, domain.PersonalWorkspace(owner.ID))
+		require.NoError(t, err)
+		require.Empty(t, lists)
+
+		lists, err = repoList.ReadAll(ctx, connection, member.ID, workspaceID)
+		require.NoError(t, err)
+		require.Len(t, lists, 1)
+		require.Equal(t, owner.ID, lists[0].UserID)
+
+		members, err := repoList.ReadMembers(ctx, connection, member.ID, workspaceID, list.ID)
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		require.True(t, members[0].Owner)
+
+		task.Assignees = []domain.UserID{member.ID}
+		require.NoError(t, repository.NewTasks().Update(ctx, connection, member.ID, workspaceID, task))
+
+		assigned, err := repository.NewTasks().Find(ctx, connection, member.ID, workspaceID, domain.TaskFilter{AssigneeIDs: []domain.UserID{member.ID}})
+		require.NoError(t, err)
+		require.Len(t, assigned, 1)
+		require.Equal(t, []domain.UserID{member.ID}, assigned[0].Assignees)
+
+		require.NoError(t, repoList.RemoveMember(ctx, connection, member.ID, workspaceID, list.ID, member.ID))
+
+		readTask, err := repository.NewTasks().Read(ctx, connection, owner.ID, workspaceID, task.ID)
+		require.NoError(t, err)
+		require.Empty(t, readTask.Assignees)
+
+		_, err = repository.NewTasks().Read(ctx, connection, member.ID, workspaceID, task.ID)
+		require.ErrorContains(t, err, "not found or access denied")
+
+		return nil
+	})
+}
+
 func TestListsUnit(t *testing.T) {
 	validEmptyList := domain.List{
-		ID:        domain.ListID(uuid.New()),
-		UserID:    domain.UserID(uuid.New()),
-		Name:      "Some list name",
-		UpdatedAt: time.Now().Add(-time.Hour),
-		Tasks:     nil,
+		ID:          domain.ListID(uuid.New()),
+		UserID:      domain.UserID(uuid.New()),
+		WorkspaceID: domain.WorkspaceID(uuid.New()),
+		Name:        "Some list name",
+		UpdatedAt:   time.Now().Add(-time.Hour),
+		Tasks:       nil,
 	}
 	ctx := context.Background()
 
@@ -68,7 +139,8 @@ func TestListsUnit(t *testing.T) {
 			name: "Create DB Error",
 			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
 				connection.EXPECT().
-					ExecContext(mock.Anything, mock.Anything, validEmptyList.ID, validEmptyList.UserID, validEmptyList.Name).
+					ExecContext(mock.Anything, mock.Anything, validEmptyList.ID, validEmptyList.UserID, validEmptyList.WorkspaceID,
+						validEmptyList.Name).
 					Return(0, errors.New("some error")).
 					Once()
 
@@ -78,15 +150,30 @@ func TestListsUnit(t *testing.T) {
 				require.ErrorContains(t, err, "some error")
 			},
 		},
+		{
+			name: "Create Not Workspace Member",
+			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyList.ID, validEmptyList.UserID, validEmptyList.WorkspaceID,
+						validEmptyList.Name).
+					Return(0, nil).
+					Once()
+
+				err := repo.Create(ctx, connection, validEmptyList)
+
+				require.ErrorIs(t, err, repository.ErrListsCreate)
+				require.ErrorContains(t, err, "access denied")
+			},
+		},
 		{
 			name: "Delete DB Error",
 			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
 				connection.EXPECT().
-					ExecContext(mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.ID).
+					ExecContext(mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID).
 					Return(0, errors.New("some error")).
 					Once()
 
-				err := repo.Delete(ctx, connection, validEmptyList.UserID, validEmptyList.ID)
+				err := repo.Delete(ctx, connection, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID)
 
 				require.ErrorIs(t, err, repository.ErrListsDelete)
 				require.ErrorContains(t, err, "some error")
@@ -96,11 +183,11 @@ func TestListsUnit(t *testing.T) {
 			name: "Read DB Error",
 			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
 				connection.EXPECT().
-					GetContext(mock.Anything, mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.ID).
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID).
 					Return(errors.New("some error")).
 					Once()
 
-				_, err := repo.Read(ctx, connection, validEmptyList.UserID, validEmptyList.ID)
+				_, err := repo.Read(ctx, connection, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID)
 
 				require.ErrorIs(t, err, repository.ErrListsRead)
 				require.ErrorContains(t, err, "some error")
@@ -122,7 +209,7 @@ func TestListsUnit(t *testing.T) {
 			name: "Update DB Error",
 			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
 				connection.EXPECT().
-					ExecContext(mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.ID, validEmptyList.Name).
+					ExecContext(mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID, validEmptyList.Name).
 					Return(0, errors.New("some error")).
 					Once()
 
@@ -136,16 +223,62 @@ func TestListsUnit(t *testing.T) {
 			name: "Read All DB Error",
 			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
 				connection.EXPECT().
-					SelectContext(mock.Anything, mock.Anything, mock.Anything, validEmptyList.UserID).
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.WorkspaceID).
 					Return(errors.New("some error")).
 					Once()
 
-				_, err := repo.ReadAll(ctx, connection, validEmptyList.UserID)
+				_, err := repo.ReadAll(ctx, connection, validEmptyList.UserID, validEmptyList.WorkspaceID)
 
 				require.ErrorIs(t, err, repository.ErrListsReadAll)
 				require.ErrorContains(t, err, "some error")
 			},
 		},
+		{
+			name: "Add Member not found",
+			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID, "member@email.foo").
+					Return(0, nil).
+					Once()
+
+				err := repo.AddMember(ctx, connection, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID, "member@email.foo")
+
+				require.ErrorIs(t, err, repository.ErrListsAddMember)
+				require.ErrorContains(t, err, "not found")
+			},
+		},
+		{
+			name: "Read Members access denied",
+			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID).
+					Return(sql.ErrNoRows).
+					Once()
+
+				_, err := repo.ReadMembers(ctx, connection, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID)
+
+				require.ErrorIs(t, err, repository.ErrListsReadMembers)
+				require.ErrorContains(t, err, "access denied")
+			},
+		},
+		{
+			name: "Remove Member not found",
+			check: func(t *testing.T, repo *repository.Lists, connection *dbMocks.MockConnection) {
+				memberID := domain.UserID(uuid.New())
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyList.ID, memberID).
+					Return(0, nil).
+					Once()
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID, memberID).
+					Return(0, nil).
+					Once()
+
+				err := repo.RemoveMember(ctx, connection, validEmptyList.UserID, validEmptyList.WorkspaceID, validEmptyList.ID, memberID)
+
+				require.ErrorIs(t, err, repository.ErrListsRemoveMember)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -156,10 +289,11 @@ func TestListsUnit(t *testing.T) {
 
 func fixtureCreateList(t *testing.T, ctx context.Context, connection domain.Connection, userID domain.UserID) domain.List {
 	list := domain.List{
-		ID:        domain.ListID(uuid.New()),
-		UserID:    userID,
-		Name:      "list name",
-		UpdatedAt: time.Now(),
+		ID:          domain.ListID(uuid.New()),
+		UserID:      userID,
+		WorkspaceID: domain.PersonalWorkspace(userID),
+		Name:        "list name",
+		UpdatedAt:   time.Now(),
 	}
 	require.NoError(t, repository.NewLists().Create(ctx, connection, list))
 
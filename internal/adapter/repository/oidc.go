@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.OIDCRepository = (*OIDC)(nil)
+
+var (
+	errOIDC            = errors.New("oidc repository error")
+	ErrOIDCReadUser    = errors.Join(errOIDC, errors.New("read user failed"))
+	ErrOIDCLink        = errors.Join(errOIDC, errors.New("link failed"))
+	ErrOIDCCreateLogin = errors.Join(errOIDC, errors.New("create login failed"))
+	ErrOIDCUseLogin    = errors.Join(errOIDC, errors.New("use login failed"))
+)
+
+type OIDC struct{}
+
+func NewOIDC() *OIDC {
+	return &OIDC{}
+}
+
+func (r OIDC) ReadUser(ctx context.Context, connection domain.Connection, issuer string, subject string) (domain.UserID, error) {
+	const query = `select user_id from user_identities where issuer = $1 and subject = $2`
+
+	var userID domain.UserID
+	if err := connection.GetContext(ctx, &userID, query, issuer, subject); err != nil {
+		return userID, errors.Join(ErrOIDCReadUser, err)
+	}
+
+	return userID, nil
+}
+
+func (r OIDC) Link(ctx context.Context, connection domain.Connection, identity domain.Identity, userID domain.UserID) error {
+	const query = `insert into user_identities (issuer, subject, user_id, email) values ($1, $2, $3, $4)`
+
+	if _, err := connection.ExecContext(ctx, query, identity.Issuer, identity.Subject, userID, identity.Email); err != nil {
+		return errors.Join(ErrOIDCLink, err)
+	}
+
+	return nil
+}
+
+func (r OIDC) CreateLogin(ctx context.Context, connection domain.Connection, login domain.OIDCLogin) error {
+	if _, err := connection.ExecContext(ctx, `delete from oidc_logins where expires_at < now()`); err != nil {
+		return errors.Join(ErrOIDCCreateLogin, err)
+	}
+
+	const query = `insert into oidc_logins (state_hash, verifier, nonce, expires_at) values ($1, $2, $3, $4)`
+	if _, err := connection.ExecContext(ctx, query, login.StateHash, login.Verifier, login.Nonce, login.ExpiresAt); err != nil {
+		return errors.Join(ErrOIDCCreateLogin, err)
+	}
+
+	return nil
+}
+
+func (r OIDC) UseLogin(ctx context.Context, connection domain.Connection, stateHash string) (domain.OIDCLogin, error) {
+	const query = `
+delete from oidc_logins where state_hash = $1 and expires_at > now()
+returning state_hash, verifier, nonce, expires_at`
+
+	var login domain.OIDCLogin
+	if err := connection.GetContext(ctx, &login, query, stateHash); err != nil {
+		return login, errors.Join(ErrOIDCUseLogin, err)
+	}
+
+	return login, nil
+}
@@ -0,0 +1,111 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewOIDC()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		identity := domain.Identity{Issuer: "https://idp.example", Subject: "subject", Email: user.Email}
+
+		_, err := repo.ReadUser(ctx, connection, identity.Issuer, identity.Subject)
+		require.ErrorIs(t, err, repository.ErrOIDCReadUser)
+
+		require.NoError(t, repo.Link(ctx, connection, identity, user.ID))
+		userID, err := repo.ReadUser(ctx, connection, identity.Issuer, identity.Subject)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, userID)
+
+		login := domain.OIDCLogin{StateHash: "state", Verifier: "verifier", Nonce: "nonce", ExpiresAt: time.Now().Add(time.Minute)}
+		require.NoError(t, repo.CreateLogin(ctx, connection, login))
+		require.NoError(t, repo.CreateLogin(ctx, connection, domain.OIDCLogin{
+			StateHash: "expired", Verifier: "verifier", Nonce: "nonce", ExpiresAt: time.Now().Add(-time.Minute),
+		}))
+
+		used, err := repo.UseLogin(ctx, connection, login.StateHash)
+		require.NoError(t, err)
+		require.Equal(t, login.Verifier, used.Verifier)
+		require.Equal(t, login.Nonce, used.Nonce)
+
+		_, err = repo.UseLogin(ctx, connection, login.StateHash)
+		require.ErrorIs(t, err, repository.ErrOIDCUseLogin)
+		_, err = repo.UseLogin(ctx, connection, "expired")
+		require.ErrorIs(t, err, repository.ErrOIDCUseLogin)
+
+		return nil
+	})
+}
+
+func TestOIDCUnit(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.OIDC, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Read User DB Error",
+			check: func(t *testing.T, repo *repository.OIDC, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, "issuer", "subject").
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadUser(ctx, connection, "issuer", "subject")
+
+				require.ErrorIs(t, err, repository.ErrOIDCReadUser)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Create Login Sweep DB Error",
+			check: func(t *testing.T, repo *repository.OIDC, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.CreateLogin(ctx, connection, domain.OIDCLogin{StateHash: "state"})
+
+				require.ErrorIs(t, err, repository.ErrOIDCCreateLogin)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Use Login DB Error",
+			check: func(t *testing.T, repo *repository.OIDC, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, "state").
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.UseLogin(ctx, connection, "state")
+
+				require.ErrorIs(t, err, repository.ErrOIDCUseLogin)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewOIDC(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.PasswordResetsRepository = (*PasswordResets)(nil)
+
+var (
+	errPasswordResets       = errors.New("password resets repository error")
+	ErrPasswordResetsCreate = errors.Join(errPasswordResets, errors.New("create failed"))
+	ErrPasswordResetsUse    = errors.Join(errPasswordResets, errors.New("use failed"))
+	ErrPasswordResetsUseAll = errors.Join(errPasswordResets, errors.New("use all failed"))
+)
+
+type PasswordResets struct{}
+
+func NewPasswordResets() *PasswordResets {
+	return &PasswordResets{}
+}
+
+func (r PasswordResets) Create(ctx context.Context, connection domain.Connection, reset domain.PasswordReset) error {
+	const query = `insert into password_resets (id, user_id, token_hash, created_at, expires_at) values ($1, $2, $3, $4, $5)`
+
+	_, err := connection.ExecContext(ctx, query, reset.ID, reset.UserID, reset.TokenHash, reset.CreatedAt, reset.ExpiresAt)
+	if err != nil {
+		return errors.Join(ErrPasswordResetsCreate, err)
+	}
+
+	return nil
+}
+
+// Use checks and marks the reset in one statement, so concurrent requests
+// can't both use it.
+func (r PasswordResets) Use(ctx context.Context, connection domain.Connection, tokenHash string) (domain.UserID, error) {
+	const query = `update password_resets set used_at = now()
+where token_hash = $1 and used_at is null and expires_at > now()
+returning user_id`
+
+	var userID domain.UserID
+	if err := connection.GetContext(ctx, &userID, query, tokenHash); err != nil {
+		return userID, errors.Join(ErrPasswordResetsUse, err)
+	}
+
+	return userID, nil
+}
+
+func (r PasswordResets) UseAll(ctx context.Context, connection domain.Connection, userID domain.UserID) error {
+	const query = `update password_resets set used_at = now() where user_id = $1 and used_at is null`
+
+	if _, err := connection.ExecContext(ctx, query, userID); err != nil {
+		return errors.Join(ErrPasswordResetsUseAll, err)
+	}
+
+	return nil
+}
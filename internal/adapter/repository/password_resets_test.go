@@ -0,0 +1,124 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetsIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewPasswordResets()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+
+		now := time.Now()
+		newReset := func(hash string, expiresAt time.Time) domain.PasswordReset {
+			reset := domain.PasswordReset{
+				ID:        domain.PasswordResetID(uuid.New()),
+				UserID:    user.ID,
+				TokenHash: hash,
+				CreatedAt: now,
+				ExpiresAt: expiresAt,
+			}
+			require.NoError(t, repo.Create(ctx, connection, reset))
+
+			return reset
+		}
+		valid := newReset("valid hash", now.Add(time.Hour))
+		expired := newReset("expired hash", now.Add(-time.Minute))
+		other := newReset("other hash", now.Add(time.Hour))
+
+		userID, err := repo.Use(ctx, connection, valid.TokenHash)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, userID)
+
+		_, err = repo.Use(ctx, connection, valid.TokenHash)
+		require.ErrorIs(t, err, repository.ErrPasswordResetsUse)
+		_, err = repo.Use(ctx, connection, expired.TokenHash)
+		require.ErrorIs(t, err, repository.ErrPasswordResetsUse)
+
+		require.NoError(t, repo.UseAll(ctx, connection, user.ID))
+		_, err = repo.Use(ctx, connection, other.TokenHash)
+		require.ErrorIs(t, err, repository.ErrPasswordResetsUse)
+
+		return nil
+	})
+}
+
+func TestPasswordResetsUnit(t *testing.T) {
+	validReset := domain.PasswordReset{
+		ID:        domain.PasswordResetID(uuid.New()),
+		UserID:    domain.UserID(uuid.New()),
+		TokenHash: "some hash",
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.PasswordResets, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.PasswordResets, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validReset.ID, validReset.UserID, validReset.TokenHash, validReset.CreatedAt, validReset.ExpiresAt).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, validReset)
+
+				require.ErrorIs(t, err, repository.ErrPasswordResetsCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Use DB Error",
+			check: func(t *testing.T, repo *repository.PasswordResets, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, validReset.TokenHash).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Use(ctx, connection, validReset.TokenHash)
+
+				require.ErrorIs(t, err, repository.ErrPasswordResetsUse)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Use All DB Error",
+			check: func(t *testing.T, repo *repository.PasswordResets, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validReset.UserID).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.UseAll(ctx, connection, validReset.UserID)
+
+				require.ErrorIs(t, err, repository.ErrPasswordResetsUseAll)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewPasswordResets(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"todo_list/internal/domain"
+)
+
+// queryCondition translates the query into a condition on tasks aliased t.
+// Values only ever reach the SQL as arguments appended to args, $1 being the
+// user running the query and $2 their workspace. Every term is true or
+// false, never null, so NOT keeps tasks without a deadline.
+func queryCondition(q domain.Query, args *[]any) (string, error) {
+	arg := func(value any) string {
+		*args = append(*args, value)
+
+		return fmt.Sprintf("$%d", len(*args))
+	}
+
+	switch q := q.(type) {
+	case domain.QueryAnd:
+		return binaryCondition(q.Left, "and", q.Right, args)
+	case domain.QueryOr:
+		return binaryCondition(q.Left, "or", q.Right, args)
+	case domain.QueryNot:
+		condition, err := queryCondition(q.Query, args)
+		if err != nil {
+			return "", err
+		}
+
+		return "not (" + condition + ")", nil
+	case domain.QueryPriority:
+		return "t.priority::text = " + arg(q.Priority), nil
+	case domain.QueryDone:
+		return "t.done = " + arg(q.Done), nil
+	case domain.QueryList:
+		return "t.list_id in (select l.id from lists l where lower(l.name) = lower(" + arg(q.Name) + "))", nil
+	case domain.QueryTag:
+		return arg(q.Tag) + " = any(t.tags)", nil
+	case domain.QueryName:
+		return "t.name ilike " + arg("%"+escapeLike(q.Text)+"%"), nil
+	case domain.QueryAssignee:
+		condition := "exists (select 1 from task_assignees a where a.task_id = t.id and a.user_id = $1)"
+		if q.None {
+			condition = "not exists (select 1 from task_assignees a where a.task_id = t.id)"
+		}
+
+		return condition, nil
+	case domain.QueryDue:
+		return dueCondition(q.Op, q.Day, args)
+	case domain.QueryOverdue:
+		return overdueCondition(q.Today, args), nil
+	case domain.QueryNoDeadline:
+		return "t.deadline is null", nil
+	}
+
+	return "", fmt.Errorf("unsupported query %T", q)
+}
+
+func binaryCondition(left domain.Query, op string, right domain.Query, args *[]any) (string, error) {
+	leftCondition, err := queryCondition(left, args)
+	if err != nil {
+		return "", err
+	}
+	rightCondition, err := queryCondition(right, args)
+	if err != nil {
+		return "", err
+	}
+
+	return "(" + leftCondition + " " + op + " " + rightCondition + ")", nil
+}
+
+// dueCondition compares deadlines with the day. All-day deadlines are
+// compared with its date, the others with the instants it starts and ends.
+func dueCondition(op string, day domain.Day, args *[]any) (string, error) {
+	var allDay, timed string
+	switch op {
+	case "<":
+		allDay, timed = "t.deadline < $d", "t.deadline < $s"
+	case "<=":
+		allDay, timed = "t.deadline <= $d", "t.deadline < $e"
+	case "=":
+		allDay, timed = "t.deadline = $d", "t.deadline >= $s and t.deadline < $e"
+	case ">=":
+		allDay, timed = "t.deadline >= $d", "t.deadline >= $s"
+	case ">":
+		allDay, timed = "t.deadline > $d", "t.deadline >= $e"
+	default:
+		return "", fmt.Errorf("unsupported comparison %q", op)
+	}
+
+	*args = append(*args, day.Date, day.Start, day.End)
+	placeholders := strings.NewReplacer(
+		"$d", fmt.Sprintf("$%d", len(*args)-2),
+		"$s", fmt.Sprintf("$%d", len(*args)-1),
+		"$e", fmt.Sprintf("$%d", len(*args)),
+	)
+
+	return placeholders.Replace("coalesce((t.all_day and " + allDay + ") or (not t.all_day and " + timed + "), false)"), nil
+}
+
+// overdueCondition keeps all-day tasks due until their day is over.
+func overdueCondition(today domain.Day, args *[]any) string {
+	*args = append(*args, today.Date, today.Now)
+
+	return fmt.Sprintf("coalesce(not t.done and ((t.all_day and t.deadline < $%d) or (not t.all_day and t.deadline < $%d)), false)",
+		len(*args)-1, len(*args))
+}
+
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryCondition(t *testing.T) {
+	today := domain.DayIn(time.Date(2024, time.March, 9, 14, 0, 0, 0, time.UTC), time.UTC)
+	query, err := domain.ParseQuery(`priority:high AND (tag:"50%" OR NOT list:Work) AND due<=1d`, today)
+	require.NoError(t, err)
+
+	userID := uuid.New()
+	args := []any{userID}
+	condition, err := queryCondition(query, &args)
+
+	require.NoError(t, err)
+	require.Equal(t, "((t.priority::text = $2 and ($3 = any(t.tags) or not (t.list_id in (select l.id from lists l where lower(l.name) = lower($4)))))"+
+		" and coalesce((t.all_day and t.deadline <= $5) or (not t.all_day and t.deadline < $7), false))", condition)
+	tomorrow := today.AddDays(1)
+	require.Equal(t, []any{userID, domain.High, "50%", "Work", tomorrow.Date, tomorrow.Start, tomorrow.End}, args)
+}
+
+func TestEscapeLike(t *testing.T) {
+	require.Equal(t, `100\% \_done\\`, escapeLike(`100% _done\`))
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.SmartListsRepository = (*SmartLists)(nil)
+
+var (
+	errSmartLists          = errors.New("smart lists repository error")
+	ErrSmartListsCreate    = errors.Join(errSmartLists, errors.New("create failed"))
+	ErrSmartListsRead      = errors.Join(errSmartLists, errors.New("read failed"))
+	ErrSmartListsReadAll   = errors.Join(errSmartLists, errors.New("read all failed"))
+	ErrSmartListsUpdate    = errors.Join(errSmartLists, errors.New("update failed"))
+	ErrSmartListsDelete    = errors.Join(errSmartLists, errors.New("delete failed"))
+	ErrSmartListsForbidden = errors.Join(errSmartLists, errors.New("smart list not found or access denied"))
+)
+
+type SmartLists struct{}
+
+func NewSmartLists() *SmartLists {
+	return &SmartLists{}
+}
+
+func (r SmartLists) Create(ctx context.Context, connection domain.Connection, list domain.SmartList) error {
+	const query = `insert into smart_lists (id, user_id, workspace_id, name, query, updated_at) values ($1, $2, $3, $4, $5, default)`
+
+	if _, err := connection.ExecContext(ctx, query, list.ID, list.UserID, list.WorkspaceID, list.Name, list.Query); err != nil {
+		return errors.Join(ErrSmartListsCreate, err)
+	}
+
+	return nil
+}
+
+func (r SmartLists) Read(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.SmartListID,
+) (domain.SmartList, error) {
+	const query = `select id, user_id, workspace_id, name, query, updated_at from smart_lists
+where user_id = $1 and workspace_id = $2 and id = $3`
+
+	var list domain.SmartList
+	if err := connection.GetContext(ctx, &list, query, userID, workspaceID, listID); err != nil {
+		return list, errors.Join(ErrSmartListsRead, err)
+	}
+
+	return list, nil
+}
+
+func (r SmartLists) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+) ([]domain.SmartList, error) {
+	const query = `select id, user_id, workspace_id, name, query, updated_at from smart_lists
+where user_id = $1 and workspace_id = $2
+order by name, id`
+
+	var lists []domain.SmartList
+	if err := connection.SelectContext(ctx, &lists, query, userID, workspaceID); err != nil {
+		return nil, errors.Join(ErrSmartListsReadAll, err)
+	}
+
+	return lists, nil
+}
+
+func (r SmartLists) Update(ctx context.Context, connection domain.Connection, list domain.SmartList) error {
+	const query = `update smart_lists set name = $4, query = $5, updated_at = default where user_id = $1 and workspace_id = $2 and id = $3`
+
+	updated, err := connection.ExecContext(ctx, query, list.UserID, list.WorkspaceID, list.ID, list.Name, list.Query)
+	if err != nil {
+		return errors.Join(ErrSmartListsUpdate, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrSmartListsUpdate, ErrSmartListsForbidden)
+	}
+
+	return nil
+}
+
+func (r SmartLists) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listID domain.SmartListID,
+) error {
+	const query = `delete from smart_lists where user_id = $1 and workspace_id = $2 and id = $3`
+
+	deleted, err := connection.ExecContext(ctx, query, userID, workspaceID, listID)
+	if err != nil {
+		return errors.Join(ErrSmartListsDelete, err)
+	}
+	if deleted <= 0 {
+		return errors.Join(ErrSmartListsDelete, ErrSmartListsForbidden)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmartListsIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewSmartLists()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
+		list := fixtureCreateList(t, ctx, connection, user.ID)
+		_ = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "Pay rent")
+		_ = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "Buy milk")
+
+		smartList := domain.SmartList{
+			ID:          domain.SmartListID(uuid.New()),
+			UserID:      user.ID,
+			WorkspaceID: workspaceID,
+			Name:        "Rent",
+			Query:       `"rent" AND due<=today AND NOT done`,
+		}
+		require.NoError(t, repo.Create(ctx, connection, smartList))
+
+		lists, err := repo.ReadAll(ctx, connection, user.ID, workspaceID)
+		require.NoError(t, err)
+		require.Len(t, lists, 1)
+		require.Equal(t, smartList.Query, lists[0].Query)
+
+		read, err := repo.Read(ctx, connection, user.ID, workspaceID, smartList.ID)
+		require.NoError(t, err)
+		query, err := domain.ParseQuery(read.Query, domain.DayIn(time.Now(), time.UTC))
+		require.NoError(t, err)
+
+		tasks, err := repository.NewTasks().Find(ctx, connection, user.ID, workspaceID, domain.TaskFilter{Query: query})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		require.Equal(t, "Pay rent", tasks[0].Name)
+
+		smartList.Name = "Renamed"
+		require.NoError(t, repo.Update(ctx, connection, smartList))
+
+		_, err = repo.Read(ctx, connection, uuid.New(), workspaceID, smartList.ID)
+		require.ErrorIs(t, err, repository.ErrSmartListsRead)
+		require.ErrorIs(t, repo.Delete(ctx, connection, uuid.New(), workspaceID, smartList.ID), repository.ErrSmartListsForbidden)
+
+		require.NoError(t, repo.Delete(ctx, connection, user.ID, workspaceID, smartList.ID))
+
+		return nil
+	})
+}
+
+func TestSmartListsUnit(t *testing.T) {
+	validList := domain.SmartList{
+		ID:          domain.SmartListID(uuid.New()),
+		UserID:      domain.UserID(uuid.New()),
+		WorkspaceID: domain.WorkspaceID(uuid.New()),
+		Name:        "Today",
+		Query:       "due:today",
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.SmartLists, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.SmartLists, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validList.ID, validList.UserID, validList.WorkspaceID, validList.Name, validList.Query).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, validList)
+
+				require.ErrorIs(t, err, repository.ErrSmartListsCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read All DB Error",
+			check: func(t *testing.T, repo *repository.SmartLists, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, validList.UserID, validList.WorkspaceID).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadAll(ctx, connection, validList.UserID, validList.WorkspaceID)
+
+				require.ErrorIs(t, err, repository.ErrSmartListsReadAll)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Update Not Found",
+			check: func(t *testing.T, repo *repository.SmartLists, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validList.UserID, validList.WorkspaceID, validList.ID, validList.Name, validList.Query).
+					Return(0, nil).
+					Once()
+
+				err := repo.Update(ctx, connection, validList)
+
+				require.ErrorIs(t, err, repository.ErrSmartListsUpdate)
+				require.ErrorIs(t, err, repository.ErrSmartListsForbidden)
+			},
+		},
+		{
+			name: "Delete DB Error",
+			check: func(t *testing.T, repo *repository.SmartLists, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validList.UserID, validList.WorkspaceID, validList.ID).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Delete(ctx, connection, validList.UserID, validList.WorkspaceID, validList.ID)
+
+				require.ErrorIs(t, err, repository.ErrSmartListsDelete)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewSmartLists(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.StatsRepository = (*Stats)(nil)
+
+var (
+	errStats                = errors.New("stats repository error")
+	ErrStatsReadPeriods     = errors.Join(errStats, errors.New("read periods failed"))
+	ErrStatsReadGroups      = errors.Join(errStats, errors.New("read groups failed"))
+	ErrStatsReadSummary     = errors.Join(errStats, errors.New("read summary failed"))
+	errStatsUnknownInterval = errors.New("unknown stats interval")
+)
+
+type Stats struct{}
+
+func NewStats() *Stats {
+	return &Stats{}
+}
+
+// ReadPeriods truncates timestamps in the zone of the range, so days and
+// weeks, which start on Monday, are those of the user.
+func (r Stats) ReadPeriods(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	statsRange domain.StatsRange,
+) ([]domain.StatsPeriod, error) {
+	if statsRange.Interval != domain.StatsDay && statsRange.Interval != domain.StatsWeek {
+		return nil, errors.Join(ErrStatsReadPeriods, errStatsUnknownInterval)
+	}
+
+	const query = `select start, sum(created) as created, sum(completed) as completed
+from (
+    select date_trunc($3, t.created_at at time zone $4)::date as start, 1 as created, 0 as completed
+    from tasks t
+    where t.list_id in (` + accessibleLists + `) and t.created_at >= $5 and t.created_at < $6
+    union all
+    select date_trunc($3, t.completed_at at time zone $4)::date, 0, 1
+    from tasks t
+    where t.list_id in (` + accessibleLists + `) and t.completed_at >= $5 and t.completed_at < $6
+) counts
+group by start
+order by start`
+
+	var periods []domain.StatsPeriod
+	err := connection.SelectContext(ctx, &periods, query, userID, workspaceID, statsRange.Interval, statsRange.From.Start.Location().String(),
+		statsRange.From.Start, statsRange.To.End)
+	if err != nil {
+		return nil, errors.Join(ErrStatsReadPeriods, err)
+	}
+
+	return periods, nil
+}
+
+func (r Stats) ReadGroups(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	statsRange domain.StatsRange,
+) ([]domain.StatsGroup, error) {
+	const query = `select t.list_id, l.name as list_name, t.priority,
+    count(*) filter (where t.created_at >= $3 and t.created_at < $4) as created,
+    count(*) filter (where t.completed_at >= $3 and t.completed_at < $4) as completed
+from tasks t
+join lists l on l.id = t.list_id
+where t.list_id in (` + accessibleLists + `)
+    and ((t.created_at >= $3 and t.created_at < $4) or (t.completed_at >= $3 and t.completed_at < $4))
+group by t.list_id, l.name, t.priority
+order by l.name, t.list_id, t.priority`
+
+	var groups []domain.StatsGroup
+	if err := connection.SelectContext(ctx, &groups, query, userID, workspaceID, statsRange.From.Start, statsRange.To.End); err != nil {
+		return nil, errors.Join(ErrStatsReadGroups, err)
+	}
+
+	return groups, nil
+}
+
+// ReadSummary counts the tasks overdue today, whatever the range.
+func (r Stats) ReadSummary(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	statsRange domain.StatsRange,
+) (domain.StatsSummary, error) {
+	args := []any{userID, workspaceID, statsRange.From.Start, statsRange.To.End}
+	query := `select
+    (select extract(epoch from avg(t.completed_at - t.created_at))::float8
+        from tasks t
+        where t.list_id in (` + accessibleLists + `) and t.completed_at >= $3 and t.completed_at < $4) as average_completion_seconds,
+    (select count(*)
+        from tasks t
+        where t.list_id in (` + accessibleLists + `) and ` + overdueCondition(statsRange.Today, &args) + `) as overdue`
+
+	var summary domain.StatsSummary
+	if err := connection.GetContext(ctx, &summary, query, args...); err != nil {
+		return summary, errors.Join(ErrStatsReadSummary, err)
+	}
+
+	return summary, nil
+}
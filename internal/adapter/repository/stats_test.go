@@ -0,0 +1,129 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewStats()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
+		list := fixtureCreateList(t, ctx, connection, user.ID)
+		done := fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "Pay rent")
+		_ = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "Buy milk")
+
+		done.Done = true
+		require.NoError(t, repository.NewTasks().Update(ctx, connection, user.ID, workspaceID, done))
+
+		today := domain.DayIn(time.Now(), time.UTC)
+		statsRange := domain.StatsRange{From: today.AddDays(-1), To: today, Interval: domain.StatsDay, Today: today}
+
+		periods, err := repo.ReadPeriods(ctx, connection, user.ID, workspaceID, statsRange)
+		require.NoError(t, err)
+		require.Equal(t, []domain.StatsPeriod{{Start: today.Date, Created: 2, Completed: 1}}, periods)
+
+		groups, err := repo.ReadGroups(ctx, connection, user.ID, workspaceID, statsRange)
+		require.NoError(t, err)
+		require.Equal(t, []domain.StatsGroup{
+			{ListID: list.ID, ListName: list.Name, Priority: domain.Low, Created: 2, Completed: 1},
+		}, groups)
+
+		summary, err := repo.ReadSummary(ctx, connection, user.ID, workspaceID, statsRange)
+		require.NoError(t, err)
+		require.NotNil(t, summary.AverageCompletionSeconds)
+
+		groups, err = repo.ReadGroups(ctx, connection, uuid.New(), workspaceID, statsRange)
+		require.NoError(t, err)
+		require.Empty(t, groups)
+
+		return nil
+	})
+}
+
+func TestStatsUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	today := domain.DayIn(time.Now(), time.UTC)
+	statsRange := domain.StatsRange{From: today.AddDays(-6), To: today, Interval: domain.StatsDay, Today: today}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.Stats, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Read Periods Unknown Interval",
+			check: func(t *testing.T, repo *repository.Stats, connection *dbMocks.MockConnection) {
+				statsRange := statsRange
+				statsRange.Interval = "hour"
+
+				_, err := repo.ReadPeriods(ctx, connection, userID, workspaceID, statsRange)
+
+				require.ErrorIs(t, err, repository.ErrStatsReadPeriods)
+			},
+		},
+		{
+			name: "Read Periods DB Error",
+			check: func(t *testing.T, repo *repository.Stats, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, userID, workspaceID, domain.StatsDay, "UTC", statsRange.From.Start, statsRange.To.End).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadPeriods(ctx, connection, userID, workspaceID, statsRange)
+
+				require.ErrorIs(t, err, repository.ErrStatsReadPeriods)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read Groups DB Error",
+			check: func(t *testing.T, repo *repository.Stats, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, userID, workspaceID, statsRange.From.Start, statsRange.To.End).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadGroups(ctx, connection, userID, workspaceID, statsRange)
+
+				require.ErrorIs(t, err, repository.ErrStatsReadGroups)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Read Summary DB Error",
+			check: func(t *testing.T, repo *repository.Stats, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, userID, workspaceID, statsRange.From.Start, statsRange.To.End, today.Date, today.Now).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadSummary(ctx, connection, userID, workspaceID, statsRange)
+
+				require.ErrorIs(t, err, repository.ErrStatsReadSummary)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewStats(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
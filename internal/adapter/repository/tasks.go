@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"todo_list/internal/domain"
 )
@@ -17,6 +18,10 @@ var (
 	ErrTasksUpdate      = errors.Join(errTasks, errors.New("update failed"))
 	ErrTasksDelete      = errors.Join(errTasks, errors.New("delete failed"))
 	ErrTasksGetAllTasks = errors.Join(errTasks, errors.New("get all failed"))
+	ErrTasksFind        = errors.Join(errTasks, errors.New("find failed"))
+	// ErrTasksForbidden is sql.ErrNoRows too, a task the user may not access
+	// is one that doesn't exist.
+	ErrTasksForbidden = errors.Join(errTasks, errors.New("list or task not found or access denied"), sql.ErrNoRows)
 )
 
 type Tasks struct{}
@@ -25,53 +30,47 @@ func NewTasks() *Tasks {
 	return &Tasks{}
 }
 
-func (r Tasks) listExists(ctx context.Context, connection domain.Connection, userID domain.UserID, listID domain.ListID) (bool, error) {
-
-	const query = `select 1 from lists where user_id = $1 and id = $2`
-
-	var tmp int
-	if err := connection.GetContext(ctx, &tmp, query, userID, listID); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			err = nil
-		}
-
-		return false, err
-	}
-
-	return true, nil
-}
-
-func (r Tasks) Create(ctx context.Context, connection domain.Connection, userID domain.UserID, task domain.Task) error {
-	exists, err := r.listExists(ctx, connection, userID, task.ListID)
+func (r Tasks) Create(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	task domain.Task,
+) error {
+	exists, err := listExists(ctx, connection, userID, workspaceID, task.ListID)
 	if err != nil {
 		return errors.Join(ErrTasksCreate, err)
 	}
 	if !exists {
-		return errors.Join(ErrTasksCreate, errors.New("list not found or access denied"))
+		return errors.Join(ErrTasksCreate, ErrTasksForbidden)
 	}
 
-	const query = `insert into tasks (id, list_id, priority, deadline, done, name) values ($1, $2, $3, $4, $5, $6)`
+	const query = `insert into tasks (id, list_id, priority, deadline, all_day, done, name, tags, recurrence, completed_at, dav_name, ical_uid)
+values ($1, $2, $3, $4, $5, $6, $7, coalesce($8::text[], '{}'), $9, case when $6 then now() end, $10, $11)`
 
-	_, err = connection.ExecContext(ctx, query, task.ID, task.ListID, domain.Priority(task.Priority), task.Deadline, task.Done, task.Name)
+	_, err = connection.ExecContext(ctx, query, task.ID, task.ListID, domain.Priority(task.Priority), task.Deadline, task.AllDay, task.Done, task.Name,
+		task.Tags, task.Recurrence, task.DAVName, task.ICalUID)
 	if err != nil {
 		return errors.Join(ErrTasksCreate, err)
 	}
 
+	if err = r.setAssignees(ctx, connection, workspaceID, task); err != nil {
+		return errors.Join(ErrTasksCreate, err)
+	}
+
 	return nil
 }
 
-func (r Tasks) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID, taskID domain.TaskID) error {
+func (r Tasks) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	taskID domain.TaskID,
+) error {
 	var listID domain.ListID
 	if err := connection.GetContext(ctx, &listID, "select list_id from tasks where id = $1", taskID); err != nil {
 		return errors.Join(ErrTasksDelete, err)
 	}
 
-	exists, err := r.listExists(ctx, connection, userID, listID)
+	exists, err := listExists(ctx, connection, userID, workspaceID, listID)
 	if err != nil {
 		return errors.Join(ErrTasksDelete, err)
 	}
 	if !exists {
-		return errors.Join(ErrTasksDelete, errors.New("list not found or access denied"))
+		return errors.Join(ErrTasksDelete, ErrTasksForbidden)
 	}
 
 	const query = `delete from tasks where id = $1`
@@ -84,22 +83,27 @@ func (r Tasks) Delete(ctx context.Context, connection domain.Connection, userID
 	return nil
 }
 
-func (r Tasks) Read(ctx context.Context, connection domain.Connection, userID domain.UserID, taskID domain.TaskID) (domain.Task, error) {
+func (r Tasks) Read(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	taskID domain.TaskID,
+) (domain.Task, error) {
 	var task domain.Task
 	var listID domain.ListID
 	if err := connection.GetContext(ctx, &listID, "select list_id from tasks where id = $1", taskID); err != nil {
 		return task, errors.Join(ErrTasksRead, err)
 	}
 
-	exists, err := r.listExists(ctx, connection, userID, listID)
+	exists, err := listExists(ctx, connection, userID, workspaceID, listID)
 	if err != nil {
 		return task, errors.Join(ErrTasksRead, err)
 	}
 	if !exists {
-		return task, errors.Join(ErrTasksRead, errors.New("list not found or access denied"))
+		return task, errors.Join(ErrTasksRead, ErrTasksForbidden)
 	}
 
-	const query = `select id, list_id, priority, deadline, done, name, updated_at from tasks where id = $1`
+	const query = `select id, list_id, priority, deadline, all_day, done, name, tags, recurrence, created_at, updated_at, completed_at, dav_name, ical_uid,
+    array(select a.user_id from task_assignees a where a.task_id = tasks.id order by a.user_id) as assignees,
+    (select count(*) from comments c where c.task_id = tasks.id) as comment_count
+from tasks where id = $1`
 
 	err = connection.GetContext(ctx, &task, query, taskID)
 	if err != nil {
@@ -111,29 +115,77 @@ func (r Tasks) Read(ctx context.Context, connection domain.Connection, userID do
 	return task, nil
 }
 
-func (r Tasks) Update(ctx context.Context, connection domain.Connection, userID domain.UserID, task domain.Task) error {
-	exists, err := r.listExists(ctx, connection, userID, task.ListID)
+func (r Tasks) Update(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	task domain.Task,
+) error {
+	exists, err := listExists(ctx, connection, userID, workspaceID, task.ListID)
 	if err != nil {
 		return errors.Join(ErrTasksUpdate, err)
 	}
 	if !exists {
-		return errors.Join(ErrTasksUpdate, errors.New("list not found or access denied"))
+		return errors.Join(ErrTasksUpdate, ErrTasksForbidden)
 	}
 
-	const query = `update tasks set name = $2, priority = $3, deadline = $4, done = $5, updated_at = default where id = $1`
+	// completed_at is kept while the task stays done. The list is checked
+	// above, so the task must be in it.
+	const query = `update tasks set name = $2, priority = $3, deadline = $4, all_day = $5, done = $6,
+    tags = coalesce($7::text[], tags), recurrence = $8, updated_at = default,
+    completed_at = case when not $6 then null when done then completed_at else now() end,
+    dav_name = coalesce(nullif($10, ''), dav_name), ical_uid = coalesce(nullif($11, ''), ical_uid)
+where id = $1 and list_id = $9`
 
-	_, err = connection.ExecContext(ctx, query, task.ID, task.Name, domain.Priority(task.Priority), task.Deadline, task.Done)
+	updated, err := connection.ExecContext(ctx, query, task.ID, task.Name, domain.Priority(task.Priority), task.Deadline, task.AllDay, task.Done,
+		task.Tags, task.Recurrence, task.ListID, task.DAVName, task.ICalUID)
 	if err != nil {
 		return errors.Join(ErrTasksUpdate, err)
 	}
+	if updated <= 0 {
+		return errors.Join(ErrTasksUpdate, ErrTasksForbidden)
+	}
+
+	if err = r.setAssignees(ctx, connection, workspaceID, task); err != nil {
+		return errors.Join(ErrTasksUpdate, err)
+	}
 
 	return nil
 }
 
-func (r Tasks) GetAllTasks(ctx context.Context, connection domain.Connection, userID domain.UserID, listsIDs []domain.ListID) ([]domain.Task, error) {
+// setAssignees replaces the assignees of the task unless they are nil.
+func (r Tasks) setAssignees(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID, task domain.Task) error {
+	if task.Assignees == nil {
+		return nil
+	}
+
+	for _, assigneeID := range task.Assignees {
+		exists, err := listExists(ctx, connection, assigneeID, workspaceID, task.ListID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("assignee %s has no access to the list", assigneeID)
+		}
+	}
+
+	if _, err := connection.ExecContext(ctx, `delete from task_assignees where task_id = $1`, task.ID); err != nil {
+		return err
+	}
+	if len(task.Assignees) == 0 {
+		return nil
+	}
+
+	const query = `insert into task_assignees (task_id, user_id) select $1, unnest($2::uuid[]) on conflict do nothing`
+
+	_, err := connection.ExecContext(ctx, query, task.ID, task.Assignees)
+
+	return err
+}
+
+func (r Tasks) GetAllTasks(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	listsIDs []domain.ListID,
+) ([]domain.Task, error) {
 	// userID ckeck for all lists!!!
 	for _, listID := range listsIDs {
-		exists, err := r.listExists(ctx, connection, userID, listID)
+		exists, err := listExists(ctx, connection, userID, workspaceID, listID)
 		if err != nil {
 			return nil, errors.Join(ErrTasksGetAllTasks, err)
 		}
@@ -142,7 +194,10 @@ func (r Tasks) GetAllTasks(ctx context.Context, connection domain.Connection, us
 		}
 	}
 
-	const query = `select id, list_id, priority, deadline, done, name, updated_at from tasks where list_id = any($1)`
+	const query = `select id, list_id, priority, deadline, all_day, done, name, tags, recurrence, created_at, updated_at, completed_at, dav_name, ical_uid,
+    array(select a.user_id from task_assignees a where a.task_id = tasks.id order by a.user_id) as assignees,
+    (select count(*) from comments c where c.task_id = tasks.id) as comment_count
+from tasks where list_id = any($1)`
 
 	var tasks []domain.Task
 	err := connection.SelectContext(ctx, &tasks, query, listsIDs)
@@ -152,3 +207,66 @@ func (r Tasks) GetAllTasks(ctx context.Context, connection domain.Connection, us
 
 	return tasks, nil
 }
+
+// Find returns tasks of all the lists the user may access in the workspace
+// matching the filter. Access is checked by the query, so no separate list
+// access check is needed.
+func (r Tasks) Find(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+	filter domain.TaskFilter,
+) ([]domain.Task, error) {
+	query := `select t.id, t.list_id, t.priority, t.deadline, t.all_day, t.done, t.name, t.tags, t.recurrence, t.created_at, t.updated_at, t.completed_at, t.dav_name, t.ical_uid,
+    array(select a.user_id from task_assignees a where a.task_id = t.id order by a.user_id) as assignees,
+    (select count(*) from comments c where c.task_id = t.id) as comment_count
+from tasks t
+where t.list_id in (` + accessibleLists + `)`
+	args := []any{userID, workspaceID}
+
+	if len(filter.ListIDs) > 0 {
+		args = append(args, filter.ListIDs)
+		query += fmt.Sprintf(" and t.list_id = any($%d)", len(args))
+	}
+	if len(filter.Priorities) > 0 {
+		args = append(args, filter.Priorities)
+		query += fmt.Sprintf(" and t.priority::text = any($%d)", len(args))
+	}
+	if len(filter.AssigneeIDs) > 0 {
+		args = append(args, filter.AssigneeIDs)
+		query += fmt.Sprintf(" and exists (select 1 from task_assignees a where a.task_id = t.id and a.user_id = any($%d))", len(args))
+	}
+	if filter.Done != nil {
+		args = append(args, *filter.Done)
+		query += fmt.Sprintf(" and t.done = $%d", len(args))
+	}
+	if filter.HasDeadline {
+		query += " and t.deadline is not null"
+	}
+	if filter.DAVName != "" {
+		args = append(args, filter.DAVName)
+		query += fmt.Sprintf(" and t.dav_name = $%d", len(args))
+	}
+	switch filter.Due {
+	case domain.DueToday:
+		condition, err := dueCondition("=", filter.Today, &args)
+		if err != nil {
+			return nil, errors.Join(ErrTasksFind, err)
+		}
+		query += " and " + condition
+	case domain.DueOverdue:
+		query += " and " + overdueCondition(filter.Today, &args)
+	}
+	if filter.Query != nil {
+		condition, err := queryCondition(filter.Query, &args)
+		if err != nil {
+			return nil, errors.Join(ErrTasksFind, err)
+		}
+		query += " and " + condition
+	}
+	query += " order by t.deadline nulls last, t.id"
+
+	var tasks []domain.Task
+	if err := connection.SelectContext(ctx, &tasks, query, args...); err != nil {
+		return nil, errors.Join(ErrTasksFind, err)
+	}
+
+	return tasks, nil
+}
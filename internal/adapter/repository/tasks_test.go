@@ -20,56 +20,126 @@ import (
 func TestTasksIntegration(t *testing.T) {
 	repoTask := repository.NewTasks()
 
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 	provider := cleanTablesAndCreateProvider(ctx, t)
 	defer func() { _ = provider.Close() }()
 
 	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
 		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
 
 		list := fixtureCreateList(t, ctx, connection, user.ID)
 
 		_ = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "firstTask")
 		_ = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "secondTask")
 
-		tasks, err := repoTask.GetAllTasks(ctx, connection, user.ID, []domain.ListID{list.ID})
+		tasks, err := repoTask.GetAllTasks(ctx, connection, user.ID, workspaceID, []domain.ListID{list.ID})
 		require.NoError(t, err)
 		require.Equal(t, 2, len(tasks))
 
+		done := false
+		tasks, err = repoTask.Find(ctx, connection, user.ID, workspaceID, domain.TaskFilter{
+			ListIDs:     []domain.ListID{list.ID},
+			Priorities:  []domain.Priority{domain.Low},
+			Done:        &done,
+			HasDeadline: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(tasks))
+
+		tasks, err = repoTask.Find(ctx, connection, uuid.New(), workspaceID, domain.TaskFilter{})
+		require.NoError(t, err)
+		require.Empty(t, tasks)
+
 		task := fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "thirdTask")
 
 		task.Name = "new task name"
-		require.NoError(t, repoTask.Update(ctx, connection, user.ID, task))
+		task.Tags = []string{"home", "finance"}
+		task.Recurrence = "FREQ=MONTHLY;BYMONTHDAY=1"
+		require.NoError(t, repoTask.Update(ctx, connection, user.ID, workspaceID, task))
 
-		newTask, err := repoTask.Read(ctx, connection, user.ID, task.ID)
+		newTask, err := repoTask.Read(ctx, connection, user.ID, workspaceID, task.ID)
 		require.NoError(t, err)
 		require.Equal(t, task.Name, newTask.Name)
+		require.Equal(t, task.Tags, newTask.Tags)
+		require.Equal(t, task.Recurrence, newTask.Recurrence)
 
-		require.NoError(t, repoTask.Delete(ctx, connection, user.ID, task.ID))
+		// Nil tags keep the current ones.
+		newTask.Tags = nil
+		require.NoError(t, repoTask.Update(ctx, connection, user.ID, workspaceID, newTask))
+		newTask, err = repoTask.Read(ctx, connection, user.ID, workspaceID, task.ID)
+		require.NoError(t, err)
+		require.Equal(t, task.Tags, newTask.Tags)
+
+		clientTask := domain.Task{ID: uuid.New(), ListID: list.ID, Priority: domain.Low, Name: "client task", DAVName: "client-name", ICalUID: "client-uid"}
+		require.NoError(t, repoTask.Create(ctx, connection, user.ID, workspaceID, clientTask))
+		tasks, err = repoTask.Find(ctx, connection, user.ID, workspaceID, domain.TaskFilter{ListIDs: []domain.ListID{list.ID}, DAVName: "client-name"})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		require.Equal(t, clientTask.ID, tasks[0].ID)
+		require.Equal(t, "client-uid", tasks[0].ICalUID)
+
+		require.NoError(t, repoTask.Delete(ctx, connection, user.ID, workspaceID, task.ID))
 
-		_, err = repoTask.Read(ctx, connection, user.ID, task.ID)
+		_, err = repoTask.Read(ctx, connection, user.ID, workspaceID, task.ID)
 		require.ErrorIs(t, err, sql.ErrNoRows)
 
 		return nil
 	})
 }
 
+// TestTasksIntegrationRowLevelSecurity reads tasks without the checks of the
+// repository, the database hides them all the same.
+func TestTasksIntegrationRowLevelSecurity(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	var task domain.Task
+	err := provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		list := fixtureCreateList(t, ctx, connection, user.ID)
+		task = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "private task")
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// A stranger sees nothing, and so does a context acting on behalf of
+	// neither a user nor no one.
+	for _, ctx := range []context.Context{domain.WithAuthUser(ctx, domain.UserID(uuid.New())), context.Background()} {
+		err = provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+			var listID domain.ListID
+			err := connection.GetContext(ctx, &listID, "select list_id from tasks where id = $1", task.ID)
+			require.ErrorIs(t, err, sql.ErrNoRows)
+
+			deleted, err := connection.ExecContext(ctx, "delete from tasks where id = $1", task.ID)
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+
+			return nil
+		})
+		require.NoError(t, err)
+	}
+}
+
 func TestTasksIntegrationInvalidUserIDCreate(t *testing.T) {
 	repoTask := repository.NewTasks()
 
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 	provider := cleanTablesAndCreateProvider(ctx, t)
 	defer func() { _ = provider.Close() }()
 
 	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
 		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
 		task := domain.Task{
 			ID:     uuid.New(),
 			ListID: uuid.New(), // <- non existing list ID
 			Name:   "Some name",
 		}
 
-		err := repoTask.Create(ctx, connection, user.ID, task)
+		err := repoTask.Create(ctx, connection, user.ID, workspaceID, task)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "not found or access denied")
 
@@ -80,18 +150,19 @@ func TestTasksIntegrationInvalidUserIDCreate(t *testing.T) {
 func TestTasksIntegrationInvalidUserIDDelete(t *testing.T) {
 	repoTask := repository.NewTasks()
 
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 	provider := cleanTablesAndCreateProvider(ctx, t)
 	defer func() { _ = provider.Close() }()
 
 	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
 		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
 		list := fixtureCreateList(t, ctx, connection, user.ID)
 		task := fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "firstTask")
 
 		wrongUserID := uuid.New()
 
-		err := repoTask.Delete(ctx, connection, wrongUserID, task.ID)
+		err := repoTask.Delete(ctx, connection, wrongUserID, workspaceID, task.ID)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "not found or access denied")
 
@@ -102,18 +173,19 @@ func TestTasksIntegrationInvalidUserIDDelete(t *testing.T) {
 func TestTasksIntegrationInvalidUserIDRead(t *testing.T) {
 	repoTask := repository.NewTasks()
 
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 	provider := cleanTablesAndCreateProvider(ctx, t)
 	defer func() { _ = provider.Close() }()
 
 	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
 		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
 		list := fixtureCreateList(t, ctx, connection, user.ID)
 		task := fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "firstTask")
 
 		wrongUserID := uuid.New()
 
-		_, err := repoTask.Read(ctx, connection, wrongUserID, task.ID)
+		_, err := repoTask.Read(ctx, connection, wrongUserID, workspaceID, task.ID)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "not found or access denied")
 
@@ -124,18 +196,19 @@ func TestTasksIntegrationInvalidUserIDRead(t *testing.T) {
 func TestTasksIntegrationInvalidUserIDUpdate(t *testing.T) {
 	repoTask := repository.NewTasks()
 
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 	provider := cleanTablesAndCreateProvider(ctx, t)
 	defer func() { _ = provider.Close() }()
 
 	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
 		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
 		list := fixtureCreateList(t, ctx, connection, user.ID)
 		task := fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "firstTask")
 
 		wrongUserID := uuid.New()
 
-		err := repoTask.Update(ctx, connection, wrongUserID, task)
+		err := repoTask.Update(ctx, connection, wrongUserID, workspaceID, task)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "not found or access denied")
 
@@ -146,12 +219,13 @@ func TestTasksIntegrationInvalidUserIDUpdate(t *testing.T) {
 func TestTasksIntegrationInvalidUserIDGetAllTasks(t *testing.T) {
 	repoTask := repository.NewTasks()
 
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 	provider := cleanTablesAndCreateProvider(ctx, t)
 	defer func() { _ = provider.Close() }()
 
 	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
 		user := fixtureCreateUser(t, ctx, connection)
+		workspaceID := domain.PersonalWorkspace(user.ID)
 		list1 := fixtureCreateList(t, ctx, connection, user.ID)
 		list2 := fixtureCreateList(t, ctx, connection, user.ID)
 
@@ -162,7 +236,7 @@ func TestTasksIntegrationInvalidUserIDGetAllTasks(t *testing.T) {
 
 		listIDs := []domain.ListID{list1.ID, list2.ID}
 
-		_, err := repoTask.GetAllTasks(ctx, connection, wrongUserID, listIDs)
+		_, err := repoTask.GetAllTasks(ctx, connection, wrongUserID, workspaceID, listIDs)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "not found or access denied")
 
@@ -180,12 +254,12 @@ func TestTasksUnit(t *testing.T) {
 		Deadline: &now,
 		Done:     false,
 	}
-	userID := domain.UserID(uuid.New())
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
 	ctx := context.Background()
 
 	mockListExistsCall := func(connection *dbMocks.MockConnection, userID domain.UserID, listID domain.ListID, err error) {
 		connection.EXPECT().
-			GetContext(mock.Anything, mock.Anything, mock.Anything, userID, listID).
+			GetContext(mock.Anything, mock.Anything, mock.Anything, userID, workspaceID, listID).
 			Return(err).
 			Once()
 	}
@@ -202,7 +276,7 @@ func TestTasksUnit(t *testing.T) {
 			check: func(t *testing.T, repo *repository.Tasks, connection *dbMocks.MockConnection) {
 				mockListExistsCall(connection, userID, validEmptyTask.ListID, errors.New("some db error"))
 
-				err := repo.Create(ctx, connection, userID, validEmptyTask)
+				err := repo.Create(ctx, connection, userID, workspaceID, validEmptyTask)
 
 				require.ErrorIs(t, err, repository.ErrTasksCreate)
 				require.ErrorContains(t, err, "some db error")
@@ -213,16 +287,38 @@ func TestTasksUnit(t *testing.T) {
 			check: func(t *testing.T, repo *repository.Tasks, connection *dbMocks.MockConnection) {
 				mockListExists(connection, userID, validEmptyTask.ListID)
 				connection.EXPECT().
-					ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+						mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(0, errors.New("some error")).
 					Once()
 
-				err := repo.Create(ctx, connection, userID, validEmptyTask)
+				err := repo.Create(ctx, connection, userID, workspaceID, validEmptyTask)
 
 				require.ErrorIs(t, err, repository.ErrTasksCreate)
 				require.ErrorContains(t, err, "some error")
 			},
 		},
+		{
+			name: "Update with assignee without access",
+			check: func(t *testing.T, repo *repository.Tasks, connection *dbMocks.MockConnection) {
+				assigneeID := domain.UserID(uuid.New())
+				task := validEmptyTask
+				task.Assignees = []domain.UserID{assigneeID}
+
+				mockListExists(connection, userID, task.ListID)
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, task.ID, task.Name, task.Priority, task.Deadline, task.AllDay, task.Done, task.Tags, task.Recurrence,
+						task.ListID, task.DAVName, task.ICalUID).
+					Return(1, nil).
+					Once()
+				mockListExistsCall(connection, assigneeID, task.ListID, sql.ErrNoRows)
+
+				err := repo.Update(ctx, connection, userID, workspaceID, task)
+
+				require.ErrorIs(t, err, repository.ErrTasksUpdate)
+				require.ErrorContains(t, err, "has no access to the list")
+			},
+		},
 		{
 			name: "Delete Task DB Error on List Exists 1",
 			check: func(t *testing.T, repo *repository.Tasks, connection *dbMocks.MockConnection) {
@@ -231,7 +327,7 @@ func TestTasksUnit(t *testing.T) {
 					Return(errors.New("empty rows, list not found")).
 					Once()
 
-				err := repo.Delete(ctx, connection, userID, validEmptyTask.ID)
+				err := repo.Delete(ctx, connection, userID, workspaceID, validEmptyTask.ID)
 
 				require.ErrorIs(t, err, repository.ErrTasksDelete)
 				require.ErrorContains(t, err, "empty rows, list not found")
@@ -251,7 +347,7 @@ func TestTasksUnit(t *testing.T) {
 
 				mockListExistsCall(connection, userID, validEmptyTask.ListID, errors.New("some db error"))
 
-				err := repo.Delete(ctx, connection, userID, validEmptyTask.ID)
+				err := repo.Delete(ctx, connection, userID, workspaceID, validEmptyTask.ID)
 
 				require.ErrorIs(t, err, repository.ErrTasksDelete)
 				require.ErrorContains(t, err, "some db error")
@@ -276,7 +372,7 @@ func TestTasksUnit(t *testing.T) {
 					Return(0, errors.New("some error")).
 					Once()
 
-				err := repo.Delete(ctx, connection, userID, validEmptyTask.ID)
+				err := repo.Delete(ctx, connection, userID, workspaceID, validEmptyTask.ID)
 
 				require.ErrorIs(t, err, repository.ErrTasksDelete)
 				require.ErrorContains(t, err, "some error")
@@ -291,7 +387,7 @@ func TestTasksUnit(t *testing.T) {
 					Return(errors.New("empty rows, list not found")).
 					Once()
 
-				_, err := repo.Read(ctx, connection, userID, validEmptyTask.ID)
+				_, err := repo.Read(ctx, connection, userID, workspaceID, validEmptyTask.ID)
 
 				require.ErrorIs(t, err, repository.ErrTasksRead)
 				require.ErrorContains(t, err, "empty rows, list not found")
@@ -311,7 +407,7 @@ func TestTasksUnit(t *testing.T) {
 
 				mockListExistsCall(connection, userID, validEmptyTask.ListID, errors.New("some db error"))
 
-				_, err := repo.Read(ctx, connection, userID, validEmptyTask.ID)
+				_, err := repo.Read(ctx, connection, userID, workspaceID, validEmptyTask.ID)
 
 				require.ErrorIs(t, err, repository.ErrTasksRead)
 				require.ErrorContains(t, err, "some db error")
@@ -332,11 +428,14 @@ func TestTasksUnit(t *testing.T) {
 				mockListExists(connection, userID, validEmptyTask.ListID)
 
 				connection.EXPECT().
-					GetContext(mock.Anything, mock.Anything, `select id, list_id, priority, deadline, done, name, updated_at from tasks where id = $1`, validEmptyTask.ID).
+					GetContext(mock.Anything, mock.Anything, `select id, list_id, priority, deadline, all_day, done, name, tags, recurrence, created_at, updated_at, completed_at, dav_name, ical_uid,
+    array(select a.user_id from task_assignees a where a.task_id = tasks.id order by a.user_id) as assignees,
+    (select count(*) from comments c where c.task_id = tasks.id) as comment_count
+from tasks where id = $1`, validEmptyTask.ID).
 					Return(errors.New("some error")).
 					Once()
 
-				_, err := repo.Read(ctx, connection, userID, validEmptyTask.ID)
+				_, err := repo.Read(ctx, connection, userID, workspaceID, validEmptyTask.ID)
 
 				require.ErrorIs(t, err, repository.ErrTasksRead)
 				require.ErrorContains(t, err, "some error")
@@ -347,7 +446,7 @@ func TestTasksUnit(t *testing.T) {
 			check: func(t *testing.T, repo *repository.Tasks, connection *dbMocks.MockConnection) {
 				mockListExistsCall(connection, userID, validEmptyTask.ListID, errors.New("some db error"))
 
-				err := repo.Update(ctx, connection, userID, validEmptyTask)
+				err := repo.Update(ctx, connection, userID, workspaceID, validEmptyTask)
 
 				require.ErrorIs(t, err, repository.ErrTasksUpdate)
 				require.ErrorContains(t, err, "some db error")
@@ -359,11 +458,12 @@ func TestTasksUnit(t *testing.T) {
 				mockListExists(connection, userID, validEmptyTask.ListID)
 
 				connection.EXPECT().
-					ExecContext(mock.Anything, mock.Anything, validEmptyTask.ID, validEmptyTask.Name, domain.Priority(validEmptyTask.Priority), validEmptyTask.Deadline, validEmptyTask.Done).
+					ExecContext(mock.Anything, mock.Anything, validEmptyTask.ID, validEmptyTask.Name, domain.Priority(validEmptyTask.Priority), validEmptyTask.Deadline, validEmptyTask.AllDay, validEmptyTask.Done,
+						validEmptyTask.Tags, validEmptyTask.Recurrence, validEmptyTask.ListID, validEmptyTask.DAVName, validEmptyTask.ICalUID).
 					Return(0, errors.New("update error")).
 					Once()
 
-				err := repo.Update(ctx, connection, userID, validEmptyTask)
+				err := repo.Update(ctx, connection, userID, workspaceID, validEmptyTask)
 
 				require.ErrorIs(t, err, repository.ErrTasksUpdate)
 				require.ErrorContains(t, err, "update error")
@@ -377,7 +477,7 @@ func TestTasksUnit(t *testing.T) {
 				mockListExists(connection, userID, ids[0])
 				mockListExistsCall(connection, userID, ids[1], errors.New("access check failed"))
 
-				_, err := repo.GetAllTasks(ctx, connection, userID, ids)
+				_, err := repo.GetAllTasks(ctx, connection, userID, workspaceID, ids)
 
 				require.ErrorIs(t, err, repository.ErrTasksGetAllTasks)
 				require.ErrorContains(t, err, "access check failed")
@@ -394,12 +494,28 @@ func TestTasksUnit(t *testing.T) {
 					Return(errors.New("select error")).
 					Once()
 
-				_, err := repo.GetAllTasks(ctx, connection, userID, ids)
+				_, err := repo.GetAllTasks(ctx, connection, userID, workspaceID, ids)
 
 				require.ErrorIs(t, err, repository.ErrTasksGetAllTasks)
 				require.ErrorContains(t, err, "select error")
 			},
 		},
+		{
+			name: "Find DB error",
+			check: func(t *testing.T, repo *repository.Tasks, connection *dbMocks.MockConnection) {
+				filter := domain.TaskFilter{ListIDs: []domain.ListID{validEmptyTask.ListID}, HasDeadline: true}
+
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, userID, workspaceID, filter.ListIDs).
+					Return(errors.New("select error")).
+					Once()
+
+				_, err := repo.Find(ctx, connection, userID, workspaceID, filter)
+
+				require.ErrorIs(t, err, repository.ErrTasksFind)
+				require.ErrorContains(t, err, "select error")
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -418,7 +534,7 @@ func fixtureCreateTask(t *testing.T, ctx context.Context, connection domain.Conn
 		Done:     false,
 		Name:     name,
 	}
-	require.NoError(t, repository.NewTasks().Create(ctx, connection, userID, task))
+	require.NoError(t, repository.NewTasks().Create(ctx, connection, userID, domain.PersonalWorkspace(userID), task))
 
 	return task
 }
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.TwoFactorRepository = (*TwoFactor)(nil)
+
+var (
+	errTwoFactor                    = errors.New("two-factor repository error")
+	ErrTwoFactorRead                = errors.Join(errTwoFactor, errors.New("read failed"))
+	ErrTwoFactorCreate              = errors.Join(errTwoFactor, errors.New("create failed"))
+	ErrTwoFactorEnable              = errors.Join(errTwoFactor, errors.New("enable failed"))
+	ErrTwoFactorUseCounter          = errors.Join(errTwoFactor, errors.New("use counter failed"))
+	ErrTwoFactorDelete              = errors.Join(errTwoFactor, errors.New("delete failed"))
+	ErrTwoFactorCreateRecoveryCodes = errors.Join(errTwoFactor, errors.New("create recovery codes failed"))
+	ErrTwoFactorUseRecoveryCode     = errors.Join(errTwoFactor, errors.New("use recovery code failed"))
+	ErrTwoFactorCreateChallenge     = errors.Join(errTwoFactor, errors.New("create challenge failed"))
+	ErrTwoFactorUseChallenge        = errors.Join(errTwoFactor, errors.New("use challenge failed"))
+	ErrTwoFactorReadChallenge       = errors.Join(errTwoFactor, errors.New("read challenge failed"))
+)
+
+type TwoFactor struct{}
+
+func NewTwoFactor() *TwoFactor {
+	return &TwoFactor{}
+}
+
+func (r TwoFactor) Read(ctx context.Context, connection domain.Connection, userID domain.UserID) (domain.TwoFactor, error) {
+	const query = `select user_id, secret, enabled_at, last_counter from two_factor where user_id = $1`
+
+	var twoFactor domain.TwoFactor
+	if err := connection.GetContext(ctx, &twoFactor, query, userID); err != nil {
+		return twoFactor, errors.Join(ErrTwoFactorRead, err)
+	}
+
+	return twoFactor, nil
+}
+
+func (r TwoFactor) Create(ctx context.Context, connection domain.Connection, twoFactor domain.TwoFactor) error {
+	const query = `insert into two_factor as f (user_id, secret) values ($1, $2)
+on conflict (user_id) do update set secret = excluded.secret, last_counter = 0
+where f.enabled_at is null`
+
+	created, err := connection.ExecContext(ctx, query, twoFactor.UserID, twoFactor.Secret)
+	if err != nil {
+		return errors.Join(ErrTwoFactorCreate, err)
+	}
+	if created <= 0 {
+		return errors.Join(ErrTwoFactorCreate, errors.New("already enabled"))
+	}
+
+	return nil
+}
+
+func (r TwoFactor) Enable(ctx context.Context, connection domain.Connection, userID domain.UserID, counter int64) error {
+	const query = `update two_factor set enabled_at = now(), last_counter = $2 where user_id = $1 and enabled_at is null`
+
+	updated, err := connection.ExecContext(ctx, query, userID, counter)
+	if err != nil {
+		return errors.Join(ErrTwoFactorEnable, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrTwoFactorEnable, errors.New("not enrolled or already enabled"))
+	}
+
+	return nil
+}
+
+func (r TwoFactor) UseCounter(ctx context.Context, connection domain.Connection, userID domain.UserID, counter int64) error {
+	const query = `update two_factor set last_counter = $2 where user_id = $1 and last_counter < $2`
+
+	updated, err := connection.ExecContext(ctx, query, userID, counter)
+	if err != nil {
+		return errors.Join(ErrTwoFactorUseCounter, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrTwoFactorUseCounter, errors.New("code already used"))
+	}
+
+	return nil
+}
+
+func (r TwoFactor) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID) error {
+	if _, err := connection.ExecContext(ctx, `delete from recovery_codes where user_id = $1`, userID); err != nil {
+		return errors.Join(ErrTwoFactorDelete, err)
+	}
+	if _, err := connection.ExecContext(ctx, `delete from two_factor where user_id = $1`, userID); err != nil {
+		return errors.Join(ErrTwoFactorDelete, err)
+	}
+
+	return nil
+}
+
+func (r TwoFactor) CreateRecoveryCodes(ctx context.Context, connection domain.Connection, userID domain.UserID, codeHashes []string) error {
+	if _, err := connection.ExecContext(ctx, `delete from recovery_codes where user_id = $1`, userID); err != nil {
+		return errors.Join(ErrTwoFactorCreateRecoveryCodes, err)
+	}
+
+	const query = `insert into recovery_codes (user_id, code_hash) select $1, unnest($2::text[])`
+	if _, err := connection.ExecContext(ctx, query, userID, codeHashes); err != nil {
+		return errors.Join(ErrTwoFactorCreateRecoveryCodes, err)
+	}
+
+	return nil
+}
+
+func (r TwoFactor) UseRecoveryCode(ctx context.Context, connection domain.Connection, userID domain.UserID, codeHash string) error {
+	const query = `update recovery_codes set used_at = now() where user_id = $1 and code_hash = $2 and used_at is null`
+
+	updated, err := connection.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		return errors.Join(ErrTwoFactorUseRecoveryCode, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrTwoFactorUseRecoveryCode, errors.New("unknown or used recovery code"))
+	}
+
+	return nil
+}
+
+// CreateChallenge also removes the expired challenges, nothing else does.
+func (r TwoFactor) CreateChallenge(ctx context.Context, connection domain.Connection, challenge domain.LoginChallenge) error {
+	if _, err := connection.ExecContext(ctx, `delete from login_challenges where expires_at < now()`); err != nil {
+		return errors.Join(ErrTwoFactorCreateChallenge, err)
+	}
+
+	const query = `insert into login_challenges (token_hash, user_id, expires_at) values ($1, $2, $3)`
+	if _, err := connection.ExecContext(ctx, query, challenge.TokenHash, challenge.UserID, challenge.ExpiresAt); err != nil {
+		return errors.Join(ErrTwoFactorCreateChallenge, err)
+	}
+
+	return nil
+}
+
+func (r TwoFactor) ReadChallenge(ctx context.Context, connection domain.Connection, tokenHash string) (domain.UserID, error) {
+	const query = `select user_id from login_challenges where token_hash = $1 and expires_at > now()`
+
+	var userID domain.UserID
+	if err := connection.GetContext(ctx, &userID, query, tokenHash); err != nil {
+		return userID, errors.Join(ErrTwoFactorReadChallenge, err)
+	}
+
+	return userID, nil
+}
+
+func (r TwoFactor) UseChallenge(ctx context.Context, connection domain.Connection, tokenHash string) (domain.UserID, error) {
+	const query = `delete from login_challenges where token_hash = $1 and expires_at > now() returning user_id`
+
+	var userID domain.UserID
+	if err := connection.GetContext(ctx, &userID, query, tokenHash); err != nil {
+		return userID, errors.Join(ErrTwoFactorUseChallenge, err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,154 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoFactorIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewTwoFactor()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+
+		_, err := repo.Read(ctx, connection, user.ID)
+		require.ErrorIs(t, err, repository.ErrTwoFactorRead)
+
+		require.NoError(t, repo.Create(ctx, connection, domain.TwoFactor{UserID: user.ID, Secret: "first"}))
+		require.NoError(t, repo.Create(ctx, connection, domain.TwoFactor{UserID: user.ID, Secret: "second"}))
+		require.NoError(t, repo.Enable(ctx, connection, user.ID, 10))
+
+		twoFactor, err := repo.Read(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, "second", twoFactor.Secret)
+		require.NotNil(t, twoFactor.EnabledAt)
+		require.Equal(t, int64(10), twoFactor.LastCounter)
+
+		require.ErrorIs(t, repo.Create(ctx, connection, domain.TwoFactor{UserID: user.ID, Secret: "third"}), repository.ErrTwoFactorCreate)
+		require.ErrorIs(t, repo.UseCounter(ctx, connection, user.ID, 10), repository.ErrTwoFactorUseCounter)
+		require.NoError(t, repo.UseCounter(ctx, connection, user.ID, 11))
+
+		require.NoError(t, repo.CreateRecoveryCodes(ctx, connection, user.ID, []string{"hash 1", "hash 2"}))
+		require.NoError(t, repo.UseRecoveryCode(ctx, connection, user.ID, "hash 1"))
+		require.ErrorIs(t, repo.UseRecoveryCode(ctx, connection, user.ID, "hash 1"), repository.ErrTwoFactorUseRecoveryCode)
+
+		require.NoError(t, repo.CreateChallenge(ctx, connection, domain.LoginChallenge{
+			TokenHash: "challenge", UserID: user.ID, ExpiresAt: time.Now().Add(time.Minute),
+		}))
+		userID, err := repo.ReadChallenge(ctx, connection, "challenge")
+		require.NoError(t, err)
+		require.Equal(t, user.ID, userID)
+		userID, err = repo.UseChallenge(ctx, connection, "challenge")
+		require.NoError(t, err)
+		require.Equal(t, user.ID, userID)
+		_, err = repo.UseChallenge(ctx, connection, "challenge")
+		require.ErrorIs(t, err, repository.ErrTwoFactorUseChallenge)
+		_, err = repo.ReadChallenge(ctx, connection, "challenge")
+		require.ErrorIs(t, err, repository.ErrTwoFactorReadChallenge)
+
+		require.NoError(t, repo.Delete(ctx, connection, user.ID))
+		_, err = repo.Read(ctx, connection, user.ID)
+		require.ErrorIs(t, err, repository.ErrTwoFactorRead)
+
+		return nil
+	})
+}
+
+func TestTwoFactorUnit(t *testing.T) {
+	userID := domain.UserID(uuid.New())
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.TwoFactor, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Read DB Error",
+			check: func(t *testing.T, repo *repository.TwoFactor, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, userID).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Read(ctx, connection, userID)
+
+				require.ErrorIs(t, err, repository.ErrTwoFactorRead)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Enable Not Enrolled",
+			check: func(t *testing.T, repo *repository.TwoFactor, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, userID, int64(10)).
+					Return(0, nil).
+					Once()
+
+				err := repo.Enable(ctx, connection, userID, 10)
+
+				require.ErrorIs(t, err, repository.ErrTwoFactorEnable)
+			},
+		},
+		{
+			name: "Use Counter Already Used",
+			check: func(t *testing.T, repo *repository.TwoFactor, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, userID, int64(10)).
+					Return(0, nil).
+					Once()
+
+				err := repo.UseCounter(ctx, connection, userID, 10)
+
+				require.ErrorIs(t, err, repository.ErrTwoFactorUseCounter)
+				require.ErrorContains(t, err, "code already used")
+			},
+		},
+		{
+			name: "Use Recovery Code DB Error",
+			check: func(t *testing.T, repo *repository.TwoFactor, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, userID, "some hash").
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.UseRecoveryCode(ctx, connection, userID, "some hash")
+
+				require.ErrorIs(t, err, repository.ErrTwoFactorUseRecoveryCode)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Use Challenge DB Error",
+			check: func(t *testing.T, repo *repository.TwoFactor, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, "some hash").
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.UseChallenge(ctx, connection, "some hash")
+
+				require.ErrorIs(t, err, repository.ErrTwoFactorUseChallenge)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewTwoFactor(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.UsageRepository = (*Usage)(nil)
+
+var (
+	errUsage     = errors.New("usage repository error")
+	ErrUsageRead = errors.Join(errUsage, errors.New("read failed"))
+)
+
+type Usage struct{}
+
+func NewUsage() *Usage {
+	return &Usage{}
+}
+
+// Read counts the lists the user owns with their tasks, and what the user
+// wrote or uploaded anywhere. Revoked and expired access tokens aren't
+// counted.
+func (r Usage) Read(ctx context.Context, connection domain.Connection, userID domain.UserID) (domain.UserUsage, error) {
+	const query = `select
+    (select count(*) from lists where user_id = $1) as lists,
+    (select count(*) from tasks t join lists l on l.id = t.list_id where l.user_id = $1) as tasks,
+    (select count(*) from comments where author_id = $1) as comments,
+    (select count(*) from attachments where user_id = $1 and task_id is not null) as attachments,
+    (select coalesce(sum(size), 0) from attachments where user_id = $1 and task_id is not null) as attachment_bytes,
+    (select count(*) from access_tokens
+     where user_id = $1 and revoked_at is null and (expires_at is null or expires_at > now())) as access_tokens`
+
+	var usage domain.UserUsage
+	if err := connection.GetContext(ctx, &usage, query, userID); err != nil {
+		return usage, errors.Join(ErrUsageRead, err)
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,56 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewUsage()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		user := fixtureCreateUser(t, ctx, connection)
+		list := fixtureCreateList(t, ctx, connection, user.ID)
+		_ = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "Pay rent")
+		_ = fixtureCreateTask(t, ctx, connection, user.ID, list.ID, "Buy milk")
+
+		usage, err := repo.Read(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.UserUsage{Lists: 1, Tasks: 2}, usage)
+
+		usage, err = repo.Read(ctx, connection, uuid.New())
+		require.NoError(t, err)
+		require.Zero(t, usage)
+
+		return nil
+	})
+}
+
+func TestUsageUnit(t *testing.T) {
+	userID := domain.UserID(uuid.New())
+	ctx := context.Background()
+
+	connection := dbMocks.NewMockConnection(t)
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, userID).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewUsage().Read(ctx, connection, userID)
+
+	require.ErrorIs(t, err, repository.ErrUsageRead)
+	require.ErrorContains(t, err, "some error")
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"todo_list/internal/domain"
 )
@@ -16,8 +17,17 @@ var (
 	ErrUsersUpdate             = errors.Join(errUsers, errors.New("update failed"))
 	ErrUsersDelete             = errors.Join(errUsers, errors.New("delete failed"))
 	ErrUsersUpdateTokenByEmail = errors.Join(errUsers, errors.New("update token by email failed"))
+	ErrUsersUpdatePreferences  = errors.Join(errUsers, errors.New("update preferences failed"))
+	ErrUsersUpdateName         = errors.Join(errUsers, errors.New("update name failed"))
+	ErrUsersUpdatePassword     = errors.Join(errUsers, errors.New("update password failed"))
+	ErrUsersVerify             = errors.Join(errUsers, errors.New("verify failed"))
+	ErrUsersSearch             = errors.Join(errUsers, errors.New("search failed"))
+	ErrUsersSetDisabled        = errors.Join(errUsers, errors.New("set disabled failed"))
+	ErrUsersUpdateRole         = errors.Join(errUsers, errors.New("update role failed"))
 )
 
+const userColumns = `id, name, email, password_hash, token, time_zone, locale, verified_at, role, disabled_at`
+
 type Users struct{}
 
 func NewUsers() *Users {
@@ -27,11 +37,11 @@ func NewUsers() *Users {
 func (r Users) Create(ctx context.Context, connection domain.Connection, user domain.User) error {
 	const query = `
 insert into users
-    (id, name, email, password_hash, token)
+    (id, name, email, password_hash, token, time_zone, locale)
 values
-    ($1, $2, $3, $4, $5)`
+    ($1, $2, $3, $4, $5, $6, $7)`
 
-	_, err := connection.ExecContext(ctx, query, user.ID, user.Name, user.Email, user.PasswordHash, user.Token)
+	_, err := connection.ExecContext(ctx, query, user.ID, user.Name, user.Email, user.PasswordHash, user.Token, user.TimeZone, user.Locale)
 	if err != nil {
 		return errors.Join(ErrUsersCreate, err)
 	}
@@ -39,8 +49,10 @@ values
 	return nil
 }
 
+// Delete removes the user along with their personal workspace.
 func (r Users) Delete(ctx context.Context, connection domain.Connection, userID domain.UserID) error {
-	const query = `delete from users where id = $1`
+	const query = `with personal as (delete from workspaces where id = $1 and personal)
+delete from users where id = $1`
 
 	_, err := connection.ExecContext(ctx, query, userID)
 	if err != nil {
@@ -51,7 +63,7 @@ func (r Users) Delete(ctx context.Context, connection domain.Connection, userID
 }
 
 func (r Users) ReadByToken(ctx context.Context, connection domain.Connection, token string) (domain.User, error) {
-	const query = `select id, name, email, password_hash, token from users where token = $1`
+	const query = `select ` + userColumns + ` from users where token = $1`
 
 	var user domain.User
 	err := connection.GetContext(ctx, &user, query, token)
@@ -63,7 +75,7 @@ func (r Users) ReadByToken(ctx context.Context, connection domain.Connection, to
 }
 
 func (r Users) ReadByEmail(ctx context.Context, connection domain.Connection, email string) (domain.User, error) {
-	const query = `select id, name, email, password_hash, token from users where email = $1`
+	const query = `select ` + userColumns + ` from users where email = $1`
 
 	var user domain.User
 	err := connection.GetContext(ctx, &user, query, email)
@@ -74,13 +86,28 @@ func (r Users) ReadByEmail(ctx context.Context, connection domain.Connection, em
 	return user, nil
 }
 
+func (r Users) ReadByID(ctx context.Context, connection domain.Connection, userID domain.UserID) (domain.User, error) {
+	const query = `select ` + userColumns + ` from users where id = $1`
+
+	var user domain.User
+	err := connection.GetContext(ctx, &user, query, userID)
+	if err != nil {
+		return user, errors.Join(ErrUsersRead, err)
+	}
+
+	return user, nil
+}
+
 func (r Users) Update(ctx context.Context, connection domain.Connection, user domain.User) error {
 	const query = `update users set name = $2, email = $3, password_hash = $4, token = $5, updated_at = default where id = $1`
 
-	_, err := connection.ExecContext(ctx, query, user.ID, user.Name, user.Email, user.PasswordHash, user.Token)
+	updated, err := connection.ExecContext(ctx, query, user.ID, user.Name, user.Email, user.PasswordHash, user.Token)
 	if err != nil {
 		return errors.Join(ErrUsersUpdate, err)
 	}
+	if updated <= 0 {
+		return errors.Join(ErrUsersUpdate, errors.New("user not found"))
+	}
 
 	return nil
 }
@@ -94,3 +121,103 @@ func (r Users) UpdateTokenByEmail(ctx context.Context, connection domain.Connect
 
 	return nil
 }
+
+func (r Users) UpdatePreferences(ctx context.Context, connection domain.Connection, userID domain.UserID, preferences domain.Preferences) error {
+	const query = `update users set time_zone = $2, locale = $3, updated_at = default where id = $1`
+
+	updated, err := connection.ExecContext(ctx, query, userID, preferences.TimeZone, preferences.Locale)
+	if err != nil {
+		return errors.Join(ErrUsersUpdatePreferences, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrUsersUpdatePreferences, errors.New("user not found"))
+	}
+
+	return nil
+}
+
+func (r Users) UpdateName(ctx context.Context, connection domain.Connection, userID domain.UserID, name string) error {
+	const query = `update users set name = $2, updated_at = default where id = $1`
+
+	updated, err := connection.ExecContext(ctx, query, userID, name)
+	if err != nil {
+		return errors.Join(ErrUsersUpdateName, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrUsersUpdateName, errors.New("user not found"))
+	}
+
+	return nil
+}
+
+func (r Users) UpdatePassword(ctx context.Context, connection domain.Connection, userID domain.UserID, passwordHash string, token string) error {
+	const query = `update users set password_hash = $2, token = $3, updated_at = default where id = $1`
+
+	updated, err := connection.ExecContext(ctx, query, userID, passwordHash, token)
+	if err != nil {
+		return errors.Join(ErrUsersUpdatePassword, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrUsersUpdatePassword, errors.New("user not found"))
+	}
+
+	return nil
+}
+
+// Verify keeps the time of the first verification.
+func (r Users) Verify(ctx context.Context, connection domain.Connection, userID domain.UserID, email string) error {
+	const query = `update users set verified_at = coalesce(verified_at, now()) where id = $1 and email = $2`
+
+	updated, err := connection.ExecContext(ctx, query, userID, email)
+	if err != nil {
+		return errors.Join(ErrUsersVerify, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrUsersVerify, errors.New("user not found or email changed"))
+	}
+
+	return nil
+}
+
+func (r Users) Search(ctx context.Context, connection domain.Connection, text string, page domain.Page) ([]domain.User, error) {
+	const query = `select ` + userColumns + ` from users
+where email ilike $1 or name ilike $1
+order by email
+limit $2 offset $3`
+
+	users := []domain.User{}
+	err := connection.SelectContext(ctx, &users, query, "%"+escapeLike(text)+"%", page.Limit, page.Offset)
+	if err != nil {
+		return nil, errors.Join(ErrUsersSearch, err)
+	}
+
+	return users, nil
+}
+
+func (r Users) SetDisabled(ctx context.Context, connection domain.Connection, userID domain.UserID, disabledAt *time.Time) error {
+	const query = `update users set disabled_at = $2, updated_at = default where id = $1`
+
+	updated, err := connection.ExecContext(ctx, query, userID, disabledAt)
+	if err != nil {
+		return errors.Join(ErrUsersSetDisabled, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrUsersSetDisabled, errors.New("user not found"))
+	}
+
+	return nil
+}
+
+func (r Users) UpdateRole(ctx context.Context, connection domain.Connection, userID domain.UserID, role domain.Role) error {
+	const query = `update users set role = $2, updated_at = default where id = $1`
+
+	updated, err := connection.ExecContext(ctx, query, userID, role)
+	if err != nil {
+		return errors.Join(ErrUsersUpdateRole, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrUsersUpdateRole, errors.New("user not found"))
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"todo_list/internal/adapter/repository"
 	"todo_list/internal/domain"
@@ -16,7 +17,7 @@ import (
 )
 
 func TestUsersIntegration(t *testing.T) {
-	ctx := context.Background()
+	ctx := domain.WithoutAuthUser(context.Background())
 
 	repo := repository.NewUsers()
 	provider := cleanTablesAndCreateProvider(ctx, t)
@@ -36,12 +37,42 @@ func TestUsersIntegration(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, user.Name, updatedUser.Name)
 
+		require.NoError(t, repo.UpdateName(ctx, connection, user.ID, "renamed"))
+		updatedUser, err = repo.ReadByID(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, "renamed", updatedUser.Name)
+
 		newToken := "some new token"
 		require.NoError(t, repo.UpdateTokenByEmail(ctx, connection, user.Email, newToken))
 		updatedUser, err = repo.ReadByEmail(ctx, connection, user.Email)
 		require.NoError(t, err)
 		require.Equal(t, newToken, updatedUser.Token)
 
+		require.Nil(t, updatedUser.VerifiedAt)
+		require.ErrorIs(t, repo.Verify(ctx, connection, user.ID, "old@email.foo"), repository.ErrUsersVerify)
+		require.NoError(t, repo.Verify(ctx, connection, user.ID, user.Email))
+		updatedUser, err = repo.ReadByID(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.NotNil(t, updatedUser.VerifiedAt)
+
+		require.Equal(t, domain.RoleUser, updatedUser.Role)
+		require.NoError(t, repo.UpdateRole(ctx, connection, user.ID, domain.RoleAdmin))
+		disabledAt := time.Now()
+		require.NoError(t, repo.SetDisabled(ctx, connection, user.ID, &disabledAt))
+		updatedUser, err = repo.ReadByID(ctx, connection, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.RoleAdmin, updatedUser.Role)
+		require.NotNil(t, updatedUser.DisabledAt)
+		require.NoError(t, repo.SetDisabled(ctx, connection, user.ID, nil))
+
+		found, err := repo.Search(ctx, connection, "USER@", domain.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		require.Nil(t, found[0].DisabledAt)
+		found, err = repo.Search(ctx, connection, "%", domain.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, found)
+
 		require.NoError(t, repo.Delete(ctx, connection, user.ID))
 
 		_, err = repo.ReadByToken(ctx, connection, user.Token)
@@ -69,7 +100,7 @@ func TestUsersUnit(t *testing.T) {
 			name: "Create DB Error",
 			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
 				connection.EXPECT().
-					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, validEmptyUser.Name, validEmptyUser.Email, validEmptyUser.PasswordHash, validEmptyUser.Token).
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, validEmptyUser.Name, validEmptyUser.Email, validEmptyUser.PasswordHash, validEmptyUser.Token, validEmptyUser.TimeZone, validEmptyUser.Locale).
 					Return(0, errors.New("some error")).
 					Once()
 
@@ -149,6 +180,126 @@ func TestUsersUnit(t *testing.T) {
 				require.ErrorContains(t, err, "some error")
 			},
 		},
+		{
+			name: "Update Preferences DB Error",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, "Europe/Moscow", "ru").
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.UpdatePreferences(ctx, connection, validEmptyUser.ID, domain.Preferences{TimeZone: "Europe/Moscow", Locale: "ru"})
+
+				require.ErrorIs(t, err, repository.ErrUsersUpdatePreferences)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Update Preferences Not Found",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, "UTC", "en").
+					Return(0, nil).
+					Once()
+
+				err := repo.UpdatePreferences(ctx, connection, validEmptyUser.ID, domain.Preferences{TimeZone: "UTC", Locale: "en"})
+
+				require.ErrorIs(t, err, repository.ErrUsersUpdatePreferences)
+			},
+		},
+		{
+			name: "Update Not Found",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, validEmptyUser.Name, validEmptyUser.Email,
+						validEmptyUser.PasswordHash, validEmptyUser.Token).
+					Return(0, nil).
+					Once()
+
+				err := repo.Update(ctx, connection, validEmptyUser)
+
+				require.ErrorIs(t, err, repository.ErrUsersUpdate)
+			},
+		},
+		{
+			name: "Update Name Not Found",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, "new name").
+					Return(0, nil).
+					Once()
+
+				err := repo.UpdateName(ctx, connection, validEmptyUser.ID, "new name")
+
+				require.ErrorIs(t, err, repository.ErrUsersUpdateName)
+			},
+		},
+		{
+			name: "Verify Email Changed",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, validEmptyUser.Email).
+					Return(0, nil).
+					Once()
+
+				err := repo.Verify(ctx, connection, validEmptyUser.ID, validEmptyUser.Email)
+
+				require.ErrorIs(t, err, repository.ErrUsersVerify)
+			},
+		},
+		{
+			name: "Update Password Not Found",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, "new hash", "new token").
+					Return(0, nil).
+					Once()
+
+				err := repo.UpdatePassword(ctx, connection, validEmptyUser.ID, "new hash", "new token")
+
+				require.ErrorIs(t, err, repository.ErrUsersUpdatePassword)
+			},
+		},
+		{
+			name: "Search Escapes Pattern",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, `%50\%%`, 20, 40).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Search(ctx, connection, "50%", domain.Page{Limit: 20, Offset: 40})
+
+				require.ErrorIs(t, err, repository.ErrUsersSearch)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Set Disabled Not Found",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, (*time.Time)(nil)).
+					Return(0, nil).
+					Once()
+
+				err := repo.SetDisabled(ctx, connection, validEmptyUser.ID, nil)
+
+				require.ErrorIs(t, err, repository.ErrUsersSetDisabled)
+			},
+		},
+		{
+			name: "Update Role Not Found",
+			check: func(t *testing.T, repo *repository.Users, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validEmptyUser.ID, domain.RoleAdmin).
+					Return(0, nil).
+					Once()
+
+				err := repo.UpdateRole(ctx, connection, validEmptyUser.ID, domain.RoleAdmin)
+
+				require.ErrorIs(t, err, repository.ErrUsersUpdateRole)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -165,8 +316,12 @@ func fixtureCreateUser(t *testing.T, ctx context.Context, connection domain.Conn
 		Email:        "user@email.foo",
 		PasswordHash: "some password hash",
 		Token:        "some secret token",
+		TimeZone:     domain.DefaultTimeZone,
+		Locale:       domain.DefaultLocale,
 	}
 	require.NoError(t, repository.NewUsers().Create(ctx, connection, user))
+	workspace := domain.Workspace{ID: domain.PersonalWorkspace(user.ID), Name: user.Name, Personal: true, CreatedAt: time.Now()}
+	require.NoError(t, repository.NewWorkspaces().Create(ctx, connection, workspace, user.ID))
 
 	return user
 }
@@ -17,16 +17,18 @@ import (
 func cleanTablesAndCreateProvider(ctx context.Context, t *testing.T) domain.ConnectionProvider {
 	godotenv.Load("../../../.env")
 
-	tablesToClean := []string{"users", "lists", "tasks"}
+	// Children first, the rest of the tables cascade from users. The role of
+	// the application may not truncate.
+	tablesToClean := []string{"tasks", "lists", "workspaces", "users"}
 
 	pool, err := pgxpool.New(context.Background(), os.Getenv("DB_CONNECTION"))
 	require.NoError(t, err)
 
 	provider := database.NewPostgresProvider(pool)
 
-	err = provider.Execute(ctx, func(ctx context.Context, connection domain.Connection) error {
+	err = provider.Execute(domain.WithoutAuthUser(ctx), func(ctx context.Context, connection domain.Connection) error {
 		for _, table := range tablesToClean {
-			_, err := connection.ExecContext(ctx, fmt.Sprintf("truncate %s cascade", table))
+			_, err := connection.ExecContext(ctx, fmt.Sprintf("delete from %s", table))
 			require.NoError(t, err)
 		}
 
@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"todo_list/internal/domain"
+)
+
+var _ domain.WorkspacesRepository = (*Workspaces)(nil)
+
+var (
+	errWorkspaces                 = errors.New("workspaces repository error")
+	ErrWorkspacesCreate           = errors.Join(errWorkspaces, errors.New("create failed"))
+	ErrWorkspacesRead             = errors.Join(errWorkspaces, errors.New("read failed"))
+	ErrWorkspacesReadAll          = errors.Join(errWorkspaces, errors.New("read all failed"))
+	ErrWorkspacesRename           = errors.Join(errWorkspaces, errors.New("rename failed"))
+	ErrWorkspacesDelete           = errors.Join(errWorkspaces, errors.New("delete failed"))
+	ErrWorkspacesReadMembers      = errors.Join(errWorkspaces, errors.New("read members failed"))
+	ErrWorkspacesAddMember        = errors.Join(errWorkspaces, errors.New("add member failed"))
+	ErrWorkspacesRemoveMember     = errors.Join(errWorkspaces, errors.New("remove member failed"))
+	ErrWorkspacesCreateInvitation = errors.Join(errWorkspaces, errors.New("create invitation failed"))
+	ErrWorkspacesReadInvitations  = errors.Join(errWorkspaces, errors.New("read invitations failed"))
+	ErrWorkspacesDeleteInvitation = errors.Join(errWorkspaces, errors.New("delete invitation failed"))
+	ErrWorkspacesUseInvitation    = errors.Join(errWorkspaces, errors.New("use invitation failed"))
+)
+
+type Workspaces struct{}
+
+func NewWorkspaces() *Workspaces {
+	return &Workspaces{}
+}
+
+func (r Workspaces) Create(ctx context.Context, connection domain.Connection, workspace domain.Workspace, ownerID domain.UserID) error {
+	const query = `insert into workspaces (id, name, personal, created_at) values ($1, $2, $3, $4)`
+
+	_, err := connection.ExecContext(ctx, query, workspace.ID, workspace.Name, workspace.Personal, workspace.CreatedAt)
+	if err != nil {
+		return errors.Join(ErrWorkspacesCreate, err)
+	}
+
+	if err = r.AddMember(ctx, connection, workspace.ID, ownerID, domain.WorkspaceRoleOwner); err != nil {
+		return errors.Join(ErrWorkspacesCreate, err)
+	}
+
+	return nil
+}
+
+func (r Workspaces) Read(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID,
+) (domain.Workspace, error) {
+	const query = `select w.id, w.name, w.personal, m.role, w.created_at from workspaces w
+join workspace_members m on m.workspace_id = w.id
+where m.user_id = $1 and w.id = $2`
+
+	var workspace domain.Workspace
+	if err := connection.GetContext(ctx, &workspace, query, userID, workspaceID); err != nil {
+		return workspace, errors.Join(ErrWorkspacesRead, err)
+	}
+
+	return workspace, nil
+}
+
+// ReadAll returns the personal workspace first, then the others by name.
+func (r Workspaces) ReadAll(ctx context.Context, connection domain.Connection, userID domain.UserID) ([]domain.Workspace, error) {
+	const query = `select w.id, w.name, w.personal, m.role, w.created_at from workspaces w
+join workspace_members m on m.workspace_id = w.id
+where m.user_id = $1
+order by w.personal desc, w.name, w.id`
+
+	var workspaces []domain.Workspace
+	if err := connection.SelectContext(ctx, &workspaces, query, userID); err != nil {
+		return nil, errors.Join(ErrWorkspacesReadAll, err)
+	}
+
+	return workspaces, nil
+}
+
+func (r Workspaces) Rename(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID, name string) error {
+	const query = `update workspaces set name = $2, updated_at = default where id = $1`
+
+	updated, err := connection.ExecContext(ctx, query, workspaceID, name)
+	if err != nil {
+		return errors.Join(ErrWorkspacesRename, err)
+	}
+	if updated <= 0 {
+		return errors.Join(ErrWorkspacesRename, errors.New("workspace not found"))
+	}
+
+	return nil
+}
+
+func (r Workspaces) Delete(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID) error {
+	const query = `delete from workspaces where id = $1 and not personal`
+
+	deleted, err := connection.ExecContext(ctx, query, workspaceID)
+	if err != nil {
+		return errors.Join(ErrWorkspacesDelete, err)
+	}
+	if deleted <= 0 {
+		return errors.Join(ErrWorkspacesDelete, errors.New("workspace not found or personal"))
+	}
+
+	return nil
+}
+
+// ReadMembers returns the owners first, then the members by name.
+func (r Workspaces) ReadMembers(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID,
+) ([]domain.WorkspaceMember, error) {
+	const query = `select u.id, u.name, u.email, m.role from users u
+join workspace_members m on m.user_id = u.id
+where m.workspace_id = $1
+order by m.role = 'owner' desc, u.name, u.id`
+
+	var members []domain.WorkspaceMember
+	if err := connection.SelectContext(ctx, &members, query, workspaceID); err != nil {
+		return nil, errors.Join(ErrWorkspacesReadMembers, err)
+	}
+
+	return members, nil
+}
+
+func (r Workspaces) AddMember(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID, userID domain.UserID,
+	role domain.WorkspaceRole,
+) error {
+	const query = `insert into workspace_members (workspace_id, user_id, role) values ($1, $2, $3) on conflict do nothing`
+
+	if _, err := connection.ExecContext(ctx, query, workspaceID, userID, role); err != nil {
+		return errors.Join(ErrWorkspacesAddMember, err)
+	}
+
+	return nil
+}
+
+// RemoveMember keeps the lists the member owns in the workspace, other owners
+// see them still. Assignments and shares go first: row-level security hides
+// the lists from a member leaving on their own afterwards. The transaction of
+// the caller brings them back when the member isn't removed.
+func (r Workspaces) RemoveMember(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID, memberID domain.UserID) error {
+	const unassign = `delete from task_assignees a
+using tasks t, lists l
+where t.id = a.task_id and l.id = t.list_id and l.workspace_id = $1 and a.user_id = $2`
+
+	if _, err := connection.ExecContext(ctx, unassign, workspaceID, memberID); err != nil {
+		return errors.Join(ErrWorkspacesRemoveMember, err)
+	}
+
+	const unshare = `delete from list_members m
+using lists l
+where l.id = m.list_id and l.workspace_id = $1 and m.user_id = $2`
+
+	if _, err := connection.ExecContext(ctx, unshare, workspaceID, memberID); err != nil {
+		return errors.Join(ErrWorkspacesRemoveMember, err)
+	}
+
+	const query = `delete from workspace_members where workspace_id = $1 and user_id = $2 and role <> 'owner'`
+
+	removed, err := connection.ExecContext(ctx, query, workspaceID, memberID)
+	if err != nil {
+		return errors.Join(ErrWorkspacesRemoveMember, err)
+	}
+	if removed <= 0 {
+		return errors.Join(ErrWorkspacesRemoveMember, errors.New("member not found or owner"))
+	}
+
+	return nil
+}
+
+func (r Workspaces) CreateInvitation(ctx context.Context, connection domain.Connection, invitation domain.WorkspaceInvitation) error {
+	const query = `
+insert into workspace_invitations
+    (id, workspace_id, email, token_hash, invited_by, created_at, expires_at)
+values
+    ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := connection.ExecContext(ctx, query, invitation.ID, invitation.WorkspaceID, invitation.Email, invitation.TokenHash,
+		invitation.InvitedBy, invitation.CreatedAt, invitation.ExpiresAt)
+	if err != nil {
+		return errors.Join(ErrWorkspacesCreateInvitation, err)
+	}
+
+	return nil
+}
+
+func (r Workspaces) ReadInvitations(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID,
+) ([]domain.WorkspaceInvitation, error) {
+	const query = `
+select id, workspace_id, email, token_hash, invited_by, created_at, expires_at from workspace_invitations
+where workspace_id = $1 and expires_at > now() order by created_at`
+
+	var invitations []domain.WorkspaceInvitation
+	if err := connection.SelectContext(ctx, &invitations, query, workspaceID); err != nil {
+		return nil, errors.Join(ErrWorkspacesReadInvitations, err)
+	}
+
+	return invitations, nil
+}
+
+func (r Workspaces) DeleteInvitation(ctx context.Context, connection domain.Connection, workspaceID domain.WorkspaceID,
+	invitationID domain.WorkspaceInvitationID,
+) error {
+	const query = `delete from workspace_invitations where workspace_id = $1 and id = $2`
+
+	deleted, err := connection.ExecContext(ctx, query, workspaceID, invitationID)
+	if err != nil {
+		return errors.Join(ErrWorkspacesDeleteInvitation, err)
+	}
+	if deleted <= 0 {
+		return errors.Join(ErrWorkspacesDeleteInvitation, errors.New("invitation not found"))
+	}
+
+	return nil
+}
+
+func (r Workspaces) UseInvitation(ctx context.Context, connection domain.Connection, tokenHash string) (domain.WorkspaceInvitation, error) {
+	const query = `
+delete from workspace_invitations where token_hash = $1 and expires_at > now()
+returning id, workspace_id, email, token_hash, invited_by, created_at, expires_at`
+
+	var invitation domain.WorkspaceInvitation
+	if err := connection.GetContext(ctx, &invitation, query, tokenHash); err != nil {
+		return invitation, errors.Join(ErrWorkspacesUseInvitation, err)
+	}
+
+	return invitation, nil
+}
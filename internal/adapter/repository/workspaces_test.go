@@ -0,0 +1,234 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/adapter/repository"
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspacesIntegration(t *testing.T) {
+	ctx := domain.WithoutAuthUser(context.Background())
+
+	repo := repository.NewWorkspaces()
+	provider := cleanTablesAndCreateProvider(ctx, t)
+	defer func() { _ = provider.Close() }()
+
+	provider.ExecuteTx(ctx, func(ctx context.Context, connection domain.Connection) error {
+		owner := fixtureCreateUser(t, ctx, connection)
+		member := domain.User{
+			ID:           domain.UserID(uuid.New()),
+			Name:         "member name",
+			Email:        "member@email.foo",
+			PasswordHash: "some password hash",
+			Token:        "another secret token",
+		}
+		require.NoError(t, repository.NewUsers().Create(ctx, connection, member))
+
+		workspace := domain.Workspace{ID: domain.WorkspaceID(uuid.New()), Name: "team", CreatedAt: time.Now()}
+		require.NoError(t, repo.Create(ctx, connection, workspace, owner.ID))
+
+		workspaces, err := repo.ReadAll(ctx, connection, owner.ID)
+		require.NoError(t, err)
+		require.Len(t, workspaces, 2)
+		require.True(t, workspaces[0].Personal)
+		require.Equal(t, domain.WorkspaceRoleOwner, workspaces[1].Role)
+
+		_, err = repo.Read(ctx, connection, member.ID, workspace.ID)
+		require.ErrorIs(t, err, repository.ErrWorkspacesRead)
+
+		now := time.Now()
+		invitation := domain.WorkspaceInvitation{
+			ID:          domain.WorkspaceInvitationID(uuid.New()),
+			WorkspaceID: workspace.ID,
+			Email:       member.Email,
+			TokenHash:   "some token hash",
+			InvitedBy:   &owner.ID,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(time.Hour),
+		}
+		require.NoError(t, repo.CreateInvitation(ctx, connection, invitation))
+
+		invitations, err := repo.ReadInvitations(ctx, connection, workspace.ID)
+		require.NoError(t, err)
+		require.Len(t, invitations, 1)
+
+		used, err := repo.UseInvitation(ctx, connection, invitation.TokenHash)
+		require.NoError(t, err)
+		require.Equal(t, workspace.ID, used.WorkspaceID)
+		_, err = repo.UseInvitation(ctx, connection, invitation.TokenHash)
+		require.ErrorIs(t, err, repository.ErrWorkspacesUseInvitation)
+
+		require.NoError(t, repo.AddMember(ctx, connection, workspace.ID, member.ID, domain.WorkspaceRoleMember))
+		require.NoError(t, repo.AddMember(ctx, connection, workspace.ID, member.ID, domain.WorkspaceRoleMember))
+
+		members, err := repo.ReadMembers(ctx, connection, workspace.ID)
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		require.Equal(t, owner.ID, members[0].ID)
+
+		require.NoError(t, repo.Rename(ctx, connection, workspace.ID, "renamed"))
+		renamed, err := repo.Read(ctx, connection, member.ID, workspace.ID)
+		require.NoError(t, err)
+		require.Equal(t, "renamed", renamed.Name)
+
+		require.ErrorIs(t, repo.RemoveMember(ctx, connection, workspace.ID, owner.ID), repository.ErrWorkspacesRemoveMember)
+		require.NoError(t, repo.RemoveMember(ctx, connection, workspace.ID, member.ID))
+
+		require.ErrorIs(t, repo.Delete(ctx, connection, domain.PersonalWorkspace(owner.ID)), repository.ErrWorkspacesDelete)
+		require.NoError(t, repo.Delete(ctx, connection, workspace.ID))
+
+		return nil
+	})
+}
+
+func TestWorkspacesUnit(t *testing.T) {
+	validWorkspace := domain.Workspace{
+		ID:        domain.WorkspaceID(uuid.New()),
+		Name:      "team",
+		CreatedAt: time.Now(),
+	}
+	userID := domain.UserID(uuid.New())
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.Workspaces, *dbMocks.MockConnection)
+	}{
+		{
+			name: "Create DB Error",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID, validWorkspace.Name, validWorkspace.Personal, validWorkspace.CreatedAt).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Create(ctx, connection, validWorkspace, userID)
+
+				require.ErrorIs(t, err, repository.ErrWorkspacesCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Create Adds Owner",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID, validWorkspace.Name, validWorkspace.Personal, validWorkspace.CreatedAt).
+					Return(1, nil).
+					Once()
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID, userID, domain.WorkspaceRoleOwner).
+					Return(1, nil).
+					Once()
+
+				require.NoError(t, repo.Create(ctx, connection, validWorkspace, userID))
+			},
+		},
+		{
+			name: "Read DB Error",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, userID, validWorkspace.ID).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Read(ctx, connection, userID, validWorkspace.ID)
+
+				require.ErrorIs(t, err, repository.ErrWorkspacesRead)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Rename Not Found",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID, "renamed").
+					Return(0, nil).
+					Once()
+
+				err := repo.Rename(ctx, connection, validWorkspace.ID, "renamed")
+
+				require.ErrorIs(t, err, repository.ErrWorkspacesRename)
+			},
+		},
+		{
+			name: "Delete Personal",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID).
+					Return(0, nil).
+					Once()
+
+				err := repo.Delete(ctx, connection, validWorkspace.ID)
+
+				require.ErrorIs(t, err, repository.ErrWorkspacesDelete)
+				require.ErrorContains(t, err, "personal")
+			},
+		},
+		{
+			name: "Remove Member Owner",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID, userID).
+					Return(0, nil).
+					Times(3)
+
+				err := repo.RemoveMember(ctx, connection, validWorkspace.ID, userID)
+
+				require.ErrorIs(t, err, repository.ErrWorkspacesRemoveMember)
+			},
+		},
+		{
+			name: "Remove Member Unshares Lists",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID, userID).
+					Return(1, nil).
+					Times(3)
+
+				require.NoError(t, repo.RemoveMember(ctx, connection, validWorkspace.ID, userID))
+			},
+		},
+		{
+			name: "Delete Invitation Not Found",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				invitationID := domain.WorkspaceInvitationID(uuid.New())
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, validWorkspace.ID, invitationID).
+					Return(0, nil).
+					Once()
+
+				err := repo.DeleteInvitation(ctx, connection, validWorkspace.ID, invitationID)
+
+				require.ErrorIs(t, err, repository.ErrWorkspacesDeleteInvitation)
+			},
+		},
+		{
+			name: "Use Invitation DB Error",
+			check: func(t *testing.T, repo *repository.Workspaces, connection *dbMocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, "some token hash").
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.UseInvitation(ctx, connection, "some token hash")
+
+				require.ErrorIs(t, err, repository.ErrWorkspacesUseInvitation)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewWorkspaces(), dbMocks.NewMockConnection(t))
+		})
+	}
+}
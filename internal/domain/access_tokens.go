@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessTokenPrefix tells access tokens from login tokens, and makes them
+// easy to find for secret scanners.
+const AccessTokenPrefix = "tdp_"
+
+// Scopes are all the scopes an access token can have. There is no
+// webhooks:admin yet, as there are no webhooks for it to guard.
+var Scopes = []Scope{ScopeListsRead, ScopeListsWrite, ScopeTasksRead, ScopeTasksWrite, ScopeFeedsAdmin}
+
+var (
+	_ AccessTokenInterface = (*AccessTokenService)(nil)
+)
+
+var (
+	errAccessTokenService             = errors.New("access token service error")
+	ErrAccessTokenServiceCreateToken  = errors.Join(errAccessTokenService, errors.New("create token failed"))
+	ErrAccessTokenServiceGetTokens    = errors.Join(errAccessTokenService, errors.New("get tokens failed"))
+	ErrAccessTokenServiceRevokeToken  = errors.Join(errAccessTokenService, errors.New("revoke token failed"))
+	ErrAccessTokenServiceAuthenticate = errors.Join(errAccessTokenService, errors.New("authenticate failed"))
+	ErrAccessTokenServiceInvalidArg   = errors.Join(ErrAccessTokenServiceCreateToken, errors.New("invalid access token"))
+)
+
+type AccessTokenService struct {
+	provider        ConnectionProvider
+	accessTokenRepo AccessTokensRepository
+	userRepo        UsersRepository
+}
+
+func NewAccessTokenService(provider ConnectionProvider, accessTokenRepo AccessTokensRepository, userRepo UsersRepository) *AccessTokenService {
+	return &AccessTokenService{
+		provider:        provider,
+		accessTokenRepo: accessTokenRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// Close implements AccessTokenInterface.
+func (s *AccessTokenService) Close() error {
+	return s.provider.Close()
+}
+
+// CreateToken implements AccessTokenInterface. Only a hash of the token is
+// stored, like for feed tokens.
+func (s *AccessTokenService) CreateToken(ctx context.Context, accessToken AccessToken, token string) (AccessToken, error) {
+	accessToken, err := validAccessToken(accessToken, time.Now())
+	if err != nil {
+		return AccessToken{}, errors.Join(ErrAccessTokenServiceCreateToken, err)
+	}
+	accessToken.ID = AccessTokenID(uuid.New())
+	accessToken.TokenHash = hashToken(token)
+	accessToken.CreatedAt = time.Now()
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.accessTokenRepo.Create(ctx, connection, accessToken)
+	})
+	if err != nil {
+		return AccessToken{}, errors.Join(ErrAccessTokenServiceCreateToken, err)
+	}
+
+	return accessToken, nil
+}
+
+// GetTokens implements AccessTokenInterface.
+func (s *AccessTokenService) GetTokens(ctx context.Context, userID UserID) ([]AccessToken, error) {
+	var tokens []AccessToken
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		tokens, err = s.accessTokenRepo.ReadAll(ctx, connection, userID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAccessTokenServiceGetTokens, err)
+	}
+
+	return tokens, nil
+}
+
+// RevokeToken implements AccessTokenInterface.
+func (s *AccessTokenService) RevokeToken(ctx context.Context, userID UserID, tokenID AccessTokenID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.accessTokenRepo.Revoke(ctx, connection, userID, tokenID)
+	})
+	if err != nil {
+		return errors.Join(ErrAccessTokenServiceRevokeToken, err)
+	}
+
+	return nil
+}
+
+// Authenticate implements AccessTokenInterface.
+func (s *AccessTokenService) Authenticate(ctx context.Context, token string) (User, []Scope, error) {
+	var user User
+	var accessToken AccessToken
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		accessToken, err = s.accessTokenRepo.ReadByHash(ctx, connection, hashToken(token))
+		if err != nil {
+			return err
+		}
+
+		if user, err = s.userRepo.ReadByID(ctx, connection, accessToken.UserID); err != nil {
+			return err
+		}
+
+		return checkEnabled(user)
+	})
+	if err != nil {
+		return User{}, nil, errors.Join(ErrAccessTokenServiceAuthenticate, err)
+	}
+
+	return user, accessToken.Scopes, nil
+}
+
+// validAccessToken trims the name and sorts the scopes, dropping repeated
+// ones.
+func validAccessToken(accessToken AccessToken, now time.Time) (AccessToken, error) {
+	accessToken.Name = strings.TrimSpace(accessToken.Name)
+	if accessToken.Name == "" {
+		return accessToken, errors.Join(ErrAccessTokenServiceInvalidArg, errors.New("empty name"))
+	}
+
+	if len(accessToken.Scopes) == 0 {
+		return accessToken, errors.Join(ErrAccessTokenServiceInvalidArg, errors.New("no scopes"))
+	}
+	for _, scope := range accessToken.Scopes {
+		if !slices.Contains(Scopes, scope) {
+			return accessToken, errors.Join(ErrAccessTokenServiceInvalidArg, fmt.Errorf("unknown scope %q", scope))
+		}
+	}
+	accessToken.Scopes = slices.Compact(slices.Sorted(slices.Values(accessToken.Scopes)))
+
+	if accessToken.ExpiresAt != nil && !accessToken.ExpiresAt.After(now) {
+		return accessToken, errors.Join(ErrAccessTokenServiceInvalidArg, errors.New("expiry in the past"))
+	}
+
+	return accessToken, nil
+}
@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenUnit(t *testing.T) {
+	ctx := context.Background()
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "ann@email.foo"}
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *domain.AccessTokenService, *dbMocks.MockAccessTokensRepository, *dbMocks.MockUsersRepository)
+	}{
+		{
+			name: "Create",
+			check: func(t *testing.T, service *domain.AccessTokenService, repo *dbMocks.MockAccessTokensRepository, _ *dbMocks.MockUsersRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(token domain.AccessToken) bool {
+					return token.UserID == user.ID && token.TokenHash != "" && token.TokenHash != "tdp_secret"
+				})).Return(nil).Once()
+
+				token, err := service.CreateToken(ctx, domain.AccessToken{
+					UserID:    user.ID,
+					Name:      " backup script ",
+					Scopes:    []domain.Scope{domain.ScopeTasksRead, domain.ScopeListsRead, domain.ScopeTasksRead},
+					ExpiresAt: &future,
+				}, "tdp_secret")
+
+				require.NoError(t, err)
+				require.Equal(t, "backup script", token.Name)
+				require.Equal(t, []domain.Scope{domain.ScopeListsRead, domain.ScopeTasksRead}, token.Scopes)
+			},
+		},
+		{
+			name: "Create Invalid",
+			check: func(t *testing.T, service *domain.AccessTokenService, _ *dbMocks.MockAccessTokensRepository, _ *dbMocks.MockUsersRepository) {
+				for _, token := range []domain.AccessToken{
+					{Name: "", Scopes: []domain.Scope{domain.ScopeTasksRead}},
+					{Name: "script"},
+					{Name: "script", Scopes: []domain.Scope{"webhooks:admin"}},
+					{Name: "script", Scopes: []domain.Scope{domain.ScopeTasksRead}, ExpiresAt: &past},
+				} {
+					_, err := service.CreateToken(ctx, token, "tdp_secret")
+
+					require.ErrorIs(t, err, domain.ErrAccessTokenServiceInvalidArg)
+				}
+			},
+		},
+		{
+			name: "Authenticate",
+			check: func(t *testing.T, service *domain.AccessTokenService, repo *dbMocks.MockAccessTokensRepository, users *dbMocks.MockUsersRepository) {
+				scopes := []domain.Scope{domain.ScopeTasksRead}
+				repo.EXPECT().ReadByHash(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.AccessToken{UserID: user.ID, Scopes: scopes}, nil).Once()
+				users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+
+				authenticated, granted, err := service.Authenticate(ctx, "tdp_secret")
+
+				require.NoError(t, err)
+				require.Equal(t, user, authenticated)
+				require.Equal(t, scopes, granted)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			repo, users := dbMocks.NewMockAccessTokensRepository(t), dbMocks.NewMockUsersRepository(t)
+
+			test.check(t, domain.NewAccessTokenService(provider, repo, users), repo, users)
+		})
+	}
+}
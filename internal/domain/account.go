@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// EmailChangeTTL is how long a mailed email change link works.
+const EmailChangeTTL = 24 * time.Hour
+
+var (
+	_ AccountInterface = (*AccountService)(nil)
+)
+
+var (
+	errAccountService                   = errors.New("account service error")
+	ErrAccountServiceProfile            = errors.Join(errAccountService, errors.New("read profile failed"))
+	ErrAccountServiceUpdateName         = errors.Join(errAccountService, errors.New("update name failed"))
+	ErrAccountServiceChangePassword     = errors.Join(errAccountService, errors.New("change password failed"))
+	ErrAccountServiceRequestEmailChange = errors.Join(errAccountService, errors.New("request email change failed"))
+	ErrAccountServiceEmailTaken         = errors.Join(ErrAccountServiceRequestEmailChange, errors.New("email taken"))
+	ErrAccountServiceConfirmEmailChange = errors.Join(errAccountService, errors.New("confirm email change failed"))
+	ErrAccountServiceInvalidToken       = errors.Join(ErrAccountServiceConfirmEmailChange, errors.New("invalid or expired token"))
+	ErrAccountServiceInvalidName        = errors.Join(ErrAccountServiceUpdateName, errors.New("empty name"))
+)
+
+// AccountService lets users change their own details. Like
+// EmailVerificationService it signs the email change links instead of
+// storing tokens. A token holds the user ID, its expiry and the new email,
+// and its HMAC covers the current email too, so it works only once.
+type AccountService struct {
+	provider        ConnectionProvider
+	userRepo        UsersRepository
+	resetRepo       PasswordResetsRepository
+	accessTokenRepo AccessTokensRepository
+	feedRepo        FeedTokensRepository
+	keyRing         JWTKeyRing
+	mailer          Mailer
+	secret          []byte
+	confirmURL      string
+}
+
+// NewAccountService mails email change links to confirmURL with the token
+// added as the token query parameter. The key ring is nil when JWTs are
+// turned off.
+func NewAccountService(provider ConnectionProvider, userRepo UsersRepository, resetRepo PasswordResetsRepository,
+	accessTokenRepo AccessTokensRepository, feedRepo FeedTokensRepository, keyRing JWTKeyRing, mailer Mailer,
+	secret []byte, confirmURL string,
+) *AccountService {
+	return &AccountService{
+		provider:        provider,
+		userRepo:        userRepo,
+		resetRepo:       resetRepo,
+		accessTokenRepo: accessTokenRepo,
+		feedRepo:        feedRepo,
+		keyRing:         keyRing,
+		mailer:          mailer,
+		secret:          secret,
+		confirmURL:      confirmURL,
+	}
+}
+
+// Close implements AccountInterface.
+func (s *AccountService) Close() error {
+	return s.provider.Close()
+}
+
+// Profile implements AccountInterface.
+func (s *AccountService) Profile(ctx context.Context, userID UserID) (User, error) {
+	var user User
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		user, err = s.userRepo.ReadByID(ctx, connection, userID)
+
+		return err
+	})
+	if err != nil {
+		return User{}, errors.Join(ErrAccountServiceProfile, err)
+	}
+
+	return user, nil
+}
+
+// UpdateName implements AccountInterface.
+func (s *AccountService) UpdateName(ctx context.Context, userID UserID, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrAccountServiceInvalidName
+	}
+
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		return s.userRepo.UpdateName(ctx, connection, userID, name)
+	})
+	if err != nil {
+		return errors.Join(ErrAccountServiceUpdateName, err)
+	}
+
+	return nil
+}
+
+// ChangePassword implements AccountInterface. Pending password resets are
+// used up too, the user just proved to know the password. The other
+// sessions end: the access tokens, feed tokens and JWTs of the user are
+// revoked.
+func (s *AccountService) ChangePassword(ctx context.Context, userID UserID, password string, passwordHash string,
+	token string,
+) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if _, err := s.checkPassword(ctx, connection, userID, password); err != nil {
+			return err
+		}
+
+		if err := s.userRepo.UpdatePassword(ctx, connection, userID, passwordHash, token); err != nil {
+			return err
+		}
+		if err := s.resetRepo.UseAll(ctx, connection, userID); err != nil {
+			return err
+		}
+		if err := s.feedRepo.RevokeAll(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return s.accessTokenRepo.RevokeAll(ctx, connection, userID)
+	})
+	if err == nil {
+		err = revokeJWTs(ctx, s.keyRing, userID)
+	}
+	if err != nil {
+		return errors.Join(ErrAccountServiceChangePassword, err)
+	}
+
+	return nil
+}
+
+// RequestEmailChange implements AccountInterface.
+func (s *AccountService) RequestEmailChange(ctx context.Context, userID UserID, password string, email string) error {
+	parsed, err := mail.ParseAddress(email)
+	if err != nil {
+		return errors.Join(ErrAccountServiceRequestEmailChange, err)
+	}
+	email = parsed.Address
+
+	var user User
+	err = s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if user, err = s.checkPassword(ctx, connection, userID, password); err != nil {
+			return err
+		}
+		if user.Email == email {
+			return errors.New("email unchanged")
+		}
+
+		// The unique email catches a race at confirmation, this only gives
+		// a clearer error.
+		_, err = s.userRepo.ReadByEmail(ctx, connection, email)
+		if err == nil {
+			return ErrAccountServiceEmailTaken
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+	if err != nil {
+		return errors.Join(ErrAccountServiceRequestEmailChange, err)
+	}
+
+	link, err := url.Parse(s.confirmURL)
+	if err != nil {
+		return errors.Join(ErrAccountServiceRequestEmailChange, err)
+	}
+	query := link.Query()
+	query.Set("token", s.token(user.ID, user.Email, email, time.Now().Add(EmailChangeTTL)))
+	link.RawQuery = query.Encode()
+
+	err = s.mailer.Send(ctx, Mail{
+		To:      email,
+		Subject: "Confirm your new email",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"To use this email for your account instead of %s, open\n\n"+
+			"%s\n\n"+
+			"The link works for %s. If you didn't ask for it, ignore this message.\n",
+			user.Name, user.Email, link, EmailChangeTTL),
+	})
+	if err != nil {
+		return errors.Join(ErrAccountServiceRequestEmailChange, err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange implements AccountInterface. Password resets mailed to
+// the old email are used up, the sessions end like for ChangePassword, and
+// the old email is told about the change.
+func (s *AccountService) ConfirmEmailChange(ctx context.Context, token string, newToken string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return errors.Join(ErrAccountServiceInvalidToken, errors.New("malformed token"))
+	}
+	userID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return errors.Join(ErrAccountServiceInvalidToken, err)
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return errors.Join(ErrAccountServiceInvalidToken, err)
+	}
+	if time.Now().Unix() >= expires {
+		return errors.Join(ErrAccountServiceInvalidToken, errors.New("token expired"))
+	}
+	email, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return errors.Join(ErrAccountServiceInvalidToken, err)
+	}
+
+	var user User
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if user, err = s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+		expected := s.token(user.ID, user.Email, string(email), time.Unix(expires, 0))
+		if !hmac.Equal([]byte(token), []byte(expected)) {
+			return errors.Join(ErrAccountServiceInvalidToken, errors.New("signature mismatch"))
+		}
+
+		changed := user
+		changed.Email, changed.Token = string(email), newToken
+		if err = s.userRepo.Update(ctx, connection, changed); err != nil {
+			return err
+		}
+		// The link proved the user reads the new email.
+		if err = s.userRepo.Verify(ctx, connection, user.ID, changed.Email); err != nil {
+			return err
+		}
+
+		if err = s.resetRepo.UseAll(ctx, connection, user.ID); err != nil {
+			return err
+		}
+		if err = s.feedRepo.RevokeAll(ctx, connection, user.ID); err != nil {
+			return err
+		}
+
+		return s.accessTokenRepo.RevokeAll(ctx, connection, user.ID)
+	})
+	if err == nil {
+		err = revokeJWTs(ctx, s.keyRing, user.ID)
+	}
+	if err != nil {
+		return errors.Join(ErrAccountServiceConfirmEmailChange, err)
+	}
+
+	// The change is done, a lost notice isn't worth failing it.
+	_ = s.mailer.Send(ctx, Mail{
+		To:      user.Email,
+		Subject: "Your email was changed",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"Your account now uses %s instead of this email. If you didn't change it,\n"+
+			"reset your password and contact us.\n", user.Name, email),
+	})
+
+	return nil
+}
+
+// checkPassword reads the password hash of the user, users authenticated
+// by a JWT come without one.
+func (s *AccountService) checkPassword(ctx context.Context, connection Connection, userID UserID, password string,
+) (User, error) {
+	user, err := s.userRepo.ReadByID(ctx, connection, userID)
+	if err != nil {
+		return User{}, err
+	}
+	if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return User{}, errors.Join(ErrToDoServiceInvalidPasswordUser, err)
+	}
+
+	return user, nil
+}
+
+func (s *AccountService) token(userID UserID, currentEmail string, newEmail string, expires time.Time) string {
+	payload := userID.String() + "." + strconv.FormatInt(expires.Unix(), 10) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(newEmail))
+
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("email change\n" + payload + "\n" + currentEmail))
+
+	return payload + "." + hex.EncodeToString(mac.Sum(nil))
+}
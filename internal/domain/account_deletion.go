@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	_ AccountDeletionInterface = (*AccountDeletionService)(nil)
+)
+
+var (
+	errAccountDeletionService         = errors.New("account deletion service error")
+	ErrAccountDeletionServiceSchedule = errors.Join(errAccountDeletionService, errors.New("schedule failed"))
+	ErrAccountDeletionServiceGet      = errors.Join(errAccountDeletionService, errors.New("get failed"))
+	ErrAccountDeletionServiceCancel   = errors.Join(errAccountDeletionService, errors.New("cancel failed"))
+	ErrAccountDeletionServicePurge    = errors.Join(errAccountDeletionService, errors.New("purge failed"))
+)
+
+// AccountDeletionService deletes accounts after a grace period. The user
+// may log in and cancel until then. Deleting the user cascades to their
+// data, the attachments sweep removes the blobs and the data exports sweep
+// the archives.
+type AccountDeletionService struct {
+	provider        ConnectionProvider
+	userRepo        UsersRepository
+	deletionRepo    AccountDeletionsRepository
+	twoFactorRepo   TwoFactorRepository
+	feedRepo        FeedTokensRepository
+	accessTokenRepo AccessTokensRepository
+	keyRing         JWTKeyRing
+	mailer          Mailer
+	grace           time.Duration
+}
+
+// NewAccountDeletionService takes a nil key ring when JWTs are turned off.
+func NewAccountDeletionService(provider ConnectionProvider, userRepo UsersRepository, deletionRepo AccountDeletionsRepository,
+	twoFactorRepo TwoFactorRepository, feedRepo FeedTokensRepository, accessTokenRepo AccessTokensRepository,
+	keyRing JWTKeyRing, mailer Mailer, grace time.Duration,
+) *AccountDeletionService {
+	return &AccountDeletionService{
+		provider:        provider,
+		userRepo:        userRepo,
+		deletionRepo:    deletionRepo,
+		twoFactorRepo:   twoFactorRepo,
+		feedRepo:        feedRepo,
+		accessTokenRepo: accessTokenRepo,
+		keyRing:         keyRing,
+		mailer:          mailer,
+		grace:           grace,
+	}
+}
+
+// Close implements AccountDeletionInterface.
+func (s *AccountDeletionService) Close() error {
+	return s.provider.Close()
+}
+
+// Schedule implements AccountDeletionInterface. The access tokens and JWTs of
+// the user are revoked, and stay revoked when the deletion is cancelled, the
+// user creates new ones.
+func (s *AccountDeletionService) Schedule(ctx context.Context, userID UserID, password string, code string,
+	newToken string,
+) (AccountDeletion, error) {
+	now := time.Now()
+	deletion := AccountDeletion{UserID: userID, RequestedAt: now, DeleteAt: now.Add(s.grace)}
+
+	var user User
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if user, err = s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+		if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+			return errors.Join(ErrToDoServiceInvalidPasswordUser, err)
+		}
+
+		twoFactor, err := s.twoFactorRepo.Read(ctx, connection, userID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil && twoFactor.EnabledAt != nil {
+			if err = checkTwoFactorCode(ctx, connection, s.twoFactorRepo, userID, code); err != nil {
+				return err
+			}
+		}
+
+		if err = s.deletionRepo.Create(ctx, connection, deletion); err != nil {
+			return err
+		}
+		if err = s.userRepo.UpdateTokenByEmail(ctx, connection, user.Email, newToken); err != nil {
+			return err
+		}
+		if err = s.feedRepo.RevokeAll(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return s.accessTokenRepo.RevokeAll(ctx, connection, userID)
+	})
+	if err == nil {
+		err = revokeJWTs(ctx, s.keyRing, userID)
+	}
+	if err != nil {
+		return AccountDeletion{}, errors.Join(ErrAccountDeletionServiceSchedule, err)
+	}
+
+	// The deletion is scheduled, a lost notice isn't worth failing it.
+	_ = s.mailer.Send(ctx, Mail{
+		To:      user.Email,
+		Subject: "Your account will be deleted",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"Your account and all its data will be deleted on %s.\n"+
+			"Until then you can log in and cancel the deletion.\n",
+			user.Name, deletion.DeleteAt.UTC().Format(time.RFC1123)),
+	})
+
+	return deletion, nil
+}
+
+// Get implements AccountDeletionInterface.
+func (s *AccountDeletionService) Get(ctx context.Context, userID UserID) (AccountDeletion, error) {
+	var deletion AccountDeletion
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		deletion, err = s.deletionRepo.Read(ctx, connection, userID)
+
+		return err
+	})
+	if err != nil {
+		return AccountDeletion{}, errors.Join(ErrAccountDeletionServiceGet, err)
+	}
+
+	return deletion, nil
+}
+
+// Cancel implements AccountDeletionInterface.
+func (s *AccountDeletionService) Cancel(ctx context.Context, userID UserID) error {
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		return s.deletionRepo.Delete(ctx, connection, userID)
+	})
+	if err != nil {
+		return errors.Join(ErrAccountDeletionServiceCancel, err)
+	}
+
+	return nil
+}
+
+// Purge implements AccountDeletionInterface. Every account is deleted in a
+// transaction of its own that removes the deletion first, so a cancel
+// racing the purge either removes it before and the account stays, or
+// waits for the purge and finds nothing to cancel.
+func (s *AccountDeletionService) Purge(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	var due []UserID
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		due, err = s.deletionRepo.ReadDue(ctx, connection, now, sweepBatchSize)
+
+		return err
+	})
+	if err != nil {
+		return 0, errors.Join(ErrAccountDeletionServicePurge, err)
+	}
+
+	purged := 0
+	for _, userID := range due {
+		deleted := false
+		err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+			scheduled, err := s.deletionRepo.DeleteDue(ctx, connection, userID, now)
+			if err != nil || !scheduled {
+				return err
+			}
+
+			if err = s.userRepo.Delete(ctx, connection, userID); err != nil {
+				return err
+			}
+			deleted = true
+
+			return nil
+		})
+		if err != nil {
+			return purged, errors.Join(ErrAccountDeletionServicePurge, err)
+		}
+		// Only a committed deletion counts.
+		if deleted {
+			purged++
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,140 @@
+package domain_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAccountDeletionUnit(t *testing.T) {
+	ctx := context.Background()
+	grace := 30 * 24 * time.Hour
+	passwordHash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo", PasswordHash: string(passwordHash)}
+	enabledAt := time.Now()
+
+	type repos struct {
+		users        *dbMocks.MockUsersRepository
+		deletions    *dbMocks.MockAccountDeletionsRepository
+		twoFactor    *dbMocks.MockTwoFactorRepository
+		feeds        *dbMocks.MockFeedTokensRepository
+		accessTokens *dbMocks.MockAccessTokensRepository
+		keyRing      *dbMocks.MockJWTKeyRing
+		mailer       *dbMocks.MockMailer
+	}
+
+	tests := []struct {
+		name      string
+		commitErr error
+		check     func(*testing.T, *domain.AccountDeletionService, repos)
+	}{
+		{
+			name: "Schedule",
+			check: func(t *testing.T, service *domain.AccountDeletionService, r repos) {
+				r.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				r.twoFactor.EXPECT().Read(mock.Anything, mock.Anything, user.ID).Return(domain.TwoFactor{}, sql.ErrNoRows).Once()
+				r.deletions.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(deletion domain.AccountDeletion) bool {
+					return deletion.UserID == user.ID && deletion.DeleteAt.Sub(deletion.RequestedAt) == grace
+				})).Return(nil).Once()
+				r.users.EXPECT().UpdateTokenByEmail(mock.Anything, mock.Anything, user.Email, "new token").Return(nil).Once()
+				r.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				r.accessTokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				r.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(nil).Once()
+				// The deletion stands even when the notice is lost.
+				r.mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+					return mail.To == user.Email
+				})).Return(errors.New("smtp down")).Once()
+
+				deletion, err := service.Schedule(ctx, user.ID, "secret", "", "new token")
+
+				require.NoError(t, err)
+				require.Equal(t, grace, deletion.DeleteAt.Sub(deletion.RequestedAt))
+			},
+		},
+		{
+			name: "Schedule Wrong Password",
+			check: func(t *testing.T, service *domain.AccountDeletionService, r repos) {
+				r.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+
+				_, err := service.Schedule(ctx, user.ID, "wrong", "", "new token")
+
+				require.ErrorIs(t, err, domain.ErrAccountDeletionServiceSchedule)
+				require.ErrorIs(t, err, domain.ErrToDoServiceInvalidPasswordUser)
+			},
+		},
+		{
+			name: "Schedule Wrong Code",
+			check: func(t *testing.T, service *domain.AccountDeletionService, r repos) {
+				r.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				r.twoFactor.EXPECT().Read(mock.Anything, mock.Anything, user.ID).
+					Return(domain.TwoFactor{UserID: user.ID, Secret: rfcSecret, EnabledAt: &enabledAt}, nil).Twice()
+				r.twoFactor.EXPECT().UseRecoveryCode(mock.Anything, mock.Anything, user.ID, mock.Anything).Return(sql.ErrNoRows).Once()
+
+				_, err := service.Schedule(ctx, user.ID, "secret", "", "new token")
+
+				require.ErrorIs(t, err, domain.ErrTwoFactorServiceInvalidCode)
+			},
+		},
+		{
+			name: "Purge",
+			check: func(t *testing.T, service *domain.AccountDeletionService, r repos) {
+				cancelled := domain.UserID(uuid.New())
+				r.deletions.EXPECT().ReadDue(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.UserID{user.ID, cancelled}, nil).Once()
+				r.deletions.EXPECT().DeleteDue(mock.Anything, mock.Anything, user.ID, mock.Anything).Return(true, nil).Once()
+				r.users.EXPECT().Delete(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				// Cancelled between reading the due deletions and purging.
+				r.deletions.EXPECT().DeleteDue(mock.Anything, mock.Anything, cancelled, mock.Anything).Return(false, nil).Once()
+
+				purged, err := service.Purge(ctx)
+
+				require.NoError(t, err)
+				require.Equal(t, 1, purged)
+			},
+		},
+		{
+			name:      "Purge Commit Failed",
+			commitErr: errors.New("some error"),
+			check: func(t *testing.T, service *domain.AccountDeletionService, r repos) {
+				r.deletions.EXPECT().ReadDue(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.UserID{user.ID}, nil).Once()
+				r.deletions.EXPECT().DeleteDue(mock.Anything, mock.Anything, user.ID, mock.Anything).Return(true, nil).Once()
+				r.users.EXPECT().Delete(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+
+				purged, err := service.Purge(ctx)
+
+				require.ErrorIs(t, err, domain.ErrAccountDeletionServicePurge)
+				require.Zero(t, purged)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := repos{
+				users:        dbMocks.NewMockUsersRepository(t),
+				deletions:    dbMocks.NewMockAccountDeletionsRepository(t),
+				twoFactor:    dbMocks.NewMockTwoFactorRepository(t),
+				feeds:        dbMocks.NewMockFeedTokensRepository(t),
+				accessTokens: dbMocks.NewMockAccessTokensRepository(t),
+				keyRing:      dbMocks.NewMockJWTKeyRing(t),
+				mailer:       dbMocks.NewMockMailer(t),
+			}
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			provider.commitErr = test.commitErr
+			service := domain.NewAccountDeletionService(provider, r.users, r.deletions, r.twoFactor, r.feeds, r.accessTokens,
+				r.keyRing, r.mailer, grace)
+
+			test.check(t, service, r)
+		})
+	}
+}
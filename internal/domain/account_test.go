@@ -0,0 +1,197 @@
+package domain_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAccountUnit(t *testing.T) {
+	ctx := context.Background()
+	secret := []byte("some secret")
+	passwordHash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo", PasswordHash: string(passwordHash)}
+
+	type accountMocks struct {
+		users   *dbMocks.MockUsersRepository
+		resets  *dbMocks.MockPasswordResetsRepository
+		tokens  *dbMocks.MockAccessTokensRepository
+		feeds   *dbMocks.MockFeedTokensRepository
+		keyRing *dbMocks.MockJWTKeyRing
+		mailer  *dbMocks.MockMailer
+	}
+	newService := func(t *testing.T) (*domain.AccountService, accountMocks) {
+		m := accountMocks{
+			users:   dbMocks.NewMockUsersRepository(t),
+			resets:  dbMocks.NewMockPasswordResetsRepository(t),
+			tokens:  dbMocks.NewMockAccessTokensRepository(t),
+			feeds:   dbMocks.NewMockFeedTokensRepository(t),
+			keyRing: dbMocks.NewMockJWTKeyRing(t),
+			mailer:  dbMocks.NewMockMailer(t),
+		}
+		service := domain.NewAccountService(newFakeProvider(dbMocks.NewMockConnection(t)), m.users, m.resets, m.tokens, m.feeds,
+			m.keyRing, m.mailer, secret, "https://todo.example/confirm-email")
+
+		return service, m
+	}
+
+	// requestedToken asks to change the email of the user to email and
+	// returns the token of the mailed link.
+	requestedToken := func(t *testing.T, email string) string {
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, email).Return(domain.User{}, sql.ErrNoRows).Once()
+
+		var token string
+		m.mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+			for _, line := range strings.Split(mail.Body, "\n") {
+				if link, err := url.Parse(line); err == nil && link.Query().Has("token") {
+					token = link.Query().Get("token")
+				}
+			}
+
+			return mail.To == email
+		})).Return(nil).Once()
+
+		require.NoError(t, service.RequestEmailChange(ctx, user.ID, "secret", email))
+		require.NotEmpty(t, token)
+
+		return token
+	}
+
+	t.Run("Update Name", func(t *testing.T) {
+		service, m := newService(t)
+		m.users.EXPECT().UpdateName(mock.Anything, mock.Anything, user.ID, "Anna").Return(nil).Once()
+
+		require.NoError(t, service.UpdateName(ctx, user.ID, " Anna "))
+		require.ErrorIs(t, service.UpdateName(ctx, user.ID, "  "), domain.ErrAccountServiceInvalidName)
+	})
+
+	t.Run("Change Password", func(t *testing.T) {
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Twice()
+		m.users.EXPECT().UpdatePassword(mock.Anything, mock.Anything, user.ID, "new hash", "new token").Return(nil).Once()
+		m.resets.EXPECT().UseAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.tokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(nil).Once()
+
+		require.NoError(t, service.ChangePassword(ctx, user.ID, "secret", "new hash", "new token"))
+
+		err := service.ChangePassword(ctx, user.ID, "wrong", "new hash", "new token")
+		require.ErrorIs(t, err, domain.ErrAccountServiceChangePassword)
+		require.ErrorIs(t, err, domain.ErrToDoServiceInvalidPasswordUser)
+	})
+
+	t.Run("Change Password Revoke Failed", func(t *testing.T) {
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		m.users.EXPECT().UpdatePassword(mock.Anything, mock.Anything, user.ID, "new hash", "new token").Return(nil).Once()
+		m.resets.EXPECT().UseAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.tokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(errors.New("some error")).Once()
+
+		err := service.ChangePassword(ctx, user.ID, "secret", "new hash", "new token")
+		require.ErrorIs(t, err, domain.ErrAccountServiceChangePassword)
+		require.ErrorContains(t, err, "some error")
+	})
+
+	t.Run("Change Password Without JWTs", func(t *testing.T) {
+		m := accountMocks{
+			users:  dbMocks.NewMockUsersRepository(t),
+			resets: dbMocks.NewMockPasswordResetsRepository(t),
+			tokens: dbMocks.NewMockAccessTokensRepository(t),
+			feeds:  dbMocks.NewMockFeedTokensRepository(t),
+		}
+		service := domain.NewAccountService(newFakeProvider(dbMocks.NewMockConnection(t)), m.users, m.resets, m.tokens, m.feeds,
+			nil, dbMocks.NewMockMailer(t), secret, "https://todo.example/confirm-email")
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		m.users.EXPECT().UpdatePassword(mock.Anything, mock.Anything, user.ID, "new hash", "new token").Return(nil).Once()
+		m.resets.EXPECT().UseAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.tokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+
+		require.NoError(t, service.ChangePassword(ctx, user.ID, "secret", "new hash", "new token"))
+	})
+
+	t.Run("Change Email", func(t *testing.T) {
+		token := requestedToken(t, "anna@email.foo")
+
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		changed := user
+		changed.Email, changed.Token = "anna@email.foo", "new token"
+		m.users.EXPECT().Update(mock.Anything, mock.Anything, changed).Return(nil).Once()
+		m.users.EXPECT().Verify(mock.Anything, mock.Anything, user.ID, "anna@email.foo").Return(nil).Once()
+		m.resets.EXPECT().UseAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.tokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+		m.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(nil).Once()
+		m.mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+			return mail.To == user.Email && strings.Contains(mail.Body, "anna@email.foo")
+		})).Return(nil).Once()
+
+		require.NoError(t, service.ConfirmEmailChange(ctx, token, "new token"))
+	})
+
+	t.Run("Change Email Twice", func(t *testing.T) {
+		token := requestedToken(t, "anna@email.foo")
+
+		// The email changed since the link was mailed.
+		changed := user
+		changed.Email = "anna@email.foo"
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(changed, nil).Once()
+
+		require.ErrorIs(t, service.ConfirmEmailChange(ctx, token, "new token"), domain.ErrAccountServiceInvalidToken)
+	})
+
+	t.Run("Change Email Tampered", func(t *testing.T) {
+		parts := strings.Split(requestedToken(t, "anna@email.foo"), ".")
+		parts[2] = "ZXZlQGVtYWlsLmZvbw" // eve@email.foo
+
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+
+		err := service.ConfirmEmailChange(ctx, strings.Join(parts, "."), "new token")
+		require.ErrorIs(t, err, domain.ErrAccountServiceInvalidToken)
+	})
+
+	t.Run("Change Email Invalid", func(t *testing.T) {
+		expired := user.ID.String() + ".1000000000.YQ.00"
+		for _, token := range []string{"", "a.b.c", "not-uuid.1.YQ.00", expired} {
+			service, _ := newService(t)
+
+			require.ErrorIs(t, service.ConfirmEmailChange(ctx, token, "new token"), domain.ErrAccountServiceInvalidToken, token)
+		}
+	})
+
+	t.Run("Request Email Change Taken", func(t *testing.T) {
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, "bob@email.foo").Return(domain.User{}, nil).Once()
+
+		err := service.RequestEmailChange(ctx, user.ID, "secret", "Bob <bob@email.foo>")
+		require.ErrorIs(t, err, domain.ErrAccountServiceEmailTaken)
+	})
+
+	t.Run("Request Email Change Wrong Password", func(t *testing.T) {
+		service, m := newService(t)
+		m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+
+		err := service.RequestEmailChange(ctx, user.ID, "wrong", "anna@email.foo")
+		require.ErrorIs(t, err, domain.ErrToDoServiceInvalidPasswordUser)
+	})
+}
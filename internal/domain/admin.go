@@ -0,0 +1,345 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ AdminInterface = (*AdminService)(nil)
+)
+
+var (
+	errAdminService              = errors.New("admin service error")
+	ErrAdminServiceSearchUsers   = errors.Join(errAdminService, errors.New("search users failed"))
+	ErrAdminServiceGetUser       = errors.Join(errAdminService, errors.New("get user failed"))
+	ErrAdminServiceUsage         = errors.Join(errAdminService, errors.New("read usage failed"))
+	ErrAdminServiceDisable       = errors.Join(errAdminService, errors.New("disable failed"))
+	ErrAdminServiceEnable        = errors.Join(errAdminService, errors.New("enable failed"))
+	ErrAdminServiceLogout        = errors.Join(errAdminService, errors.New("logout failed"))
+	ErrAdminServiceResetPassword = errors.Join(errAdminService, errors.New("reset password failed"))
+	ErrAdminServiceSetRole       = errors.Join(errAdminService, errors.New("set role failed"))
+	ErrAdminServiceAuditLog      = errors.Join(errAdminService, errors.New("read audit log failed"))
+	ErrAdminServiceInvalidArg    = errors.Join(errAdminService, errors.New("invalid argument"))
+	// ErrAdminServiceSelf keeps an admin from locking themselves out, and
+	// the service from losing its last admin.
+	ErrAdminServiceSelf = errors.Join(errAdminService, errors.New("admins can't disable or demote themselves"))
+)
+
+// AdminService audits every action in the transaction of the action, so
+// there is no action without its entry. Reads are audited too, they show
+// the data of users.
+type AdminService struct {
+	provider        ConnectionProvider
+	userRepo        UsersRepository
+	auditRepo       AuditLogRepository
+	usageRepo       UsageRepository
+	accessTokenRepo AccessTokensRepository
+	feedRepo        FeedTokensRepository
+	resetRepo       PasswordResetsRepository
+	keyRing         JWTKeyRing
+	mailer          Mailer
+	resetURL        string
+}
+
+// NewAdminService mails password reset links to resetURL like
+// PasswordResetService. The key ring is nil when JWTs are turned off.
+func NewAdminService(provider ConnectionProvider, userRepo UsersRepository, auditRepo AuditLogRepository,
+	usageRepo UsageRepository, accessTokenRepo AccessTokensRepository, feedRepo FeedTokensRepository,
+	resetRepo PasswordResetsRepository, keyRing JWTKeyRing, mailer Mailer, resetURL string,
+) *AdminService {
+	return &AdminService{
+		provider:        provider,
+		userRepo:        userRepo,
+		auditRepo:       auditRepo,
+		usageRepo:       usageRepo,
+		accessTokenRepo: accessTokenRepo,
+		feedRepo:        feedRepo,
+		resetRepo:       resetRepo,
+		keyRing:         keyRing,
+		mailer:          mailer,
+		resetURL:        resetURL,
+	}
+}
+
+// Close implements AdminInterface.
+func (s *AdminService) Close() error {
+	return s.provider.Close()
+}
+
+// SearchUsers implements AdminInterface. A zero limit means
+// DefaultPageLimit.
+func (s *AdminService) SearchUsers(ctx context.Context, adminID UserID, query string, page Page) ([]User, error) {
+	page, err := validAdminPage(page)
+	if err != nil {
+		return nil, errors.Join(ErrAdminServiceSearchUsers, err)
+	}
+
+	var users []User
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if users, err = s.userRepo.Search(ctx, connection, query, page); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditSearchUsers, nil, fmt.Sprintf("query %q", query))
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAdminServiceSearchUsers, err)
+	}
+
+	return users, nil
+}
+
+// GetUser implements AdminInterface.
+func (s *AdminService) GetUser(ctx context.Context, adminID, userID UserID) (User, error) {
+	var user User
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if user, err = s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditGetUser, &userID, "")
+	})
+	if err != nil {
+		return User{}, errors.Join(ErrAdminServiceGetUser, err)
+	}
+
+	return user, nil
+}
+
+// Usage implements AdminInterface.
+func (s *AdminService) Usage(ctx context.Context, adminID, userID UserID) (UserUsage, error) {
+	// The lists of the user are hidden from the admin otherwise.
+	ctx = WithoutAuthUser(ctx)
+
+	var usage UserUsage
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if _, err := s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		var err error
+		if usage, err = s.usageRepo.Read(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditUsage, &userID, "")
+	})
+	if err != nil {
+		return UserUsage{}, errors.Join(ErrAdminServiceUsage, err)
+	}
+
+	return usage, nil
+}
+
+// Disable implements AdminInterface. Feed tokens keep working, they only
+// read the calendar.
+func (s *AdminService) Disable(ctx context.Context, adminID, userID UserID, newToken string) error {
+	if adminID == userID {
+		return errors.Join(ErrAdminServiceDisable, ErrAdminServiceSelf)
+	}
+
+	now := time.Now()
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if err := s.userRepo.SetDisabled(ctx, connection, userID, &now); err != nil {
+			return err
+		}
+		if err := s.signOut(ctx, connection, userID, newToken); err != nil {
+			return err
+		}
+		// Feeds are read without signing in, they would go on showing the
+		// tasks of the user. They stay revoked when the user is enabled.
+		if err := s.feedRepo.RevokeAll(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditDisable, &userID, "")
+	})
+	if err == nil {
+		err = revokeJWTs(ctx, s.keyRing, userID)
+	}
+	if err != nil {
+		return errors.Join(ErrAdminServiceDisable, err)
+	}
+
+	return nil
+}
+
+// Enable implements AdminInterface. The user signs in again, their old
+// tokens stay revoked.
+func (s *AdminService) Enable(ctx context.Context, adminID, userID UserID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if err := s.userRepo.SetDisabled(ctx, connection, userID, nil); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditEnable, &userID, "")
+	})
+	if err != nil {
+		return errors.Join(ErrAdminServiceEnable, err)
+	}
+
+	return nil
+}
+
+// Logout implements AdminInterface.
+func (s *AdminService) Logout(ctx context.Context, adminID, userID UserID, newToken string) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if err := s.signOut(ctx, connection, userID, newToken); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditLogout, &userID, "")
+	})
+	if err == nil {
+		err = revokeJWTs(ctx, s.keyRing, userID)
+	}
+	if err != nil {
+		return errors.Join(ErrAdminServiceLogout, err)
+	}
+
+	return nil
+}
+
+// ResetPassword implements AdminInterface. The mail is sent once the reset
+// is stored, like PasswordResetService.Forgot does.
+func (s *AdminService) ResetPassword(ctx context.Context, adminID, userID UserID, token string) error {
+	link, err := passwordResetLink(s.resetURL, token)
+	if err != nil {
+		return errors.Join(ErrAdminServiceResetPassword, err)
+	}
+
+	var user User
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if user, err = s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+		if err = s.resetRepo.Create(ctx, connection, newPasswordReset(user.ID, token)); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditResetPassword, &userID, "")
+	})
+	if err != nil {
+		return errors.Join(ErrAdminServiceResetPassword, err)
+	}
+
+	err = s.mailer.Send(ctx, Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"An administrator asked you to choose a new password. To choose one, open\n\n"+
+			"%s\n\n"+
+			"The link works once, within %s.\n", user.Name, link, PasswordResetTTL),
+	})
+	if err != nil {
+		return errors.Join(ErrAdminServiceResetPassword, err)
+	}
+
+	return nil
+}
+
+// SetRole implements AdminInterface. JWTs carry the role, so the user's are
+// revoked.
+func (s *AdminService) SetRole(ctx context.Context, adminID, userID UserID, role Role) error {
+	if role != RoleUser && role != RoleAdmin {
+		return errors.Join(ErrAdminServiceSetRole, ErrAdminServiceInvalidArg, fmt.Errorf("unknown role %q", role))
+	}
+	if adminID == userID {
+		return errors.Join(ErrAdminServiceSetRole, ErrAdminServiceSelf)
+	}
+
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if err := s.userRepo.UpdateRole(ctx, connection, userID, role); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditSetRole, &userID, "role "+role)
+	})
+	if err == nil {
+		err = revokeJWTs(ctx, s.keyRing, userID)
+	}
+	if err != nil {
+		return errors.Join(ErrAdminServiceSetRole, err)
+	}
+
+	return nil
+}
+
+// AuditLog implements AdminInterface. A zero limit means DefaultPageLimit.
+func (s *AdminService) AuditLog(ctx context.Context, adminID UserID, page Page) ([]AuditEntry, error) {
+	page, err := validAdminPage(page)
+	if err != nil {
+		return nil, errors.Join(ErrAdminServiceAuditLog, err)
+	}
+
+	var entries []AuditEntry
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if entries, err = s.auditRepo.ReadAll(ctx, connection, page); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, connection, adminID, AuditReadAuditLog, nil, "")
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAdminServiceAuditLog, err)
+	}
+
+	return entries, nil
+}
+
+// signOut replaces the login token of the user and revokes their access
+// tokens.
+func (s *AdminService) signOut(ctx context.Context, connection Connection, userID UserID, newToken string) error {
+	user, err := s.userRepo.ReadByID(ctx, connection, userID)
+	if err != nil {
+		return err
+	}
+	if err = s.userRepo.UpdateTokenByEmail(ctx, connection, user.Email, newToken); err != nil {
+		return err
+	}
+
+	return s.accessTokenRepo.RevokeAll(ctx, connection, userID)
+}
+
+// revokeJWTs denies the JWTs issued to the user so far. The key ring is nil
+// when JWTs are turned off.
+func revokeJWTs(ctx context.Context, keyRing JWTKeyRing, userID UserID) error {
+	if keyRing == nil {
+		return nil
+	}
+
+	return keyRing.RevokeUser(ctx, userID)
+}
+
+func (s *AdminService) audit(ctx context.Context, connection Connection, adminID UserID, action AuditAction,
+	targetID *UserID, details string,
+) error {
+	return s.auditRepo.Create(ctx, connection, AuditEntry{
+		ID:        AuditEntryID(uuid.New()),
+		AdminID:   adminID,
+		Action:    action,
+		TargetID:  targetID,
+		Details:   details,
+		CreatedAt: time.Now(),
+	})
+}
+
+func validAdminPage(page Page) (Page, error) {
+	if page.Limit == 0 {
+		page.Limit = DefaultPageLimit
+	}
+	if page.Limit < 0 || page.Limit > MaxPageLimit || page.Offset < 0 {
+		return page, errors.Join(ErrAdminServiceInvalidArg, fmt.Errorf("limit must be within 1..%d, offset not negative", MaxPageLimit))
+	}
+
+	return page, nil
+}
@@ -0,0 +1,188 @@
+package domain_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminUnit(t *testing.T) {
+	ctx := context.Background()
+	adminID := domain.UserID(uuid.New())
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo", Token: "token"}
+
+	type repos struct {
+		users        *dbMocks.MockUsersRepository
+		audit        *dbMocks.MockAuditLogRepository
+		usage        *dbMocks.MockUsageRepository
+		accessTokens *dbMocks.MockAccessTokensRepository
+		feeds        *dbMocks.MockFeedTokensRepository
+		resets       *dbMocks.MockPasswordResetsRepository
+		keyRing      *dbMocks.MockJWTKeyRing
+		mailer       *dbMocks.MockMailer
+	}
+
+	// expectAudit expects the one entry of the action.
+	expectAudit := func(r repos, action domain.AuditAction, targetID *domain.UserID) {
+		r.audit.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(entry domain.AuditEntry) bool {
+			return entry.AdminID == adminID && entry.Action == action &&
+				(targetID == nil) == (entry.TargetID == nil) && (targetID == nil || *targetID == *entry.TargetID)
+		})).Return(nil).Once()
+	}
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *domain.AdminService, repos)
+	}{
+		{
+			name: "Search Users",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				r.users.EXPECT().Search(mock.Anything, mock.Anything, "ann", domain.Page{Limit: domain.DefaultPageLimit}).
+					Return([]domain.User{user}, nil).Once()
+				expectAudit(r, domain.AuditSearchUsers, nil)
+
+				users, err := service.SearchUsers(ctx, adminID, "ann", domain.Page{})
+
+				require.NoError(t, err)
+				require.Equal(t, []domain.User{user}, users)
+			},
+		},
+		{
+			name: "Search Users Invalid Page",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				_, err := service.SearchUsers(ctx, adminID, "ann", domain.Page{Limit: domain.MaxPageLimit + 1})
+
+				require.ErrorIs(t, err, domain.ErrAdminServiceInvalidArg)
+			},
+		},
+		{
+			name: "Usage",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				r.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				r.usage.EXPECT().Read(mock.Anything, mock.Anything, user.ID).Return(domain.UserUsage{Lists: 2, Tasks: 7}, nil).Once()
+				expectAudit(r, domain.AuditUsage, &user.ID)
+
+				usage, err := service.Usage(ctx, adminID, user.ID)
+
+				require.NoError(t, err)
+				require.Equal(t, domain.UserUsage{Lists: 2, Tasks: 7}, usage)
+			},
+		},
+		{
+			name: "Disable",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				r.users.EXPECT().SetDisabled(mock.Anything, mock.Anything, user.ID, mock.MatchedBy(func(at *time.Time) bool {
+					return at != nil
+				})).Return(nil).Once()
+				r.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				r.users.EXPECT().UpdateTokenByEmail(mock.Anything, mock.Anything, user.Email, "new token").Return(nil).Once()
+				r.accessTokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				r.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				expectAudit(r, domain.AuditDisable, &user.ID)
+				r.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(nil).Once()
+
+				require.NoError(t, service.Disable(ctx, adminID, user.ID, "new token"))
+			},
+		},
+		{
+			name: "Disable Self",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				err := service.Disable(ctx, adminID, adminID, "new token")
+
+				require.ErrorIs(t, err, domain.ErrAdminServiceSelf)
+			},
+		},
+		{
+			name: "Enable",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				r.users.EXPECT().SetDisabled(mock.Anything, mock.Anything, user.ID, (*time.Time)(nil)).Return(nil).Once()
+				expectAudit(r, domain.AuditEnable, &user.ID)
+
+				require.NoError(t, service.Enable(ctx, adminID, user.ID))
+			},
+		},
+		{
+			name: "Reset Password",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				r.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				r.resets.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(reset domain.PasswordReset) bool {
+					return reset.UserID == user.ID && reset.TokenHash != "reset token"
+				})).Return(nil).Once()
+				expectAudit(r, domain.AuditResetPassword, &user.ID)
+				r.mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+					link := url.URL{Scheme: "https", Host: "todo.example", Path: "/reset", RawQuery: "token=reset+token"}
+
+					return mail.To == user.Email && strings.Contains(mail.Body, link.String())
+				})).Return(nil).Once()
+
+				require.NoError(t, service.ResetPassword(ctx, adminID, user.ID, "reset token"))
+			},
+		},
+		{
+			name: "Set Role",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				r.users.EXPECT().UpdateRole(mock.Anything, mock.Anything, user.ID, domain.RoleAdmin).Return(nil).Once()
+				expectAudit(r, domain.AuditSetRole, &user.ID)
+				r.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(nil).Once()
+
+				require.NoError(t, service.SetRole(ctx, adminID, user.ID, domain.RoleAdmin))
+			},
+		},
+		{
+			name: "Set Role Unknown",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				err := service.SetRole(ctx, adminID, user.ID, "owner")
+
+				require.ErrorIs(t, err, domain.ErrAdminServiceInvalidArg)
+			},
+		},
+		{
+			name: "Set Role Self",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				err := service.SetRole(ctx, adminID, adminID, domain.RoleUser)
+
+				require.ErrorIs(t, err, domain.ErrAdminServiceSelf)
+			},
+		},
+		{
+			name: "Audit Log",
+			check: func(t *testing.T, service *domain.AdminService, r repos) {
+				entries := []domain.AuditEntry{{ID: domain.AuditEntryID(uuid.New()), AdminID: adminID, Action: domain.AuditEnable}}
+				r.audit.EXPECT().ReadAll(mock.Anything, mock.Anything, domain.Page{Limit: 10, Offset: 20}).Return(entries, nil).Once()
+				expectAudit(r, domain.AuditReadAuditLog, nil)
+
+				read, err := service.AuditLog(ctx, adminID, domain.Page{Limit: 10, Offset: 20})
+
+				require.NoError(t, err)
+				require.Equal(t, entries, read)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := repos{
+				users:        dbMocks.NewMockUsersRepository(t),
+				audit:        dbMocks.NewMockAuditLogRepository(t),
+				usage:        dbMocks.NewMockUsageRepository(t),
+				accessTokens: dbMocks.NewMockAccessTokensRepository(t),
+				feeds:        dbMocks.NewMockFeedTokensRepository(t),
+				resets:       dbMocks.NewMockPasswordResetsRepository(t),
+				keyRing:      dbMocks.NewMockJWTKeyRing(t),
+				mailer:       dbMocks.NewMockMailer(t),
+			}
+			service := domain.NewAdminService(newFakeProvider(dbMocks.NewMockConnection(t)), r.users, r.audit, r.usage,
+				r.accessTokens, r.feeds, r.resets, r.keyRing, r.mailer, "https://todo.example/reset")
+
+			test.check(t, service, r)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const sweepBatchSize = 100
+
+var (
+	_ AttachmentInterface = (*AttachmentService)(nil)
+)
+
+var (
+	errAttachmentService           = errors.New("attachment service error")
+	ErrAttachmentServiceUpload     = errors.Join(errAttachmentService, errors.New("upload failed"))
+	ErrAttachmentServiceQuota      = errors.Join(ErrAttachmentServiceUpload, errors.New("storage quota exceeded"))
+	ErrAttachmentServiceGetAll     = errors.Join(errAttachmentService, errors.New("read all failed"))
+	ErrAttachmentServiceDownload   = errors.Join(errAttachmentService, errors.New("download failed"))
+	ErrAttachmentServiceDelete     = errors.Join(errAttachmentService, errors.New("delete failed"))
+	ErrAttachmentServiceSweep      = errors.Join(errAttachmentService, errors.New("sweep failed"))
+	ErrAttachmentServiceInvalidArg = errors.Join(ErrAttachmentServiceUpload, errors.New("invalid attachment"))
+)
+
+type AttachmentService struct {
+	provider       ConnectionProvider
+	attachmentRepo AttachmentsRepository
+	taskRepo       TasksRepository
+	store          BlobStore
+	quota          int64
+}
+
+// NewAttachmentService limits every user to quota bytes of attachments.
+func NewAttachmentService(provider ConnectionProvider, attachmentRepo AttachmentsRepository, taskRepo TasksRepository, store BlobStore, quota int64) *AttachmentService {
+	return &AttachmentService{
+		provider:       provider,
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		store:          store,
+		quota:          quota,
+	}
+}
+
+// Close implements AttachmentInterface.
+func (s *AttachmentService) Close() error {
+	return s.provider.Close()
+}
+
+// Upload implements AttachmentInterface. The blob is streamed to the store
+// outside of any transaction, so a slow upload doesn't hold a connection.
+func (s *AttachmentService) Upload(ctx context.Context, userID UserID, workspaceID WorkspaceID, attachment Attachment, r io.Reader,
+) (Attachment, error) {
+	if attachment.Size <= 0 || attachment.Name == "" {
+		return Attachment{}, errors.Join(ErrAttachmentServiceInvalidArg, errors.New("name and size are required"))
+	}
+
+	attachment.ID = AttachmentID(uuid.New())
+	attachment.UserID = userID
+	attachment.StorageKey = uuid.NewString()
+	attachment.CreatedAt = time.Now()
+
+	// A first check turns the upload down before it is streamed.
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		return s.checkQuota(ctx, connection, userID, workspaceID, attachment)
+	})
+	if err != nil {
+		return Attachment{}, errors.Join(ErrAttachmentServiceUpload, err)
+	}
+
+	if err = s.store.Put(ctx, attachment.StorageKey, r, attachment.Size, attachment.ContentType); err != nil {
+		return Attachment{}, errors.Join(ErrAttachmentServiceUpload, err)
+	}
+
+	// Concurrent uploads may have used up the quota meanwhile. The check
+	// locks the storage of the user until the attachment is created, so they
+	// can't both pass it.
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if err := s.checkQuota(ctx, connection, userID, workspaceID, attachment); err != nil {
+			return err
+		}
+
+		return s.attachmentRepo.Create(ctx, connection, attachment)
+	})
+	if err != nil {
+		err = errors.Join(ErrAttachmentServiceUpload, err)
+		if deleteErr := s.store.Delete(context.WithoutCancel(ctx), attachment.StorageKey); deleteErr != nil {
+			err = errors.Join(err, deleteErr)
+		}
+
+		return Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+func (s *AttachmentService) checkQuota(ctx context.Context, connection Connection, userID UserID, workspaceID WorkspaceID,
+	attachment Attachment,
+) error {
+	// Reading the task checks the user may access it.
+	if _, err := s.taskRepo.Read(ctx, connection, userID, workspaceID, attachment.TaskID); err != nil {
+		return err
+	}
+
+	used, err := s.attachmentRepo.UsedStorage(ctx, connection, userID)
+	if err != nil {
+		return err
+	}
+
+	if used+attachment.Size > s.quota {
+		return errors.Join(ErrAttachmentServiceQuota, fmt.Errorf("used %d of %d bytes", used, s.quota))
+	}
+
+	return nil
+}
+
+// GetAll implements AttachmentInterface.
+func (s *AttachmentService) GetAll(ctx context.Context, userID UserID, workspaceID WorkspaceID, taskID TaskID) ([]Attachment, error) {
+	var attachments []Attachment
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		attachments, err = s.attachmentRepo.ReadAll(ctx, connection, userID, workspaceID, taskID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAttachmentServiceGetAll, err)
+	}
+
+	return attachments, nil
+}
+
+// Download implements AttachmentInterface. The caller closes the returned reader.
+func (s *AttachmentService) Download(ctx context.Context, userID UserID, workspaceID WorkspaceID, attachmentID AttachmentID,
+) (Attachment, io.ReadCloser, error) {
+	var attachment Attachment
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		attachment, err = s.attachmentRepo.Read(ctx, connection, userID, workspaceID, attachmentID)
+
+		return err
+	})
+	if err != nil {
+		return Attachment{}, nil, errors.Join(ErrAttachmentServiceDownload, err)
+	}
+
+	blob, err := s.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return Attachment{}, nil, errors.Join(ErrAttachmentServiceDownload, err)
+	}
+
+	return attachment, blob, nil
+}
+
+// Delete implements AttachmentInterface.
+func (s *AttachmentService) Delete(ctx context.Context, userID UserID, workspaceID WorkspaceID, attachmentID AttachmentID) error {
+	var attachment Attachment
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if attachment, err = s.attachmentRepo.Read(ctx, connection, userID, workspaceID, attachmentID); err != nil {
+			return err
+		}
+
+		return s.attachmentRepo.Delete(ctx, connection, userID, workspaceID, attachmentID)
+	})
+	if err != nil {
+		return errors.Join(ErrAttachmentServiceDelete, err)
+	}
+
+	if err = s.store.Delete(ctx, attachment.StorageKey); err != nil {
+		return errors.Join(ErrAttachmentServiceDelete, err)
+	}
+
+	return nil
+}
+
+// Sweep implements AttachmentInterface. Deleting a task, directly or along with
+// its list or user, detaches its attachments in the database. Sweep removes
+// the blobs of such orphans and then the orphans themselves.
+func (s *AttachmentService) Sweep(ctx context.Context) (int, error) {
+	var orphans []Attachment
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		orphans, err = s.attachmentRepo.ReadOrphans(ctx, connection, sweepBatchSize)
+
+		return err
+	})
+	if err != nil {
+		return 0, errors.Join(ErrAttachmentServiceSweep, err)
+	}
+
+	swept := 0
+	for _, orphan := range orphans {
+		if err = s.store.Delete(ctx, orphan.StorageKey); err != nil {
+			return swept, errors.Join(ErrAttachmentServiceSweep, err)
+		}
+
+		err = s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+			return s.attachmentRepo.DeleteOrphan(ctx, connection, orphan.ID)
+		})
+		if err != nil {
+			return swept, errors.Join(ErrAttachmentServiceSweep, err)
+		}
+		swept++
+	}
+
+	return swept, nil
+}
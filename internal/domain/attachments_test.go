@@ -0,0 +1,142 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentUploadUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	taskID := domain.TaskID(uuid.New())
+	upload := domain.Attachment{TaskID: taskID, Name: "report.pdf", ContentType: "application/pdf", Size: 40}
+
+	tests := []struct {
+		name         string
+		attachment   domain.Attachment
+		prepareMocks func(*dbMocks.MockAttachmentsRepository, *dbMocks.MockTasksRepository, *dbMocks.MockBlobStore)
+		check        func(*testing.T, domain.Attachment, error)
+	}{
+		{
+			name:       "Success",
+			attachment: upload,
+			prepareMocks: func(attachments *dbMocks.MockAttachmentsRepository, tasks *dbMocks.MockTasksRepository, store *dbMocks.MockBlobStore) {
+				tasks.EXPECT().Read(mock.Anything, mock.Anything, userID, workspaceID, taskID).Return(domain.Task{}, nil).Twice()
+				attachments.EXPECT().UsedStorage(mock.Anything, mock.Anything, userID).Return(int64(60), nil).Twice()
+				store.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(40), "application/pdf").Return(nil).Once()
+				attachments.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(a domain.Attachment) bool {
+					return a.UserID == userID && a.TaskID == taskID && a.StorageKey != ""
+				})).Return(nil).Once()
+			},
+			check: func(t *testing.T, attachment domain.Attachment, err error) {
+				require.NoError(t, err)
+				require.NotEqual(t, uuid.Nil, attachment.ID)
+				require.Equal(t, "report.pdf", attachment.Name)
+			},
+		},
+		{
+			name:       "Failed - quota exceeded",
+			attachment: upload,
+			prepareMocks: func(attachments *dbMocks.MockAttachmentsRepository, tasks *dbMocks.MockTasksRepository, _ *dbMocks.MockBlobStore) {
+				tasks.EXPECT().Read(mock.Anything, mock.Anything, userID, workspaceID, taskID).Return(domain.Task{}, nil).Once()
+				attachments.EXPECT().UsedStorage(mock.Anything, mock.Anything, userID).Return(int64(61), nil).Once()
+			},
+			check: func(t *testing.T, _ domain.Attachment, err error) {
+				require.ErrorIs(t, err, domain.ErrAttachmentServiceQuota)
+			},
+		},
+		{
+			name:       "Failed - quota used up during upload",
+			attachment: upload,
+			prepareMocks: func(attachments *dbMocks.MockAttachmentsRepository, tasks *dbMocks.MockTasksRepository, store *dbMocks.MockBlobStore) {
+				tasks.EXPECT().Read(mock.Anything, mock.Anything, userID, workspaceID, taskID).Return(domain.Task{}, nil).Twice()
+				attachments.EXPECT().UsedStorage(mock.Anything, mock.Anything, userID).Return(int64(0), nil).Once()
+				store.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(40), mock.Anything).Return(nil).Once()
+				// Another upload was created meanwhile, this one is refused before it is.
+				attachments.EXPECT().UsedStorage(mock.Anything, mock.Anything, userID).Return(int64(70), nil).Once()
+				store.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, _ domain.Attachment, err error) {
+				require.ErrorIs(t, err, domain.ErrAttachmentServiceQuota)
+			},
+		},
+		{
+			name:       "Failed - foreign task",
+			attachment: upload,
+			prepareMocks: func(_ *dbMocks.MockAttachmentsRepository, tasks *dbMocks.MockTasksRepository, _ *dbMocks.MockBlobStore) {
+				tasks.EXPECT().Read(mock.Anything, mock.Anything, userID, workspaceID, taskID).Return(domain.Task{}, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.Attachment, err error) {
+				require.ErrorIs(t, err, domain.ErrAttachmentServiceUpload)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:       "Failed - blob removed when insert fails",
+			attachment: upload,
+			prepareMocks: func(attachments *dbMocks.MockAttachmentsRepository, tasks *dbMocks.MockTasksRepository, store *dbMocks.MockBlobStore) {
+				tasks.EXPECT().Read(mock.Anything, mock.Anything, userID, workspaceID, taskID).Return(domain.Task{}, nil).Twice()
+				attachments.EXPECT().UsedStorage(mock.Anything, mock.Anything, userID).Return(int64(0), nil).Twice()
+				store.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(40), mock.Anything).Return(nil).Once()
+				attachments.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some error")).Once()
+				store.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, _ domain.Attachment, err error) {
+				require.ErrorIs(t, err, domain.ErrAttachmentServiceUpload)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:       "Failed - empty attachment",
+			attachment: domain.Attachment{TaskID: taskID, Name: "empty.txt"},
+			check: func(t *testing.T, _ domain.Attachment, err error) {
+				require.ErrorIs(t, err, domain.ErrAttachmentServiceInvalidArg)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			attachments, tasks, store := dbMocks.NewMockAttachmentsRepository(t), dbMocks.NewMockTasksRepository(t), dbMocks.NewMockBlobStore(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(attachments, tasks, store)
+			}
+
+			attachment, err := domain.NewAttachmentService(provider, attachments, tasks, store, 100).
+				Upload(context.Background(), userID, workspaceID, test.attachment, io.NopCloser(strings.NewReader("data")))
+
+			test.check(t, attachment, err)
+		})
+	}
+}
+
+func TestAttachmentSweepUnit(t *testing.T) {
+	orphans := []domain.Attachment{
+		{ID: domain.AttachmentID(uuid.New()), StorageKey: "first-key"},
+		{ID: domain.AttachmentID(uuid.New()), StorageKey: "second-key"},
+	}
+
+	provider := newFakeProvider(dbMocks.NewMockConnection(t))
+	attachments, store := dbMocks.NewMockAttachmentsRepository(t), dbMocks.NewMockBlobStore(t)
+
+	attachments.EXPECT().ReadOrphans(mock.Anything, mock.Anything, mock.Anything).Return(orphans, nil).Once()
+	store.EXPECT().Delete(mock.Anything, "first-key").Return(nil).Once()
+	attachments.EXPECT().DeleteOrphan(mock.Anything, mock.Anything, orphans[0].ID).Return(nil).Once()
+	store.EXPECT().Delete(mock.Anything, "second-key").Return(errors.New("some error")).Once()
+
+	swept, err := domain.NewAttachmentService(provider, attachments, dbMocks.NewMockTasksRepository(t), store, 100).
+		Sweep(context.Background())
+
+	require.ErrorIs(t, err, domain.ErrAttachmentServiceSweep)
+	require.Equal(t, 1, swept)
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxCommentLength = 10000
+
+	DefaultPageLimit = 50
+	MaxPageLimit     = 100
+)
+
+var (
+	_ CommentInterface = (*CommentService)(nil)
+)
+
+var (
+	errCommentService           = errors.New("comment service error")
+	ErrCommentServiceCreate     = errors.Join(errCommentService, errors.New("create failed"))
+	ErrCommentServiceGetAll     = errors.Join(errCommentService, errors.New("read all failed"))
+	ErrCommentServiceUpdate     = errors.Join(errCommentService, errors.New("update failed"))
+	ErrCommentServiceDelete     = errors.Join(errCommentService, errors.New("delete failed"))
+	ErrCommentServiceInvalidArg = errors.Join(errCommentService, errors.New("invalid comment"))
+)
+
+type CommentService struct {
+	provider    ConnectionProvider
+	commentRepo CommentsRepository
+}
+
+func NewCommentService(provider ConnectionProvider, commentRepo CommentsRepository) *CommentService {
+	return &CommentService{
+		provider:    provider,
+		commentRepo: commentRepo,
+	}
+}
+
+// Close implements CommentInterface.
+func (s *CommentService) Close() error {
+	return s.provider.Close()
+}
+
+// Create implements CommentInterface. The author is always the current user.
+func (s *CommentService) Create(ctx context.Context, userID UserID, workspaceID WorkspaceID, comment Comment) (Comment, error) {
+	body, err := commentBody(comment.Body)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	now := time.Now()
+	comment = Comment{
+		ID:        CommentID(uuid.New()),
+		TaskID:    comment.TaskID,
+		AuthorID:  userID,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.commentRepo.Create(ctx, connection, userID, workspaceID, comment)
+	})
+	if err != nil {
+		return Comment{}, errors.Join(ErrCommentServiceCreate, err)
+	}
+
+	return comment, nil
+}
+
+// GetAll implements CommentInterface. A zero limit means DefaultPageLimit.
+func (s *CommentService) GetAll(ctx context.Context, userID UserID, workspaceID WorkspaceID, taskID TaskID, page Page) ([]Comment, error) {
+	if page.Limit == 0 {
+		page.Limit = DefaultPageLimit
+	}
+	if page.Limit < 0 || page.Limit > MaxPageLimit || page.Offset < 0 {
+		return nil, errors.Join(ErrCommentServiceInvalidArg, fmt.Errorf("limit must be within 1..%d, offset not negative", MaxPageLimit))
+	}
+
+	var comments []Comment
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		comments, err = s.commentRepo.ReadAll(ctx, connection, userID, workspaceID, taskID, page)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrCommentServiceGetAll, err)
+	}
+
+	return comments, nil
+}
+
+// Update implements CommentInterface. Only the body can be changed.
+func (s *CommentService) Update(ctx context.Context, userID UserID, workspaceID WorkspaceID, comment Comment) (Comment, error) {
+	body, err := commentBody(comment.Body)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	comment = Comment{ID: comment.ID, Body: body, UpdatedAt: time.Now()}
+
+	var updated Comment
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		updated, err = s.commentRepo.Update(ctx, connection, userID, workspaceID, comment)
+
+		return err
+	})
+	if err != nil {
+		return Comment{}, errors.Join(ErrCommentServiceUpdate, err)
+	}
+
+	return updated, nil
+}
+
+// Delete implements CommentInterface.
+func (s *CommentService) Delete(ctx context.Context, userID UserID, workspaceID WorkspaceID, commentID CommentID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.commentRepo.Delete(ctx, connection, userID, workspaceID, commentID)
+	})
+	if err != nil {
+		return errors.Join(ErrCommentServiceDelete, err)
+	}
+
+	return nil
+}
+
+func commentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", errors.Join(ErrCommentServiceInvalidArg, errors.New("body is empty"))
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return "", errors.Join(ErrCommentServiceInvalidArg, fmt.Errorf("body is longer than %d characters", maxCommentLength))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,132 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentCreateUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	taskID := domain.TaskID(uuid.New())
+
+	tests := []struct {
+		name         string
+		comment      domain.Comment
+		prepareMocks func(*dbMocks.MockCommentsRepository)
+		check        func(*testing.T, domain.Comment, error)
+	}{
+		{
+			name:    "Success",
+			comment: domain.Comment{TaskID: taskID, AuthorID: domain.UserID(uuid.New()), Body: "  looks good  "},
+			prepareMocks: func(comments *dbMocks.MockCommentsRepository) {
+				comments.EXPECT().Create(mock.Anything, mock.Anything, userID, workspaceID, mock.MatchedBy(func(c domain.Comment) bool {
+					return c.AuthorID == userID && c.TaskID == taskID && c.Body == "looks good" && !c.CreatedAt.IsZero()
+				})).Return(nil).Once()
+			},
+			check: func(t *testing.T, comment domain.Comment, err error) {
+				require.NoError(t, err)
+				require.Equal(t, userID, comment.AuthorID)
+				require.NotEqual(t, uuid.Nil, comment.ID)
+			},
+		},
+		{
+			name:    "Failed - repository error",
+			comment: domain.Comment{TaskID: taskID, Body: "text"},
+			prepareMocks: func(comments *dbMocks.MockCommentsRepository) {
+				comments.EXPECT().Create(mock.Anything, mock.Anything, userID, workspaceID, mock.Anything).Return(errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.Comment, err error) {
+				require.ErrorIs(t, err, domain.ErrCommentServiceCreate)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:    "Failed - empty body",
+			comment: domain.Comment{TaskID: taskID, Body: " \n"},
+			check: func(t *testing.T, _ domain.Comment, err error) {
+				require.ErrorIs(t, err, domain.ErrCommentServiceInvalidArg)
+			},
+		},
+		{
+			name:    "Failed - body too long",
+			comment: domain.Comment{TaskID: taskID, Body: strings.Repeat("ж", 10001)},
+			check: func(t *testing.T, _ domain.Comment, err error) {
+				require.ErrorIs(t, err, domain.ErrCommentServiceInvalidArg)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			comments := dbMocks.NewMockCommentsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(comments)
+			}
+
+			comment, err := domain.NewCommentService(provider, comments).Create(context.Background(), userID, workspaceID, test.comment)
+
+			test.check(t, comment, err)
+		})
+	}
+}
+
+func TestCommentGetAllUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	taskID := domain.TaskID(uuid.New())
+
+	tests := []struct {
+		name         string
+		page         domain.Page
+		prepareMocks func(*dbMocks.MockCommentsRepository)
+		check        func(*testing.T, error)
+	}{
+		{
+			name: "Default limit",
+			prepareMocks: func(comments *dbMocks.MockCommentsRepository) {
+				comments.EXPECT().ReadAll(mock.Anything, mock.Anything, userID, workspaceID, taskID, domain.Page{Limit: domain.DefaultPageLimit}).
+					Return([]domain.Comment{}, nil).Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Failed - limit too large",
+			page: domain.Page{Limit: domain.MaxPageLimit + 1},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrCommentServiceInvalidArg)
+			},
+		},
+		{
+			name: "Failed - negative offset",
+			page: domain.Page{Limit: 10, Offset: -1},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrCommentServiceInvalidArg)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			comments := dbMocks.NewMockCommentsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(comments)
+			}
+
+			_, err := domain.NewCommentService(provider, comments).GetAll(context.Background(), userID, workspaceID, taskID, test.page)
+
+			test.check(t, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "context"
+
+type (
+	authUserKey struct{}
+
+	// noAuthUser is the user of the contexts acting on behalf of no one.
+	noAuthUser struct{}
+)
+
+// WithAuthUser returns a context acting on behalf of the user. Connection
+// providers pass the user to the database, which hides the lists and tasks
+// the user may not access however a query is written. A context acting on
+// behalf of neither a user nor no one sees no lists and tasks at all.
+func WithAuthUser(ctx context.Context, userID UserID) context.Context {
+	return context.WithValue(ctx, authUserKey{}, userID)
+}
+
+// WithoutAuthUser returns a context acting on behalf of no one, which sees
+// every user's lists and tasks, for work across users such as admin reports.
+func WithoutAuthUser(ctx context.Context) context.Context {
+	return context.WithValue(ctx, authUserKey{}, noAuthUser{})
+}
+
+// AuthUser returns the user the context acts on behalf of.
+func AuthUser(ctx context.Context) (UserID, bool) {
+	userID, ok := ctx.Value(authUserKey{}).(UserID)
+
+	return userID, ok
+}
+
+// IsWithoutAuthUser reports whether the context acts on behalf of no one, see
+// WithoutAuthUser.
+func IsWithoutAuthUser(ctx context.Context) bool {
+	_, ok := ctx.Value(authUserKey{}).(noAuthUser)
+
+	return ok
+}
@@ -0,0 +1,421 @@
+package domain
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DataExportTTL is how long the mailed download link of an export works.
+	DataExportTTL = 48 * time.Hour
+
+	// dataExportStale is how long an export may run before another worker
+	// takes it over.
+	dataExportStale = 30 * time.Minute
+)
+
+var (
+	_ DataExportInterface = (*DataExportService)(nil)
+)
+
+var (
+	errDataExportService         = errors.New("data export service error")
+	ErrDataExportServiceRequest  = errors.Join(errDataExportService, errors.New("request failed"))
+	ErrDataExportServiceGetAll   = errors.Join(errDataExportService, errors.New("read all failed"))
+	ErrDataExportServiceDownload = errors.Join(errDataExportService, errors.New("download failed"))
+	ErrDataExportServiceProcess  = errors.Join(errDataExportService, errors.New("process failed"))
+	ErrDataExportServiceSweep    = errors.Join(errDataExportService, errors.New("sweep failed"))
+)
+
+// DataExportService builds ZIP archives of everything a user owns: the
+// profile, workspaces, lists with their tasks and smart lists of every
+// workspace, comments, attachments with their files, and the feed and access
+// tokens without their secrets.
+type DataExportService struct {
+	provider        ConnectionProvider
+	userRepo        UsersRepository
+	workspaceRepo   WorkspacesRepository
+	exportRepo      DataExportsRepository
+	listRepo        ListsRepository
+	taskRepo        TasksRepository
+	smartListRepo   SmartListsRepository
+	commentRepo     CommentsRepository
+	attachmentRepo  AttachmentsRepository
+	feedRepo        FeedTokensRepository
+	accessTokenRepo AccessTokensRepository
+	store           BlobStore
+	mailer          Mailer
+	downloadURL     string
+}
+
+// NewDataExportService mails download links to downloadURL with the token
+// added as the token query parameter.
+func NewDataExportService(provider ConnectionProvider, userRepo UsersRepository, workspaceRepo WorkspacesRepository,
+	exportRepo DataExportsRepository,
+	listRepo ListsRepository, taskRepo TasksRepository, smartListRepo SmartListsRepository, commentRepo CommentsRepository,
+	attachmentRepo AttachmentsRepository, feedRepo FeedTokensRepository, accessTokenRepo AccessTokensRepository,
+	store BlobStore, mailer Mailer, downloadURL string,
+) *DataExportService {
+	return &DataExportService{
+		provider:        provider,
+		userRepo:        userRepo,
+		workspaceRepo:   workspaceRepo,
+		exportRepo:      exportRepo,
+		listRepo:        listRepo,
+		taskRepo:        taskRepo,
+		smartListRepo:   smartListRepo,
+		commentRepo:     commentRepo,
+		attachmentRepo:  attachmentRepo,
+		feedRepo:        feedRepo,
+		accessTokenRepo: accessTokenRepo,
+		store:           store,
+		mailer:          mailer,
+		downloadURL:     downloadURL,
+	}
+}
+
+// Close implements DataExportInterface.
+func (s *DataExportService) Close() error {
+	return s.provider.Close()
+}
+
+// Request implements DataExportInterface. While an export of the user is
+// queued or running it is returned instead of queueing another.
+func (s *DataExportService) Request(ctx context.Context, userID UserID) (DataExport, error) {
+	var export DataExport
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		exports, err := s.exportRepo.ReadAll(ctx, connection, userID)
+		if err != nil {
+			return err
+		}
+		for _, existing := range exports {
+			if existing.Status == DataExportPending || existing.Status == DataExportRunning {
+				export = existing
+
+				return nil
+			}
+		}
+
+		export = DataExport{ID: uuid.New(), UserID: userID, Status: DataExportPending, CreatedAt: time.Now()}
+
+		return s.exportRepo.Create(ctx, connection, export)
+	})
+	if err != nil {
+		return DataExport{}, errors.Join(ErrDataExportServiceRequest, err)
+	}
+
+	return export, nil
+}
+
+// GetAll implements DataExportInterface.
+func (s *DataExportService) GetAll(ctx context.Context, userID UserID) ([]DataExport, error) {
+	var exports []DataExport
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		exports, err = s.exportRepo.ReadAll(ctx, connection, userID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrDataExportServiceGetAll, err)
+	}
+
+	return exports, nil
+}
+
+// Download implements DataExportInterface. The caller closes the reader.
+func (s *DataExportService) Download(ctx context.Context, token string) (DataExport, io.ReadCloser, error) {
+	var export DataExport
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		export, err = s.exportRepo.ReadByHash(ctx, connection, hashToken(token))
+
+		return err
+	})
+	if err != nil {
+		return DataExport{}, nil, errors.Join(ErrDataExportServiceDownload, err)
+	}
+
+	r, err := s.store.Get(ctx, export.StorageKey)
+	if err != nil {
+		return DataExport{}, nil, errors.Join(ErrDataExportServiceDownload, err)
+	}
+
+	return export, r, nil
+}
+
+// Process implements DataExportInterface. An export that can't be built is
+// marked failed and the error returned, the next call goes on with the rest.
+func (s *DataExportService) Process(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < sweepBatchSize {
+		var export DataExport
+		err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+			var err error
+			export, err = s.exportRepo.Claim(ctx, connection, dataExportStale)
+
+			return err
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			return processed, errors.Join(ErrDataExportServiceProcess, err)
+		}
+
+		if err = s.process(ctx, export); err != nil {
+			failErr := s.provider.Execute(context.WithoutCancel(ctx), func(ctx context.Context, connection Connection) error {
+				return s.exportRepo.Fail(ctx, connection, export.ID)
+			})
+
+			return processed, errors.Join(ErrDataExportServiceProcess, err, failErr)
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// Sweep implements DataExportInterface. The exports of deleted users are
+// swept along with the expired ones.
+func (s *DataExportService) Sweep(ctx context.Context) (int, error) {
+	var expired []DataExport
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		expired, err = s.exportRepo.ReadExpired(ctx, connection, sweepBatchSize)
+
+		return err
+	})
+	if err != nil {
+		return 0, errors.Join(ErrDataExportServiceSweep, err)
+	}
+
+	swept := 0
+	for _, export := range expired {
+		if export.StorageKey != "" {
+			if err = s.store.Delete(ctx, export.StorageKey); err != nil {
+				return swept, errors.Join(ErrDataExportServiceSweep, err)
+			}
+		}
+
+		err = s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+			return s.exportRepo.Delete(ctx, connection, export.ID)
+		})
+		if err != nil {
+			return swept, errors.Join(ErrDataExportServiceSweep, err)
+		}
+		swept++
+	}
+
+	return swept, nil
+}
+
+// process builds the archive, stores it and mails its link. The archive is
+// written to a temporary file first, the blob store needs its size.
+func (s *DataExportService) process(ctx context.Context, export DataExport) error {
+	file, err := os.CreateTemp("", "data-export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	user, err := s.build(ctx, export.UserID, file)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	token, err := newChallengeToken()
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(DataExportTTL)
+	export.StorageKey, export.Size, export.TokenHash, export.ExpiresAt = uuid.NewString(), info.Size(), hashToken(token), &expiresAt
+
+	if err = s.store.Put(ctx, export.StorageKey, file, export.Size, "application/zip"); err != nil {
+		return err
+	}
+
+	err = s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		return s.exportRepo.Complete(ctx, connection, export)
+	})
+	if err != nil {
+		if deleteErr := s.store.Delete(context.WithoutCancel(ctx), export.StorageKey); deleteErr != nil {
+			err = errors.Join(err, deleteErr)
+		}
+
+		return err
+	}
+
+	link, err := url.Parse(s.downloadURL)
+	if err != nil {
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	// Without the mail nobody can download the export, so failing it lets
+	// the sweep remove the archive.
+	return s.mailer.Send(ctx, Mail{
+		To:      user.Email,
+		Subject: "Your data export is ready",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"The export of your data is ready. Download it from\n\n"+
+			"%s\n\n"+
+			"The link works for %s.\n",
+			user.Name, link, DataExportTTL),
+	})
+}
+
+// build writes the archive of the user's data to w. The data is read in one
+// transaction, so the files agree with each other, on behalf of the user,
+// since exports are built in the background.
+func (s *DataExportService) build(ctx context.Context, userID UserID, w io.Writer) (User, error) {
+	var (
+		user         User
+		workspaces   []Workspace
+		lists        []List
+		smartLists   []SmartList
+		comments     []Comment
+		attachments  []Attachment
+		feedTokens   []FeedToken
+		accessTokens []AccessToken
+	)
+	err := s.provider.ExecuteTx(WithAuthUser(ctx, userID), func(ctx context.Context, connection Connection) error {
+		var err error
+		if user, err = s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+		if workspaces, err = s.workspaceRepo.ReadAll(ctx, connection, userID); err != nil {
+			return err
+		}
+		for _, workspace := range workspaces {
+			owned, err := readOwnedLists(ctx, connection, s.listRepo, s.taskRepo, userID, workspace.ID)
+			if err != nil {
+				return err
+			}
+			lists = append(lists, owned...)
+
+			saved, err := s.smartListRepo.ReadAll(ctx, connection, userID, workspace.ID)
+			if err != nil {
+				return err
+			}
+			smartLists = append(smartLists, saved...)
+
+			tokens, err := s.feedRepo.ReadAll(ctx, connection, userID, workspace.ID)
+			if err != nil {
+				return err
+			}
+			feedTokens = append(feedTokens, tokens...)
+		}
+		if comments, err = s.commentRepo.ReadByAuthor(ctx, connection, userID); err != nil {
+			return err
+		}
+		if attachments, err = s.attachmentRepo.ReadByUser(ctx, connection, userID); err != nil {
+			return err
+		}
+		accessTokens, err = s.accessTokenRepo.ReadAll(ctx, connection, userID)
+
+		return err
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	archive := zip.NewWriter(w)
+	files := []struct {
+		name  string
+		value any
+	}{
+		{"profile.json", struct {
+			ID         UserID     `json:"id"`
+			Name       string     `json:"name"`
+			Email      string     `json:"email"`
+			TimeZone   string     `json:"time_zone"`
+			Locale     string     `json:"locale"`
+			VerifiedAt *time.Time `json:"verified_at"`
+		}{user.ID, user.Name, user.Email, user.TimeZone, user.Locale, user.VerifiedAt}},
+		{"workspaces.json", workspaces},
+		{"lists.json", lists},
+		{"smart_lists.json", smartLists},
+		{"comments.json", comments},
+		{"attachments.json", attachments},
+		{"feed_tokens.json", feedTokens},
+		{"access_tokens.json", accessTokens},
+	}
+	for _, file := range files {
+		if err = writeArchiveJSON(archive, file.name, file.value); err != nil {
+			return User{}, err
+		}
+	}
+
+	for _, attachment := range attachments {
+		if err = s.writeAttachment(ctx, archive, attachment); err != nil {
+			return User{}, err
+		}
+	}
+
+	if err = archive.Close(); err != nil {
+		return User{}, err
+	}
+
+	return user, nil
+}
+
+// writeAttachment copies the blob to attachments/<id>/<name>. The name is
+// the user's, so it is kept from escaping its directory.
+func (s *DataExportService) writeAttachment(ctx context.Context, archive *zip.Writer, attachment Attachment) error {
+	r, err := s.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	name := strings.NewReplacer("/", "_", `\`, "_").Replace(attachment.Name)
+	if slices.Contains([]string{"", ".", ".."}, name) {
+		name = "_"
+	}
+
+	w, err := archive.Create("attachments/" + attachment.ID.String() + "/" + name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+
+	return err
+}
+
+func writeArchiveJSON(archive *zip.Writer, name string, value any) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
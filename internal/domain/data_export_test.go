@@ -0,0 +1,204 @@
+package domain_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataExportUnit(t *testing.T) {
+	ctx := context.Background()
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo", PasswordHash: "hash", Token: "token"}
+	personal := domain.Workspace{ID: domain.PersonalWorkspace(user.ID), Name: "Ann", Personal: true, Role: domain.WorkspaceRoleOwner}
+	shared := domain.Workspace{ID: domain.WorkspaceID(uuid.New()), Name: "Sales", Role: domain.WorkspaceRoleMember}
+	list := domain.List{ID: domain.ListID(uuid.New()), UserID: user.ID, WorkspaceID: personal.ID, Name: "Home"}
+	sharedList := domain.List{ID: domain.ListID(uuid.New()), UserID: domain.UserID(uuid.New()), WorkspaceID: shared.ID, Name: "Leads"}
+	attachment := domain.Attachment{ID: uuid.New(), UserID: user.ID, Name: "../notes.txt", StorageKey: "blob"}
+
+	type repos struct {
+		users        *dbMocks.MockUsersRepository
+		workspaces   *dbMocks.MockWorkspacesRepository
+		exports      *dbMocks.MockDataExportsRepository
+		lists        *dbMocks.MockListsRepository
+		tasks        *dbMocks.MockTasksRepository
+		smartLists   *dbMocks.MockSmartListsRepository
+		comments     *dbMocks.MockCommentsRepository
+		attachments  *dbMocks.MockAttachmentsRepository
+		feeds        *dbMocks.MockFeedTokensRepository
+		accessTokens *dbMocks.MockAccessTokensRepository
+		store        *dbMocks.MockBlobStore
+		mailer       *dbMocks.MockMailer
+	}
+
+	// expectData returns the data of the user from the repositories.
+	expectData := func(r repos) {
+		r.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		r.workspaces.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID).Return([]domain.Workspace{personal, shared}, nil).Once()
+		r.lists.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID, personal.ID).Return([]domain.List{list}, nil).Once()
+		r.tasks.EXPECT().GetAllTasks(mock.Anything, mock.Anything, user.ID, personal.ID, []domain.ListID{list.ID}).
+			Return([]domain.Task{{ID: domain.TaskID(uuid.New()), ListID: list.ID, Name: "Dishes"}}, nil).Once()
+		r.smartLists.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID, personal.ID).Return([]domain.SmartList{}, nil).Once()
+		r.feeds.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID, personal.ID).Return([]domain.FeedToken{}, nil).Once()
+		// Lists of others are theirs to export.
+		r.lists.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID, shared.ID).Return([]domain.List{sharedList}, nil).Once()
+		r.smartLists.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID, shared.ID).
+			Return([]domain.SmartList{{ID: domain.SmartListID(uuid.New()), WorkspaceID: shared.ID, Name: "Hot leads"}}, nil).Once()
+		r.feeds.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID, shared.ID).Return([]domain.FeedToken{}, nil).Once()
+		r.comments.EXPECT().ReadByAuthor(mock.Anything, mock.Anything, user.ID).Return([]domain.Comment{}, nil).Once()
+		r.attachments.EXPECT().ReadByUser(mock.Anything, mock.Anything, user.ID).Return([]domain.Attachment{attachment}, nil).Once()
+		r.accessTokens.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID).Return([]domain.AccessToken{}, nil).Once()
+		r.store.EXPECT().Get(mock.Anything, "blob").Return(io.NopCloser(strings.NewReader("milk")), nil).Once()
+	}
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *domain.DataExportService, repos)
+	}{
+		{
+			name: "Request Queued",
+			check: func(t *testing.T, service *domain.DataExportService, r repos) {
+				queued := domain.DataExport{ID: uuid.New(), UserID: user.ID, Status: domain.DataExportRunning}
+				r.exports.EXPECT().ReadAll(mock.Anything, mock.Anything, user.ID).
+					Return([]domain.DataExport{queued, {ID: uuid.New(), Status: domain.DataExportReady}}, nil).Once()
+
+				export, err := service.Request(ctx, user.ID)
+
+				require.NoError(t, err)
+				require.Equal(t, queued, export)
+			},
+		},
+		{
+			name: "Process",
+			check: func(t *testing.T, service *domain.DataExportService, r repos) {
+				export := domain.DataExport{ID: uuid.New(), UserID: user.ID, Status: domain.DataExportRunning}
+				r.exports.EXPECT().Claim(mock.Anything, mock.Anything, mock.Anything).Return(export, nil).Once()
+				r.exports.EXPECT().Claim(mock.Anything, mock.Anything, mock.Anything).Return(domain.DataExport{}, sql.ErrNoRows).Once()
+				expectData(r)
+
+				var archive []byte
+				r.store.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, mock.Anything, "application/zip").
+					RunAndReturn(func(_ context.Context, _ string, r io.Reader, size int64, _ string) error {
+						var err error
+						archive, err = io.ReadAll(r)
+						require.EqualValues(t, size, len(archive))
+
+						return err
+					}).Once()
+
+				var completed domain.DataExport
+				r.exports.EXPECT().Complete(mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, export domain.DataExport) { completed = export }).
+					Return(nil).Once()
+
+				var token string
+				r.mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+					for _, line := range strings.Split(mail.Body, "\n") {
+						if link, err := url.Parse(line); err == nil && link.Query().Has("token") {
+							token = link.Query().Get("token")
+						}
+					}
+
+					return mail.To == user.Email
+				})).Return(nil).Once()
+
+				processed, err := service.Process(ctx)
+
+				require.NoError(t, err)
+				require.Equal(t, 1, processed)
+				require.NotEmpty(t, token)
+				require.NotEqual(t, token, completed.TokenHash)
+				require.NotNil(t, completed.ExpiresAt)
+
+				reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+				require.NoError(t, err)
+				files := map[string]string{}
+				for _, file := range reader.File {
+					content, err := file.Open()
+					require.NoError(t, err)
+					data, err := io.ReadAll(content)
+					require.NoError(t, err)
+					files[file.Name] = string(data)
+				}
+
+				require.Contains(t, files["profile.json"], "ann@email.foo")
+				require.NotContains(t, files["profile.json"], "hash")
+				require.Contains(t, files["lists.json"], "Dishes")
+				require.NotContains(t, files["lists.json"], "Leads")
+				require.Contains(t, files["smart_lists.json"], "Hot leads")
+				require.Contains(t, files["workspaces.json"], "Sales")
+				require.Equal(t, "milk", files["attachments/"+attachment.ID.String()+"/.._notes.txt"])
+				for _, name := range []string{"smart_lists.json", "comments.json", "attachments.json", "feed_tokens.json", "access_tokens.json"} {
+					require.Contains(t, files, name)
+				}
+			},
+		},
+		{
+			name: "Process Failed",
+			check: func(t *testing.T, service *domain.DataExportService, r repos) {
+				export := domain.DataExport{ID: uuid.New(), UserID: user.ID, Status: domain.DataExportRunning}
+				r.exports.EXPECT().Claim(mock.Anything, mock.Anything, mock.Anything).Return(export, nil).Once()
+				expectData(r)
+				r.store.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("disk full")).Once()
+				r.exports.EXPECT().Fail(mock.Anything, mock.Anything, export.ID).Return(nil).Once()
+
+				processed, err := service.Process(ctx)
+
+				require.ErrorIs(t, err, domain.ErrDataExportServiceProcess)
+				require.Zero(t, processed)
+			},
+		},
+		{
+			name: "Sweep",
+			check: func(t *testing.T, service *domain.DataExportService, r repos) {
+				ready, failed := domain.DataExport{ID: uuid.New(), StorageKey: "archive"}, domain.DataExport{ID: uuid.New()}
+				r.exports.EXPECT().ReadExpired(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.DataExport{ready, failed}, nil).Once()
+				r.store.EXPECT().Delete(mock.Anything, "archive").Return(nil).Once()
+				r.exports.EXPECT().Delete(mock.Anything, mock.Anything, ready.ID).Return(nil).Once()
+				r.exports.EXPECT().Delete(mock.Anything, mock.Anything, failed.ID).Return(nil).Once()
+
+				swept, err := service.Sweep(ctx)
+
+				require.NoError(t, err)
+				require.Equal(t, 2, swept)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := repos{
+				users:        dbMocks.NewMockUsersRepository(t),
+				workspaces:   dbMocks.NewMockWorkspacesRepository(t),
+				exports:      dbMocks.NewMockDataExportsRepository(t),
+				lists:        dbMocks.NewMockListsRepository(t),
+				tasks:        dbMocks.NewMockTasksRepository(t),
+				smartLists:   dbMocks.NewMockSmartListsRepository(t),
+				comments:     dbMocks.NewMockCommentsRepository(t),
+				attachments:  dbMocks.NewMockAttachmentsRepository(t),
+				feeds:        dbMocks.NewMockFeedTokensRepository(t),
+				accessTokens: dbMocks.NewMockAccessTokensRepository(t),
+				store:        dbMocks.NewMockBlobStore(t),
+				mailer:       dbMocks.NewMockMailer(t),
+			}
+			service := domain.NewDataExportService(newFakeProvider(dbMocks.NewMockConnection(t)), r.users, r.workspaces, r.exports,
+				r.lists, r.tasks, r.smartLists, r.comments, r.attachments, r.feeds, r.accessTokens, r.store, r.mailer,
+				"https://todo.example/export/download")
+
+			test.check(t, service, r)
+		})
+	}
+}
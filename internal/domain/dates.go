@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+const (
+	DefaultTimeZone = "UTC"
+	DefaultLocale   = "en"
+)
+
+// SupportedLocales lists the locales user preferences may choose from.
+var SupportedLocales = []string{"en", "ru"}
+
+// Location returns the user's time zone, falling back to UTC for users that
+// never chose one.
+func (u User) Location() *time.Location {
+	location, err := time.LoadLocation(u.TimeZone)
+	if err != nil || u.TimeZone == "" {
+		return time.UTC
+	}
+
+	return location
+}
+
+// DayIn returns the day containing now in the location.
+func DayIn(now time.Time, location *time.Location) Day {
+	local := now.In(location)
+	year, month, day := local.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, location)
+
+	return Day{
+		Date:  time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+		Start: start,
+		End:   start.AddDate(0, 0, 1),
+		Now:   now,
+	}
+}
+
+// normalizeDeadline keeps only the date of all-day deadlines, as written by
+// the client regardless of the offset it used.
+func normalizeDeadline(task *Task) {
+	if task.Deadline == nil {
+		task.AllDay = false
+
+		return
+	}
+	if task.AllDay {
+		year, month, day := task.Deadline.Date()
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		task.Deadline = &date
+	}
+}
+
+// AddDays returns the day n days later, Now is kept as is.
+func (d Day) AddDays(n int) Day {
+	start := time.Date(d.Start.Year(), d.Start.Month(), d.Start.Day()+n, 0, 0, 0, 0, d.Start.Location())
+
+	return Day{
+		Date:  d.Date.AddDate(0, 0, n),
+		Start: start,
+		End:   start.AddDate(0, 0, 1),
+		Now:   d.Now,
+	}
+}
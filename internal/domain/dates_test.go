@@ -0,0 +1,112 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDayIn(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	// 22:30 UTC is already the next day in Moscow.
+	now := time.Date(2024, time.March, 9, 22, 30, 0, 0, time.UTC)
+
+	day := domain.DayIn(now, moscow)
+
+	require.Equal(t, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC), day.Date)
+	require.True(t, day.Start.Equal(time.Date(2024, time.March, 9, 21, 0, 0, 0, time.UTC)))
+	require.True(t, day.End.Equal(time.Date(2024, time.March, 10, 21, 0, 0, 0, time.UTC)))
+	require.Equal(t, now, day.Now)
+
+	require.Equal(t, time.UTC, domain.User{}.Location())
+	require.Equal(t, time.UTC, domain.User{TimeZone: "Nowhere/Nothing"}.Location())
+	require.Equal(t, "Europe/Moscow", domain.User{TimeZone: "Europe/Moscow"}.Location().String())
+}
+
+func TestAllDayDeadlineUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	deadline := time.Date(2024, time.March, 10, 1, 30, 0, 0, moscow)
+
+	tests := []struct {
+		name     string
+		task     domain.Task
+		expected domain.Task
+	}{
+		{
+			name:     "All-day keeps the client's date",
+			task:     domain.Task{Deadline: &deadline, AllDay: true},
+			expected: domain.Task{Deadline: ptr(time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)), AllDay: true},
+		},
+		{
+			name:     "Timed deadline is untouched",
+			task:     domain.Task{Deadline: &deadline},
+			expected: domain.Task{Deadline: &deadline},
+		},
+		{
+			name:     "All-day without deadline",
+			task:     domain.Task{AllDay: true},
+			expected: domain.Task{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			tasks := dbMocks.NewMockTasksRepository(t)
+			tasks.EXPECT().Update(mock.Anything, mock.Anything, userID, workspaceID, test.expected).Return(nil).Once()
+
+			err := domain.NewTaskService(provider, tasks).Update(context.Background(), userID, workspaceID, test.task)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdatePreferencesUnit(t *testing.T) {
+	userID := domain.UserID(uuid.New())
+
+	tests := []struct {
+		name        string
+		preferences domain.Preferences
+		valid       bool
+	}{
+		{name: "Valid", preferences: domain.Preferences{TimeZone: "Europe/Moscow", Locale: "ru"}, valid: true},
+		{name: "UTC", preferences: domain.Preferences{TimeZone: "UTC", Locale: "en"}, valid: true},
+		{name: "Unknown zone", preferences: domain.Preferences{TimeZone: "Mars/Olympus", Locale: "en"}},
+		{name: "Empty zone", preferences: domain.Preferences{Locale: "en"}},
+		{name: "Server zone", preferences: domain.Preferences{TimeZone: "Local", Locale: "en"}},
+		{name: "Unsupported locale", preferences: domain.Preferences{TimeZone: "UTC", Locale: "de"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			users := dbMocks.NewMockUsersRepository(t)
+			if test.valid {
+				users.EXPECT().UpdatePreferences(mock.Anything, mock.Anything, userID, test.preferences).Return(nil).Once()
+			}
+
+			err := domain.NewUserService(provider, users, dbMocks.NewMockTwoFactorRepository(t),
+				dbMocks.NewMockWorkspacesRepository(t)).UpdatePreferences(context.Background(), userID, test.preferences)
+
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, domain.ErrUserServiceInvalidPreferences)
+			}
+		})
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
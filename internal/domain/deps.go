@@ -1,27 +1,223 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type UsersRepository interface {
 	Create(context.Context, Connection, User) error
 	ReadByToken(context.Context, Connection, string) (User, error)
 	ReadByEmail(context.Context, Connection, string) (User, error)
+	ReadByID(context.Context, Connection, UserID) (User, error)
 	Update(context.Context, Connection, User) error
 	Delete(context.Context, Connection, UserID) error
 	UpdateTokenByEmail(context.Context, Connection, string, string) error
+	UpdatePreferences(context.Context, Connection, UserID, Preferences) error
+	UpdateName(context.Context, Connection, UserID, string) error
+	UpdatePassword(ctx context.Context, connection Connection, userID UserID, passwordHash, token string) error
+	// Verify marks the user verified while the email is still theirs.
+	Verify(ctx context.Context, connection Connection, userID UserID, email string) error
+	// Search returns the users whose email or name contains query, by
+	// email.
+	Search(ctx context.Context, connection Connection, query string, page Page) ([]User, error)
+	// SetDisabled disables the user at disabledAt, or enables them for nil.
+	SetDisabled(ctx context.Context, connection Connection, userID UserID, disabledAt *time.Time) error
+	UpdateRole(context.Context, Connection, UserID, Role) error
 }
 
+type WorkspacesRepository interface {
+	// Create makes the workspace with the user as its owner.
+	Create(ctx context.Context, connection Connection, workspace Workspace, ownerID UserID) error
+	// Read returns the workspace with the role of the user in it, or
+	// sql.ErrNoRows unless they are a member.
+	Read(context.Context, Connection, UserID, WorkspaceID) (Workspace, error)
+	ReadAll(context.Context, Connection, UserID) ([]Workspace, error)
+	Rename(ctx context.Context, connection Connection, workspaceID WorkspaceID, name string) error
+	// Delete removes the workspace unless it is personal.
+	Delete(context.Context, Connection, WorkspaceID) error
+	ReadMembers(context.Context, Connection, WorkspaceID) ([]WorkspaceMember, error)
+	// AddMember does nothing for a user who is a member already.
+	AddMember(ctx context.Context, connection Connection, workspaceID WorkspaceID, userID UserID, role WorkspaceRole) error
+	// RemoveMember removes a member who isn't an owner, with their access to
+	// the lists of the workspace and their assignments there.
+	RemoveMember(ctx context.Context, connection Connection, workspaceID WorkspaceID, memberID UserID) error
+	CreateInvitation(context.Context, Connection, WorkspaceInvitation) error
+	// ReadInvitations returns the unexpired invitations.
+	ReadInvitations(context.Context, Connection, WorkspaceID) ([]WorkspaceInvitation, error)
+	DeleteInvitation(context.Context, Connection, WorkspaceID, WorkspaceInvitationID) error
+	// UseInvitation deletes the unexpired invitation with the token hash and
+	// returns it.
+	UseInvitation(context.Context, Connection, string) (WorkspaceInvitation, error)
+}
+
+// ListsRepository and the repositories of what is inside lists work for a
+// user in a workspace. The user must be a member of the workspace, and only
+// the lists of that workspace are seen.
 type ListsRepository interface {
+	// Create fails unless the owner of the list is a member of its
+	// workspace.
 	Create(context.Context, Connection, List) error
 	Update(context.Context, Connection, List) error
-	Delete(context.Context, Connection, UserID, ListID) error
-	ReadAll(context.Context, Connection, UserID) ([]List, error)
+	Delete(context.Context, Connection, UserID, WorkspaceID, ListID) error
+	ReadAll(context.Context, Connection, UserID, WorkspaceID) ([]List, error)
+	ReadMembers(context.Context, Connection, UserID, WorkspaceID, ListID) ([]Member, error)
+	// AddMember shares the list with a member of its workspace.
+	AddMember(ctx context.Context, connection Connection, ownerID UserID, workspaceID WorkspaceID, listID ListID, email string) error
+	RemoveMember(ctx context.Context, connection Connection, userID UserID, workspaceID WorkspaceID, listID ListID, memberID UserID) error
 }
 
 type TasksRepository interface {
-	Create(context.Context, Connection, UserID, Task) error
-	Read(context.Context, Connection, UserID, TaskID) (Task, error)
-	Update(context.Context, Connection, UserID, Task) error
-	Delete(context.Context, Connection, UserID, TaskID) error
-	GetAllTasks(context.Context, Connection, UserID, []ListID) ([]Task, error)
+	Create(context.Context, Connection, UserID, WorkspaceID, Task) error
+	Read(context.Context, Connection, UserID, WorkspaceID, TaskID) (Task, error)
+	Update(context.Context, Connection, UserID, WorkspaceID, Task) error
+	Delete(context.Context, Connection, UserID, WorkspaceID, TaskID) error
+	GetAllTasks(context.Context, Connection, UserID, WorkspaceID, []ListID) ([]Task, error)
+	Find(context.Context, Connection, UserID, WorkspaceID, TaskFilter) ([]Task, error)
+}
+
+type CommentsRepository interface {
+	Create(context.Context, Connection, UserID, WorkspaceID, Comment) error
+	ReadAll(context.Context, Connection, UserID, WorkspaceID, TaskID, Page) ([]Comment, error)
+	Update(context.Context, Connection, UserID, WorkspaceID, Comment) (Comment, error)
+	Delete(context.Context, Connection, UserID, WorkspaceID, CommentID) error
+	// ReadByAuthor returns the comments the user wrote on any task.
+	ReadByAuthor(context.Context, Connection, UserID) ([]Comment, error)
+}
+
+type FeedTokensRepository interface {
+	Create(context.Context, Connection, FeedToken) error
+	ReadByHash(context.Context, Connection, string) (FeedToken, error)
+	ReadAll(context.Context, Connection, UserID, WorkspaceID) ([]FeedToken, error)
+	Revoke(context.Context, Connection, UserID, WorkspaceID, FeedTokenID) error
+	RevokeAll(context.Context, Connection, UserID) error
+}
+
+type AccessTokensRepository interface {
+	Create(context.Context, Connection, AccessToken) error
+	// ReadByHash returns the token with the hash unless it is revoked or
+	// expired.
+	ReadByHash(context.Context, Connection, string) (AccessToken, error)
+	ReadAll(context.Context, Connection, UserID) ([]AccessToken, error)
+	Revoke(context.Context, Connection, UserID, AccessTokenID) error
+	RevokeAll(context.Context, Connection, UserID) error
+}
+
+type TwoFactorRepository interface {
+	Read(context.Context, Connection, UserID) (TwoFactor, error)
+	// Create stores a secret unless two-factor authentication is enabled.
+	Create(context.Context, Connection, TwoFactor) error
+	Enable(ctx context.Context, connection Connection, userID UserID, counter int64) error
+	// UseCounter moves the last counter forward, it fails for a counter
+	// that isn't after the last one.
+	UseCounter(ctx context.Context, connection Connection, userID UserID, counter int64) error
+	// Delete removes the secret and the recovery codes.
+	Delete(context.Context, Connection, UserID) error
+	// CreateRecoveryCodes replaces the recovery codes with the hashes.
+	CreateRecoveryCodes(ctx context.Context, connection Connection, userID UserID, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, connection Connection, userID UserID, codeHash string) error
+	CreateChallenge(context.Context, Connection, LoginChallenge) error
+	// ReadChallenge returns the user of the unexpired challenge, leaving it.
+	ReadChallenge(context.Context, Connection, string) (UserID, error)
+	// UseChallenge deletes the unexpired challenge and returns its user.
+	UseChallenge(context.Context, Connection, string) (UserID, error)
+}
+
+type OIDCRepository interface {
+	// ReadUser returns the user linked to the identity.
+	ReadUser(ctx context.Context, connection Connection, issuer, subject string) (UserID, error)
+	Link(context.Context, Connection, Identity, UserID) error
+	// CreateLogin also removes the expired logins.
+	CreateLogin(context.Context, Connection, OIDCLogin) error
+	// UseLogin deletes the unexpired login with the state hash and returns
+	// it.
+	UseLogin(context.Context, Connection, string) (OIDCLogin, error)
+}
+
+type PasswordResetsRepository interface {
+	Create(context.Context, Connection, PasswordReset) error
+	// Use marks the reset with the token hash used and returns its user,
+	// unless it is used already or expired.
+	Use(context.Context, Connection, string) (UserID, error)
+	// UseAll marks every reset of the user used.
+	UseAll(context.Context, Connection, UserID) error
+}
+
+type AttachmentsRepository interface {
+	Create(context.Context, Connection, Attachment) error
+	Read(context.Context, Connection, UserID, WorkspaceID, AttachmentID) (Attachment, error)
+	ReadAll(context.Context, Connection, UserID, WorkspaceID, TaskID) ([]Attachment, error)
+	Delete(context.Context, Connection, UserID, WorkspaceID, AttachmentID) error
+	// UsedStorage locks the storage of the user until the transaction ends,
+	// so uploads check the quota and create their attachment one at a time.
+	UsedStorage(context.Context, Connection, UserID) (int64, error)
+	ReadOrphans(context.Context, Connection, int) ([]Attachment, error)
+	DeleteOrphan(context.Context, Connection, AttachmentID) error
+	// ReadByUser returns the attachments the user uploaded to tasks that
+	// still exist.
+	ReadByUser(context.Context, Connection, UserID) ([]Attachment, error)
+}
+
+type SmartListsRepository interface {
+	Create(context.Context, Connection, SmartList) error
+	Read(context.Context, Connection, UserID, WorkspaceID, SmartListID) (SmartList, error)
+	ReadAll(context.Context, Connection, UserID, WorkspaceID) ([]SmartList, error)
+	Update(context.Context, Connection, SmartList) error
+	Delete(context.Context, Connection, UserID, WorkspaceID, SmartListID) error
+}
+
+// StatsRepository counts the tasks of all the lists the user may access in
+// the workspace.
+type StatsRepository interface {
+	// ReadPeriods returns only the periods with any tasks, in order.
+	ReadPeriods(context.Context, Connection, UserID, WorkspaceID, StatsRange) ([]StatsPeriod, error)
+	ReadGroups(context.Context, Connection, UserID, WorkspaceID, StatsRange) ([]StatsGroup, error)
+	ReadSummary(context.Context, Connection, UserID, WorkspaceID, StatsRange) (StatsSummary, error)
+}
+
+type AccountDeletionsRepository interface {
+	// Create schedules the deletion, replacing one scheduled before.
+	Create(context.Context, Connection, AccountDeletion) error
+	Read(context.Context, Connection, UserID) (AccountDeletion, error)
+	Delete(context.Context, Connection, UserID) error
+	// ReadDue returns up to limit users whose deletion is due at now.
+	ReadDue(ctx context.Context, connection Connection, now time.Time, limit int) ([]UserID, error)
+	// DeleteDue removes the deletion of the user when it is due at now and
+	// reports whether it did. The removed row stays locked until the
+	// transaction ends, so a cancel can't slip in before the user is deleted.
+	DeleteDue(ctx context.Context, connection Connection, userID UserID, now time.Time) (bool, error)
+}
+
+type DataExportsRepository interface {
+	Create(context.Context, Connection, DataExport) error
+	ReadAll(context.Context, Connection, UserID) ([]DataExport, error)
+	// Claim marks the oldest pending export running and returns it, or
+	// sql.ErrNoRows. Exports running for longer than stale are claimed
+	// again, their worker is gone.
+	Claim(ctx context.Context, connection Connection, stale time.Duration) (DataExport, error)
+	// Complete marks the running export ready.
+	Complete(context.Context, Connection, DataExport) error
+	Fail(context.Context, Connection, DataExportID) error
+	// ReadByHash returns the ready export with the token hash until it
+	// expires.
+	ReadByHash(context.Context, Connection, string) (DataExport, error)
+	// ReadExpired returns up to limit exports that expired or lost their
+	// user.
+	ReadExpired(ctx context.Context, connection Connection, limit int) ([]DataExport, error)
+	Delete(context.Context, Connection, DataExportID) error
+}
+
+type AuditLogRepository interface {
+	Create(context.Context, Connection, AuditEntry) error
+	// ReadAll returns the newest entries first.
+	ReadAll(context.Context, Connection, Page) ([]AuditEntry, error)
+}
+
+type UsageRepository interface {
+	Read(context.Context, Connection, UserID) (UserUsage, error)
+}
+
+type HealthRepository interface {
+	Ping(context.Context, Connection) error
+	ReadSchemaVersion(context.Context, Connection) (int, error)
 }
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailVerificationTTL is how long a mailed verification link works.
+const EmailVerificationTTL = 7 * 24 * time.Hour
+
+var (
+	_ EmailVerificationInterface = (*EmailVerificationService)(nil)
+)
+
+var (
+	errEmailVerificationService             = errors.New("email verification service error")
+	ErrEmailVerificationServiceSend         = errors.Join(errEmailVerificationService, errors.New("send failed"))
+	ErrEmailVerificationServiceVerified     = errors.Join(ErrEmailVerificationServiceSend, errors.New("already verified"))
+	ErrEmailVerificationServiceVerify       = errors.Join(errEmailVerificationService, errors.New("verify failed"))
+	ErrEmailVerificationServiceInvalidToken = errors.Join(ErrEmailVerificationServiceVerify, errors.New("invalid or expired token"))
+)
+
+// EmailVerificationService signs the links it mails instead of storing
+// tokens. A token is the user ID, its expiry and an HMAC of both with the
+// email, so it stops working when the email changes.
+type EmailVerificationService struct {
+	provider  ConnectionProvider
+	userRepo  UsersRepository
+	mailer    Mailer
+	secret    []byte
+	verifyURL string
+}
+
+// NewEmailVerificationService mails links to verifyURL with the token added
+// as the token query parameter.
+func NewEmailVerificationService(provider ConnectionProvider, userRepo UsersRepository, mailer Mailer, secret []byte,
+	verifyURL string,
+) *EmailVerificationService {
+	return &EmailVerificationService{
+		provider:  provider,
+		userRepo:  userRepo,
+		mailer:    mailer,
+		secret:    secret,
+		verifyURL: verifyURL,
+	}
+}
+
+// Close implements EmailVerificationInterface.
+func (s *EmailVerificationService) Close() error {
+	return s.provider.Close()
+}
+
+// Send implements EmailVerificationInterface.
+func (s *EmailVerificationService) Send(ctx context.Context, email string) error {
+	var user User
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		user, err = s.userRepo.ReadByEmail(ctx, connection, email)
+
+		return err
+	})
+	if err != nil {
+		return errors.Join(ErrEmailVerificationServiceSend, err)
+	}
+	if user.VerifiedAt != nil {
+		return ErrEmailVerificationServiceVerified
+	}
+
+	link, err := url.Parse(s.verifyURL)
+	if err != nil {
+		return errors.Join(ErrEmailVerificationServiceSend, err)
+	}
+	query := link.Query()
+	query.Set("token", s.token(user.ID, user.Email, time.Now().Add(EmailVerificationTTL)))
+	link.RawQuery = query.Encode()
+
+	err = s.mailer.Send(ctx, Mail{
+		To:      user.Email,
+		Subject: "Confirm your email",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"Please confirm this is your email by opening\n\n"+
+			"%s\n\n"+
+			"The link works for %s. If you didn't register, ignore this message.\n",
+			user.Name, link, EmailVerificationTTL),
+	})
+	if err != nil {
+		return errors.Join(ErrEmailVerificationServiceSend, err)
+	}
+
+	return nil
+}
+
+// Verify implements EmailVerificationInterface.
+func (s *EmailVerificationService) Verify(ctx context.Context, token string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errors.Join(ErrEmailVerificationServiceInvalidToken, errors.New("malformed token"))
+	}
+	userID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return errors.Join(ErrEmailVerificationServiceInvalidToken, err)
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return errors.Join(ErrEmailVerificationServiceInvalidToken, err)
+	}
+	if time.Now().Unix() >= expires {
+		return errors.Join(ErrEmailVerificationServiceInvalidToken, errors.New("token expired"))
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		user, err := s.userRepo.ReadByID(ctx, connection, userID)
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal([]byte(token), []byte(s.token(user.ID, user.Email, time.Unix(expires, 0)))) {
+			return errors.Join(ErrEmailVerificationServiceInvalidToken, errors.New("signature mismatch"))
+		}
+
+		return s.userRepo.Verify(ctx, connection, user.ID, user.Email)
+	})
+	if err != nil {
+		return errors.Join(ErrEmailVerificationServiceVerify, err)
+	}
+
+	return nil
+}
+
+func (s *EmailVerificationService) token(userID UserID, email string, expires time.Time) string {
+	payload := userID.String() + "." + strconv.FormatInt(expires.Unix(), 10)
+
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload + "\n" + email))
+
+	return payload + "." + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,94 @@
+package domain_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationUnit(t *testing.T) {
+	ctx := context.Background()
+	secret := []byte("some secret")
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo"}
+
+	// sentToken sends a verification mail to the user and returns the token
+	// in its link.
+	sentToken := func(t *testing.T, user domain.User) string {
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		users, mailer := dbMocks.NewMockUsersRepository(t), dbMocks.NewMockMailer(t)
+		users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, user.Email).Return(user, nil).Once()
+
+		var token string
+		mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+			for _, line := range strings.Split(mail.Body, "\n") {
+				if link, err := url.Parse(line); err == nil && link.Query().Has("token") {
+					token = link.Query().Get("token")
+				}
+			}
+
+			return mail.To == user.Email
+		})).Return(nil).Once()
+
+		service := domain.NewEmailVerificationService(provider, users, mailer, secret, "https://todo.example/verify")
+		require.NoError(t, service.Send(ctx, user.Email))
+		require.NotEmpty(t, token)
+
+		return token
+	}
+
+	t.Run("Verify", func(t *testing.T) {
+		token := sentToken(t, user)
+
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		users := dbMocks.NewMockUsersRepository(t)
+		users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		users.EXPECT().Verify(mock.Anything, mock.Anything, user.ID, user.Email).Return(nil).Once()
+
+		service := domain.NewEmailVerificationService(provider, users, dbMocks.NewMockMailer(t), secret, "")
+		require.NoError(t, service.Verify(ctx, token))
+	})
+
+	t.Run("Email Changed", func(t *testing.T) {
+		token := sentToken(t, user)
+
+		changed := user
+		changed.Email = "eve@email.foo"
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		users := dbMocks.NewMockUsersRepository(t)
+		users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(changed, nil).Once()
+
+		service := domain.NewEmailVerificationService(provider, users, dbMocks.NewMockMailer(t), secret, "")
+		require.ErrorIs(t, service.Verify(ctx, token), domain.ErrEmailVerificationServiceInvalidToken)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		expired := user.ID.String() + "." + "1000000000" + ".00"
+		for _, token := range []string{"", "a.b", "not-uuid.1.00", expired} {
+			service := domain.NewEmailVerificationService(newFakeProvider(dbMocks.NewMockConnection(t)),
+				dbMocks.NewMockUsersRepository(t), dbMocks.NewMockMailer(t), secret, "")
+
+			require.ErrorIs(t, service.Verify(ctx, token), domain.ErrEmailVerificationServiceInvalidToken, token)
+		}
+	})
+
+	t.Run("Already Verified", func(t *testing.T) {
+		verifiedAt := time.Now()
+		verified := user
+		verified.VerifiedAt = &verifiedAt
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		users := dbMocks.NewMockUsersRepository(t)
+		users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, user.Email).Return(verified, nil).Once()
+
+		service := domain.NewEmailVerificationService(provider, users, dbMocks.NewMockMailer(t), secret, "")
+		require.ErrorIs(t, service.Send(ctx, user.Email), domain.ErrEmailVerificationServiceVerified)
+	})
+}
@@ -0,0 +1,7 @@
+package domain
+
+// TOTPCode and MatchTOTP expose the TOTP primitives to the tests.
+var (
+	TOTPCode  = totpCode
+	MatchTOTP = matchTOTP
+)
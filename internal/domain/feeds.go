@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ FeedInterface = (*FeedService)(nil)
+)
+
+var (
+	errFeedService            = errors.New("feed service error")
+	ErrFeedServiceCreateToken = errors.Join(errFeedService, errors.New("create token failed"))
+	ErrFeedServiceGetTokens   = errors.Join(errFeedService, errors.New("get tokens failed"))
+	ErrFeedServiceRevokeToken = errors.Join(errFeedService, errors.New("revoke token failed"))
+	ErrFeedServiceTasks       = errors.Join(errFeedService, errors.New("read feed tasks failed"))
+)
+
+type FeedService struct {
+	provider  ConnectionProvider
+	feedRepo  FeedTokensRepository
+	tasksRepo TasksRepository
+}
+
+func NewFeedService(provider ConnectionProvider, feedRepo FeedTokensRepository, tasksRepo TasksRepository) *FeedService {
+	return &FeedService{
+		provider:  provider,
+		feedRepo:  feedRepo,
+		tasksRepo: tasksRepo,
+	}
+}
+
+// Close implements FeedInterface.
+func (s *FeedService) Close() error {
+	return s.provider.Close()
+}
+
+// CreateToken implements FeedInterface. Only a hash of the token is stored,
+// the caller is the last one to see the token itself.
+func (s *FeedService) CreateToken(ctx context.Context, userID UserID, workspaceID WorkspaceID, token string) (FeedToken, error) {
+	feedToken := FeedToken{
+		ID:          FeedTokenID(uuid.New()),
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		TokenHash:   hashToken(token),
+		CreatedAt:   time.Now(),
+	}
+
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.feedRepo.Create(ctx, connection, feedToken)
+	})
+	if err != nil {
+		return FeedToken{}, errors.Join(ErrFeedServiceCreateToken, err)
+	}
+
+	return feedToken, nil
+}
+
+// GetTokens implements FeedInterface.
+func (s *FeedService) GetTokens(ctx context.Context, userID UserID, workspaceID WorkspaceID) ([]FeedToken, error) {
+	var tokens []FeedToken
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		tokens, err = s.feedRepo.ReadAll(ctx, connection, userID, workspaceID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrFeedServiceGetTokens, err)
+	}
+
+	return tokens, nil
+}
+
+// RevokeToken implements FeedInterface.
+func (s *FeedService) RevokeToken(ctx context.Context, userID UserID, workspaceID WorkspaceID, tokenID FeedTokenID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.feedRepo.Revoke(ctx, connection, userID, workspaceID, tokenID)
+	})
+	if err != nil {
+		return errors.Join(ErrFeedServiceRevokeToken, err)
+	}
+
+	return nil
+}
+
+// Tasks implements FeedInterface. It returns the tasks with a deadline the
+// user the feed token was issued to may access in its workspace, acting on
+// behalf of that user.
+func (s *FeedService) Tasks(ctx context.Context, token string, filter TaskFilter) ([]Task, error) {
+	filter.HasDeadline = true
+
+	var feedToken FeedToken
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		feedToken, err = s.feedRepo.ReadByHash(ctx, connection, hashToken(token))
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrFeedServiceTasks, err)
+	}
+
+	var tasks []Task
+	err = s.provider.Execute(WithAuthUser(ctx, feedToken.UserID), func(ctx context.Context, connection Connection) error {
+		var err error
+		tasks, err = s.tasksRepo.Find(ctx, connection, feedToken.UserID, feedToken.WorkspaceID, filter)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrFeedServiceTasks, err)
+	}
+
+	return tasks, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
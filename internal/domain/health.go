@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SchemaVersion is the version of db/init.sql the code works with, bump both
+// together.
+const SchemaVersion = 2
+
+// healthCheckTimeout bounds the checks, a probe must answer before the load
+// balancer gives up on it even when the database hangs.
+const healthCheckTimeout = 2 * time.Second
+
+// Names of the checks of HealthService.
+const (
+	HealthCheckConnection = "connection"
+	HealthCheckQuery      = "query"
+	HealthCheckSchema     = "schema"
+
+	// HealthCheckSkipped is the error of the checks after a failed one.
+	HealthCheckSkipped = "skipped"
+)
+
+var (
+	_ HealthInterface = (*HealthService)(nil)
+)
+
+type HealthService struct {
+	provider   ConnectionProvider
+	healthRepo HealthRepository
+}
+
+func NewHealthService(provider ConnectionProvider, healthRepo HealthRepository) *HealthService {
+	return &HealthService{
+		provider:   provider,
+		healthRepo: healthRepo,
+	}
+}
+
+// Close implements HealthInterface.
+func (s *HealthService) Close() error {
+	return s.provider.Close()
+}
+
+// Check implements HealthInterface. It acts on behalf of no one, the checks
+// read no user's data.
+func (s *HealthService) Check(ctx context.Context) []HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	checks := []HealthCheck{{Name: HealthCheckConnection}, {Name: HealthCheckQuery}, {Name: HealthCheckSchema}}
+	done := 0
+	start := time.Now()
+	finish := func(err error) {
+		checks[done].Duration = time.Since(start)
+		if err != nil {
+			checks[done].Error = err.Error()
+		}
+		done++
+		start = time.Now()
+	}
+
+	err := s.provider.Execute(WithoutAuthUser(ctx), func(ctx context.Context, connection Connection) error {
+		finish(nil)
+
+		if err := s.healthRepo.Ping(ctx, connection); err != nil {
+			finish(err)
+			return err
+		}
+		finish(nil)
+
+		version, err := s.healthRepo.ReadSchemaVersion(ctx, connection)
+		if err == nil && version != SchemaVersion {
+			err = fmt.Errorf("schema version %d, want %d", version, SchemaVersion)
+		}
+		finish(err)
+
+		return err
+	})
+	if err != nil && done == 0 {
+		finish(err)
+	}
+	for ; done < len(checks); done++ {
+		checks[done].Error = HealthCheckSkipped
+	}
+
+	return checks
+}
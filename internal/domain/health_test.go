@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthUnit(t *testing.T) {
+	type mocks struct {
+		provider domain.ConnectionProvider
+		repo     *dbMocks.MockHealthRepository
+	}
+
+	tests := []struct {
+		name         string
+		prepareMocks func(mocks)
+		want         []string
+	}{
+		{
+			name: "Healthy",
+			prepareMocks: func(m mocks) {
+				withDeadline := mock.MatchedBy(func(ctx context.Context) bool {
+					_, ok := ctx.Deadline()
+					return ok
+				})
+				m.repo.EXPECT().Ping(withDeadline, mock.Anything).Return(nil).Once()
+				m.repo.EXPECT().ReadSchemaVersion(mock.Anything, mock.Anything).Return(domain.SchemaVersion, nil).Once()
+			},
+			want: []string{"", "", ""},
+		},
+		{
+			name: "Query Failed",
+			prepareMocks: func(m mocks) {
+				m.repo.EXPECT().Ping(mock.Anything, mock.Anything).Return(errors.New("some error")).Once()
+			},
+			want: []string{"", "some error", "skipped"},
+		},
+		{
+			name: "Schema Version Mismatch",
+			prepareMocks: func(m mocks) {
+				m.repo.EXPECT().Ping(mock.Anything, mock.Anything).Return(nil).Once()
+				m.repo.EXPECT().ReadSchemaVersion(mock.Anything, mock.Anything).Return(domain.SchemaVersion+1, nil).Once()
+			},
+			want: []string{"", "", fmt.Sprintf("schema version %d, want %d", domain.SchemaVersion+1, domain.SchemaVersion)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := mocks{provider: newFakeProvider(dbMocks.NewMockConnection(t)), repo: dbMocks.NewMockHealthRepository(t)}
+			test.prepareMocks(m)
+
+			checks := domain.NewHealthService(m.provider, m.repo).Check(context.Background())
+
+			require.Len(t, checks, len(test.want))
+			for i, check := range checks {
+				require.Equal(t, test.want[i], check.Error, check.Name)
+			}
+		})
+	}
+
+	t.Run("Connection Failed", func(t *testing.T) {
+		provider := dbMocks.NewMockConnectionProvider(t)
+		provider.EXPECT().Execute(mock.Anything, mock.Anything).Return(errors.New("some error")).Once()
+
+		checks := domain.NewHealthService(provider, dbMocks.NewMockHealthRepository(t)).Check(context.Background())
+
+		require.Equal(t, domain.HealthCheckConnection, checks[0].Name)
+		require.Equal(t, "some error", checks[0].Error)
+		require.Equal(t, "skipped", checks[1].Error)
+		require.Equal(t, "skipped", checks[2].Error)
+	})
+}
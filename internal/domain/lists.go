@@ -16,6 +16,9 @@ var (
 	ErrToDoServiceListAccessDenied = errors.Join(errListService, errors.New("access to list failed"))
 	ErrToDoServiceReadAllLits      = errors.Join(errListService, errors.New("read all lists failed"))
 	ErrToDoServiceUpdateList       = errors.Join(errListService, errors.New("update lists failed"))
+	ErrListServiceGetMembers       = errors.Join(errListService, errors.New("get members failed"))
+	ErrListServiceAddMember        = errors.Join(errListService, errors.New("add member failed"))
+	ErrListServiceRemoveMember     = errors.Join(errListService, errors.New("remove member failed"))
 )
 
 type ListService struct {
@@ -37,11 +40,12 @@ func (s *ListService) Close() error {
 func (s *ListService) Create(ctx context.Context, list List) error {
 	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
 		list := List{
-			ID:        list.ID,
-			UserID:    list.UserID,
-			Name:      list.Name,
-			UpdatedAt: list.UpdatedAt,
-			Tasks:     list.Tasks,
+			ID:          list.ID,
+			UserID:      list.UserID,
+			WorkspaceID: list.WorkspaceID,
+			Name:        list.Name,
+			UpdatedAt:   list.UpdatedAt,
+			Tasks:       list.Tasks,
 		}
 
 		return s.listRepo.Create(ctx, connection, list)
@@ -53,9 +57,9 @@ func (s *ListService) Create(ctx context.Context, list List) error {
 	return nil
 }
 
-func (s *ListService) Delete(ctx context.Context, userID UserID, listID ListID) error {
+func (s *ListService) Delete(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID ListID) error {
 	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
-		return s.listRepo.Delete(ctx, connection, userID, listID)
+		return s.listRepo.Delete(ctx, connection, userID, workspaceID, listID)
 	})
 	if err != nil {
 		return errors.Join(ErrToDoServiceDeleteList, err)
@@ -64,11 +68,11 @@ func (s *ListService) Delete(ctx context.Context, userID UserID, listID ListID)
 	return nil
 }
 
-func (s *ListService) GetAll(ctx context.Context, userID UserID) ([]List, error) {
+func (s *ListService) GetAll(ctx context.Context, userID UserID, workspaceID WorkspaceID) ([]List, error) {
 	var lists []List
 	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
 		var getError error
-		lists, getError = s.listRepo.ReadAll(ctx, connection, userID)
+		lists, getError = s.listRepo.ReadAll(ctx, connection, userID, workspaceID)
 		return getError
 	})
 	if err != nil {
@@ -88,3 +92,43 @@ func (s *ListService) Update(ctx context.Context, list List) error {
 
 	return nil
 }
+
+// GetMembers implements ListInterface.
+func (s *ListService) GetMembers(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID ListID) ([]Member, error) {
+	var members []Member
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		members, err = s.listRepo.ReadMembers(ctx, connection, userID, workspaceID, listID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrListServiceGetMembers, err)
+	}
+
+	return members, nil
+}
+
+// AddMember implements ListInterface.
+func (s *ListService) AddMember(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID ListID, email string) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.listRepo.AddMember(ctx, connection, userID, workspaceID, listID, email)
+	})
+	if err != nil {
+		return errors.Join(ErrListServiceAddMember, err)
+	}
+
+	return nil
+}
+
+// RemoveMember implements ListInterface.
+func (s *ListService) RemoveMember(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID ListID, memberID UserID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.listRepo.RemoveMember(ctx, connection, userID, workspaceID, listID, memberID)
+	})
+	if err != nil {
+		return errors.Join(ErrListServiceRemoveMember, err)
+	}
+
+	return nil
+}
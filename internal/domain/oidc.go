@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OIDCLoginTTL is how long the user has to sign in with the provider.
+const OIDCLoginTTL = 10 * time.Minute
+
+var (
+	_ OIDCInterface = (*OIDCService)(nil)
+)
+
+var (
+	errOIDCService                = errors.New("oidc service error")
+	ErrOIDCServiceStart           = errors.Join(errOIDCService, errors.New("start failed"))
+	ErrOIDCServiceCallback        = errors.Join(errOIDCService, errors.New("callback failed"))
+	ErrOIDCServiceInvalidState    = errors.Join(ErrOIDCServiceCallback, errors.New("invalid or expired state"))
+	ErrOIDCServiceUnverifiedEmail = errors.Join(ErrOIDCServiceCallback, errors.New("email not verified by the provider"))
+	ErrOIDCServiceNoEmail         = errors.Join(ErrOIDCServiceCallback, errors.New("identity without email"))
+	ErrOIDCServiceUnverifiedUser  = errors.Join(ErrOIDCServiceCallback, errors.New("account email not verified"))
+)
+
+type OIDCService struct {
+	provider      ConnectionProvider
+	userRepo      UsersRepository
+	oidcRepo      OIDCRepository
+	twoFactorRepo TwoFactorRepository
+	workspaceRepo WorkspacesRepository
+	idp           IdentityProvider
+}
+
+func NewOIDCService(provider ConnectionProvider, userRepo UsersRepository, oidcRepo OIDCRepository,
+	twoFactorRepo TwoFactorRepository, workspaceRepo WorkspacesRepository, idp IdentityProvider,
+) *OIDCService {
+	return &OIDCService{
+		provider:      provider,
+		userRepo:      userRepo,
+		oidcRepo:      oidcRepo,
+		twoFactorRepo: twoFactorRepo,
+		workspaceRepo: workspaceRepo,
+		idp:           idp,
+	}
+}
+
+// Close implements OIDCInterface.
+func (s *OIDCService) Close() error {
+	return s.provider.Close()
+}
+
+// Start implements OIDCInterface.
+func (s *OIDCService) Start(ctx context.Context, binding string) (string, error) {
+	state, err := newChallengeToken()
+	if err != nil {
+		return "", errors.Join(ErrOIDCServiceStart, err)
+	}
+	nonce, err := newChallengeToken()
+	if err != nil {
+		return "", errors.Join(ErrOIDCServiceStart, err)
+	}
+	verifier, err := newPKCEVerifier()
+	if err != nil {
+		return "", errors.Join(ErrOIDCServiceStart, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.oidcRepo.CreateLogin(ctx, connection, OIDCLogin{
+			StateHash: oidcStateHash(state, binding),
+			Verifier:  verifier,
+			Nonce:     nonce,
+			ExpiresAt: time.Now().Add(OIDCLoginTTL),
+		})
+	})
+	if err != nil {
+		return "", errors.Join(ErrOIDCServiceStart, err)
+	}
+
+	return s.idp.AuthCodeURL(state, nonce, pkceChallenge(verifier)), nil
+}
+
+// Callback implements OIDCInterface. A state with another binding than the
+// one of Start isn't found, so a code and state sent from another browser are
+// refused instead of signing the user in to the account they belong to.
+func (s *OIDCService) Callback(ctx context.Context, state string, code string, binding string, token string) (string, error) {
+	if binding == "" {
+		return "", errors.Join(ErrOIDCServiceCallback, ErrOIDCServiceInvalidState)
+	}
+
+	var login OIDCLogin
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		login, err = s.oidcRepo.UseLogin(ctx, connection, oidcStateHash(state, binding))
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Join(ErrOIDCServiceInvalidState, err)
+		}
+
+		return err
+	})
+	if err != nil {
+		return "", errors.Join(ErrOIDCServiceCallback, err)
+	}
+
+	identity, err := s.idp.Exchange(ctx, code, login.Verifier, login.Nonce)
+	if err != nil {
+		return "", errors.Join(ErrOIDCServiceCallback, err)
+	}
+
+	var challenge string
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		user, err := s.linkedUser(ctx, connection, identity, token)
+		if err != nil {
+			return err
+		}
+		if err = checkEnabled(user); err != nil {
+			return err
+		}
+
+		challenge, err = createLoginChallenge(ctx, connection, s.twoFactorRepo, user.ID)
+		if err != nil || challenge != "" {
+			return err
+		}
+
+		return s.userRepo.UpdateTokenByEmail(ctx, connection, user.Email, token)
+	})
+	if err != nil {
+		return "", errors.Join(ErrOIDCServiceCallback, err)
+	}
+
+	return challenge, nil
+}
+
+// linkedUser returns the user linked to the identity. An identity seen for
+// the first time needs an email the provider verified, or else anyone able
+// to register the email with the provider could take over the account with
+// it, or claim it before its owner signs up. It is linked to the user with
+// the email, who must have verified it too, or else whoever registered it
+// first, with a password of their own, would share the account. Without such
+// a user a new one is registered with the token. Registered users have no
+// password, they can choose one with a password reset.
+func (s *OIDCService) linkedUser(ctx context.Context, connection Connection, identity Identity, token string) (User, error) {
+	userID, err := s.oidcRepo.ReadUser(ctx, connection, identity.Issuer, identity.Subject)
+	if err == nil {
+		return s.userRepo.ReadByID(ctx, connection, userID)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return User{}, err
+	}
+
+	if identity.Email == "" {
+		return User{}, ErrOIDCServiceNoEmail
+	}
+	if !identity.EmailVerified {
+		return User{}, ErrOIDCServiceUnverifiedEmail
+	}
+
+	user, err := s.userRepo.ReadByEmail(ctx, connection, identity.Email)
+	switch {
+	case err == nil:
+		if user.VerifiedAt == nil {
+			return User{}, ErrOIDCServiceUnverifiedUser
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		user = User{
+			ID:       UserID(uuid.New()),
+			Name:     identity.Name,
+			Email:    identity.Email,
+			Token:    token,
+			TimeZone: DefaultTimeZone,
+			Locale:   DefaultLocale,
+		}
+		if user.Name == "" {
+			user.Name = identity.Email
+		}
+		if err = s.userRepo.Create(ctx, connection, user); err != nil {
+			return User{}, err
+		}
+		if err = createPersonalWorkspace(ctx, connection, s.workspaceRepo, user); err != nil {
+			return User{}, err
+		}
+	default:
+		return User{}, err
+	}
+
+	if err = s.userRepo.Verify(ctx, connection, user.ID, user.Email); err != nil {
+		return User{}, err
+	}
+
+	if err = s.oidcRepo.Link(ctx, connection, identity, user.ID); err != nil {
+		return User{}, err
+	}
+
+	return user, nil
+}
+
+// oidcStateHash ties the state to the browser that started the sign-in, the
+// binding is kept in a cookie of that browser.
+func oidcStateHash(state string, binding string) string {
+	return hashToken(state + "." + binding)
+}
+
+// newPKCEVerifier returns 43 characters, the shortest verifier RFC 7636
+// allows.
+func newPKCEVerifier() (string, error) {
+	verifier := make([]byte, 32)
+	if _, err := rand.Read(verifier); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(verifier), nil
+}
+
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,209 @@
+package domain_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCUnit(t *testing.T) {
+	ctx := context.Background()
+	verifiedAt := time.Now()
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo", VerifiedAt: &verifiedAt}
+	identity := domain.Identity{Issuer: "https://idp.example", Subject: "ann", Email: user.Email, EmailVerified: true, Name: "Ann"}
+	login := domain.OIDCLogin{StateHash: "hash", Verifier: "verifier", Nonce: "nonce", ExpiresAt: time.Now().Add(time.Minute)}
+
+	type mocks struct {
+		users      *dbMocks.MockUsersRepository
+		oidc       *dbMocks.MockOIDCRepository
+		twoFactor  *dbMocks.MockTwoFactorRepository
+		workspaces *dbMocks.MockWorkspacesRepository
+		idp        *dbMocks.MockIdentityProvider
+	}
+	stateHash := func(state, binding string) string {
+		sum := sha256.Sum256([]byte(state + "." + binding))
+
+		return hex.EncodeToString(sum[:])
+	}
+	exchange := func(m mocks, identity domain.Identity) {
+		m.oidc.EXPECT().UseLogin(mock.Anything, mock.Anything, stateHash("state", "binding")).Return(login, nil).Once()
+		m.idp.EXPECT().Exchange(mock.Anything, "code", login.Verifier, login.Nonce).Return(identity, nil).Once()
+	}
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *domain.OIDCService, mocks)
+	}{
+		{
+			name: "Start",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				var stored domain.OIDCLogin
+				m.oidc.EXPECT().CreateLogin(mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, login domain.OIDCLogin) { stored = login }).
+					Return(nil).Once()
+				m.idp.EXPECT().AuthCodeURL(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(state, nonce, challenge string) string {
+						sum := sha256.Sum256([]byte(stored.Verifier))
+						require.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:]), challenge)
+						require.Equal(t, stored.Nonce, nonce)
+						require.Equal(t, stateHash(state, "binding"), stored.StateHash)
+
+						return "https://idp.example/authorize"
+					}).Once()
+
+				authURL, err := service.Start(ctx, "binding")
+
+				require.NoError(t, err)
+				require.Equal(t, "https://idp.example/authorize", authURL)
+				require.Len(t, stored.Verifier, 43)
+			},
+		},
+		{
+			name: "Callback Linked",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				exchange(m, identity)
+				m.oidc.EXPECT().ReadUser(mock.Anything, mock.Anything, identity.Issuer, identity.Subject).Return(user.ID, nil).Once()
+				m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				m.twoFactor.EXPECT().Read(mock.Anything, mock.Anything, user.ID).Return(domain.TwoFactor{}, sql.ErrNoRows).Once()
+				m.users.EXPECT().UpdateTokenByEmail(mock.Anything, mock.Anything, user.Email, "token").Return(nil).Once()
+
+				challenge, err := service.Callback(ctx, "state", "code", "binding", "token")
+
+				require.NoError(t, err)
+				require.Empty(t, challenge)
+			},
+		},
+		{
+			name: "Callback Links By Verified Email",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				enabledAt := time.Now()
+				exchange(m, identity)
+				m.oidc.EXPECT().ReadUser(mock.Anything, mock.Anything, identity.Issuer, identity.Subject).Return(uuid.Nil, sql.ErrNoRows).Once()
+				m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, user.Email).Return(user, nil).Once()
+				m.users.EXPECT().Verify(mock.Anything, mock.Anything, user.ID, user.Email).Return(nil).Once()
+				m.oidc.EXPECT().Link(mock.Anything, mock.Anything, identity, user.ID).Return(nil).Once()
+				m.twoFactor.EXPECT().Read(mock.Anything, mock.Anything, user.ID).Return(domain.TwoFactor{EnabledAt: &enabledAt}, nil).Once()
+				m.twoFactor.EXPECT().CreateChallenge(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+				challenge, err := service.Callback(ctx, "state", "code", "binding", "token")
+
+				require.NoError(t, err)
+				require.NotEmpty(t, challenge)
+			},
+		},
+		{
+			name: "Callback Unverified Email",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				unverified := identity
+				unverified.EmailVerified = false
+				exchange(m, unverified)
+				m.oidc.EXPECT().ReadUser(mock.Anything, mock.Anything, identity.Issuer, identity.Subject).Return(uuid.Nil, sql.ErrNoRows).Once()
+
+				_, err := service.Callback(ctx, "state", "code", "binding", "token")
+
+				require.ErrorIs(t, err, domain.ErrOIDCServiceUnverifiedEmail)
+			},
+		},
+		{
+			name: "Callback Unverified Email Doesn't Register",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				newcomer := domain.Identity{Issuer: identity.Issuer, Subject: "bob", Email: "bob@email.foo"}
+				exchange(m, newcomer)
+				m.oidc.EXPECT().ReadUser(mock.Anything, mock.Anything, newcomer.Issuer, newcomer.Subject).Return(uuid.Nil, sql.ErrNoRows).Once()
+
+				_, err := service.Callback(ctx, "state", "code", "binding", "token")
+
+				require.ErrorIs(t, err, domain.ErrOIDCServiceUnverifiedEmail)
+			},
+		},
+		{
+			name: "Callback Unverified User",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				unverified := user
+				unverified.VerifiedAt = nil
+				exchange(m, identity)
+				m.oidc.EXPECT().ReadUser(mock.Anything, mock.Anything, identity.Issuer, identity.Subject).Return(uuid.Nil, sql.ErrNoRows).Once()
+				m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, user.Email).Return(unverified, nil).Once()
+
+				_, err := service.Callback(ctx, "state", "code", "binding", "token")
+
+				require.ErrorIs(t, err, domain.ErrOIDCServiceUnverifiedUser)
+			},
+		},
+		{
+			name: "Callback Registers",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				newcomer := domain.Identity{Issuer: identity.Issuer, Subject: "bob", Email: "bob@email.foo", EmailVerified: true}
+				exchange(m, newcomer)
+				m.oidc.EXPECT().ReadUser(mock.Anything, mock.Anything, newcomer.Issuer, newcomer.Subject).Return(uuid.Nil, sql.ErrNoRows).Once()
+				m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, newcomer.Email).Return(domain.User{}, sql.ErrNoRows).Once()
+				var created domain.User
+				m.users.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, user domain.User) { created = user }).
+					Return(nil).Once()
+				var workspace domain.Workspace
+				m.workspaces.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, created domain.Workspace, _ domain.UserID) {
+						workspace = created
+					}).
+					Return(nil).Once()
+				m.users.EXPECT().Verify(mock.Anything, mock.Anything, mock.Anything, newcomer.Email).Return(nil).Once()
+				m.oidc.EXPECT().Link(mock.Anything, mock.Anything, newcomer, mock.Anything).Return(nil).Once()
+				m.twoFactor.EXPECT().Read(mock.Anything, mock.Anything, mock.Anything).Return(domain.TwoFactor{}, sql.ErrNoRows).Once()
+				m.users.EXPECT().UpdateTokenByEmail(mock.Anything, mock.Anything, newcomer.Email, "token").Return(nil).Once()
+
+				_, err := service.Callback(ctx, "state", "code", "binding", "token")
+
+				require.NoError(t, err)
+				require.Equal(t, "bob@email.foo", created.Name)
+				require.Equal(t, "token", created.Token)
+				require.Empty(t, created.PasswordHash)
+				require.Equal(t, domain.PersonalWorkspace(created.ID), workspace.ID)
+				require.True(t, workspace.Personal)
+			},
+		},
+		{
+			name: "Callback Invalid State",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				m.oidc.EXPECT().UseLogin(mock.Anything, mock.Anything, mock.Anything).Return(domain.OIDCLogin{}, sql.ErrNoRows).Once()
+
+				_, err := service.Callback(ctx, "state", "code", "binding", "token")
+
+				require.ErrorIs(t, err, domain.ErrOIDCServiceInvalidState)
+			},
+		},
+		{
+			name: "Callback Without Binding",
+			check: func(t *testing.T, service *domain.OIDCService, m mocks) {
+				_, err := service.Callback(ctx, "state", "code", "", "token")
+
+				require.ErrorIs(t, err, domain.ErrOIDCServiceInvalidState)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := mocks{
+				users:      dbMocks.NewMockUsersRepository(t),
+				oidc:       dbMocks.NewMockOIDCRepository(t),
+				twoFactor:  dbMocks.NewMockTwoFactorRepository(t),
+				workspaces: dbMocks.NewMockWorkspacesRepository(t),
+				idp:        dbMocks.NewMockIdentityProvider(t),
+			}
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+
+			test.check(t, domain.NewOIDCService(provider, m.users, m.oidc, m.twoFactor, m.workspaces, m.idp), m)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetTTL is how long a mailed reset link works.
+const PasswordResetTTL = time.Hour
+
+var (
+	_ PasswordResetInterface = (*PasswordResetService)(nil)
+)
+
+var (
+	errPasswordResetService             = errors.New("password reset service error")
+	ErrPasswordResetServiceForgot       = errors.Join(errPasswordResetService, errors.New("forgot password failed"))
+	ErrPasswordResetServiceReset        = errors.Join(errPasswordResetService, errors.New("reset password failed"))
+	ErrPasswordResetServiceInvalidToken = errors.Join(ErrPasswordResetServiceReset, errors.New("invalid or expired token"))
+)
+
+type PasswordResetService struct {
+	provider        ConnectionProvider
+	userRepo        UsersRepository
+	resetRepo       PasswordResetsRepository
+	feedRepo        FeedTokensRepository
+	accessTokenRepo AccessTokensRepository
+	keyRing         JWTKeyRing
+	mailer          Mailer
+	resetURL        string
+}
+
+// NewPasswordResetService mails links to resetURL with the token added as
+// the token query parameter. The key ring is nil when JWTs are turned off.
+func NewPasswordResetService(provider ConnectionProvider, userRepo UsersRepository, resetRepo PasswordResetsRepository,
+	feedRepo FeedTokensRepository, accessTokenRepo AccessTokensRepository, keyRing JWTKeyRing, mailer Mailer,
+	resetURL string,
+) *PasswordResetService {
+	return &PasswordResetService{
+		provider:        provider,
+		userRepo:        userRepo,
+		resetRepo:       resetRepo,
+		feedRepo:        feedRepo,
+		accessTokenRepo: accessTokenRepo,
+		keyRing:         keyRing,
+		mailer:          mailer,
+		resetURL:        resetURL,
+	}
+}
+
+// Close implements PasswordResetInterface.
+func (s *PasswordResetService) Close() error {
+	return s.provider.Close()
+}
+
+// Forgot implements PasswordResetInterface. The mail is sent once the reset
+// is stored, so a link never arrives for a token that doesn't exist.
+func (s *PasswordResetService) Forgot(ctx context.Context, email string, token string) error {
+	link, err := passwordResetLink(s.resetURL, token)
+	if err != nil {
+		return errors.Join(ErrPasswordResetServiceForgot, err)
+	}
+
+	var user User
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		user, err = s.userRepo.ReadByEmail(ctx, connection, email)
+		if err != nil {
+			return err
+		}
+
+		return s.resetRepo.Create(ctx, connection, newPasswordReset(user.ID, token))
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return errors.Join(ErrPasswordResetServiceForgot, err)
+	}
+
+	err = s.mailer.Send(ctx, Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"Someone asked to reset the password of your account. To choose a new one, open\n\n"+
+			"%s\n\n"+
+			"The link works once, within %s. If it wasn't you, ignore this message and\n"+
+			"your password stays the same.\n", user.Name, link, PasswordResetTTL),
+	})
+	if err != nil {
+		return errors.Join(ErrPasswordResetServiceForgot, err)
+	}
+
+	return nil
+}
+
+// Reset implements PasswordResetInterface. Other resets of the user, their
+// feed tokens, access tokens and JWTs are revoked too, as they may have
+// leaked with the password.
+func (s *PasswordResetService) Reset(ctx context.Context, token string, passwordHash string, newToken string) error {
+	var userID UserID
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		userID, err = s.resetRepo.Use(ctx, connection, hashToken(token))
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Join(ErrPasswordResetServiceInvalidToken, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = s.userRepo.UpdatePassword(ctx, connection, userID, passwordHash, newToken); err != nil {
+			return err
+		}
+		if err = s.resetRepo.UseAll(ctx, connection, userID); err != nil {
+			return err
+		}
+		if err = s.feedRepo.RevokeAll(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return s.accessTokenRepo.RevokeAll(ctx, connection, userID)
+	})
+	if err == nil {
+		err = revokeJWTs(ctx, s.keyRing, userID)
+	}
+	if err != nil {
+		return errors.Join(ErrPasswordResetServiceReset, err)
+	}
+
+	return nil
+}
+
+// passwordResetLink adds the token to resetURL as the token query parameter.
+func passwordResetLink(resetURL string, token string) (*url.URL, error) {
+	link, err := url.Parse(resetURL)
+	if err != nil {
+		return nil, err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link, nil
+}
+
+func newPasswordReset(userID UserID, token string) PasswordReset {
+	now := time.Now()
+
+	return PasswordReset{
+		ID:        PasswordResetID(uuid.New()),
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(PasswordResetTTL),
+	}
+}
@@ -0,0 +1,130 @@
+package domain_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetUnit(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo"}
+	const resetURL = "https://todo.example/reset?lang=en"
+
+	type mocks struct {
+		users   *dbMocks.MockUsersRepository
+		resets  *dbMocks.MockPasswordResetsRepository
+		feeds   *dbMocks.MockFeedTokensRepository
+		tokens  *dbMocks.MockAccessTokensRepository
+		keyRing *dbMocks.MockJWTKeyRing
+		mailer  *dbMocks.MockMailer
+	}
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *domain.PasswordResetService, mocks)
+	}{
+		{
+			name: "Forgot",
+			check: func(t *testing.T, service *domain.PasswordResetService, m mocks) {
+				m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, user.Email).Return(user, nil).Once()
+				m.resets.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(reset domain.PasswordReset) bool {
+					return reset.UserID == user.ID && reset.TokenHash != "" && reset.TokenHash != "secret" &&
+						reset.ExpiresAt.Sub(reset.CreatedAt) == domain.PasswordResetTTL
+				})).Return(nil).Once()
+				m.mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+					require.Equal(t, user.Email, mail.To)
+					require.Contains(t, mail.Body, "https://todo.example/reset?lang=en&token=secret")
+
+					return true
+				})).Return(nil).Once()
+
+				require.NoError(t, service.Forgot(context.Background(), user.Email, "secret"))
+			},
+		},
+		{
+			name: "Forgot Unknown Email",
+			check: func(t *testing.T, service *domain.PasswordResetService, m mocks) {
+				m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, "nobody@email.foo").
+					Return(domain.User{}, sql.ErrNoRows).Once()
+
+				require.NoError(t, service.Forgot(context.Background(), "nobody@email.foo", "secret"))
+			},
+		},
+		{
+			name: "Forgot Mail Error",
+			check: func(t *testing.T, service *domain.PasswordResetService, m mocks) {
+				m.users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, user.Email).Return(user, nil).Once()
+				m.resets.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				m.mailer.EXPECT().Send(mock.Anything, mock.Anything).Return(errors.New("some error")).Once()
+
+				err := service.Forgot(context.Background(), user.Email, "secret")
+
+				require.ErrorIs(t, err, domain.ErrPasswordResetServiceForgot)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Reset",
+			check: func(t *testing.T, service *domain.PasswordResetService, m mocks) {
+				m.resets.EXPECT().Use(mock.Anything, mock.Anything, mock.Anything).Return(user.ID, nil).Once()
+				m.users.EXPECT().UpdatePassword(mock.Anything, mock.Anything, user.ID, "new hash", "new token").Return(nil).Once()
+				m.resets.EXPECT().UseAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				m.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				m.tokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				m.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(nil).Once()
+
+				require.NoError(t, service.Reset(context.Background(), "secret", "new hash", "new token"))
+			},
+		},
+		{
+			name: "Reset Revoke Failed",
+			check: func(t *testing.T, service *domain.PasswordResetService, m mocks) {
+				m.resets.EXPECT().Use(mock.Anything, mock.Anything, mock.Anything).Return(user.ID, nil).Once()
+				m.users.EXPECT().UpdatePassword(mock.Anything, mock.Anything, user.ID, "new hash", "new token").Return(nil).Once()
+				m.resets.EXPECT().UseAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				m.feeds.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				m.tokens.EXPECT().RevokeAll(mock.Anything, mock.Anything, user.ID).Return(nil).Once()
+				m.keyRing.EXPECT().RevokeUser(mock.Anything, user.ID).Return(errors.New("some error")).Once()
+
+				err := service.Reset(context.Background(), "secret", "new hash", "new token")
+
+				require.ErrorIs(t, err, domain.ErrPasswordResetServiceReset)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Reset Invalid Token",
+			check: func(t *testing.T, service *domain.PasswordResetService, m mocks) {
+				m.resets.EXPECT().Use(mock.Anything, mock.Anything, mock.Anything).Return(uuid.Nil, sql.ErrNoRows).Once()
+
+				err := service.Reset(context.Background(), "used", "new hash", "new token")
+
+				require.ErrorIs(t, err, domain.ErrPasswordResetServiceInvalidToken)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := mocks{
+				users:   dbMocks.NewMockUsersRepository(t),
+				resets:  dbMocks.NewMockPasswordResetsRepository(t),
+				feeds:   dbMocks.NewMockFeedTokensRepository(t),
+				tokens:  dbMocks.NewMockAccessTokensRepository(t),
+				keyRing: dbMocks.NewMockJWTKeyRing(t),
+				mailer:  dbMocks.NewMockMailer(t),
+			}
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			service := domain.NewPasswordResetService(provider, m.users, m.resets, m.feeds, m.tokens, m.keyRing, m.mailer, resetURL)
+
+			test.check(t, service, m)
+		})
+	}
+}
@@ -0,0 +1,351 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxQueryLength bounds the work a single query may cause.
+const MaxQueryLength = 1000
+
+var (
+	ErrQueryInvalid = errors.Join(errToDoService, errors.New("invalid query"))
+)
+
+func (QueryAnd) query()        {}
+func (QueryOr) query()         {}
+func (QueryNot) query()        {}
+func (QueryPriority) query()   {}
+func (QueryDone) query()       {}
+func (QueryList) query()       {}
+func (QueryTag) query()        {}
+func (QueryName) query()       {}
+func (QueryAssignee) query()   {}
+func (QueryDue) query()        {}
+func (QueryOverdue) query()    {}
+func (QueryNoDeadline) query() {}
+
+type (
+	queryToken struct {
+		kind  queryTokenKind
+		text  string
+		pos   int
+		quote bool
+	}
+
+	queryTokenKind int
+
+	queryParser struct {
+		tokens []queryToken
+		pos    int
+		today  Day
+	}
+)
+
+const (
+	queryWord queryTokenKind = iota
+	queryOperator
+	queryOpen
+	queryClose
+	queryEnd
+)
+
+// ParseQuery parses a filter query such as
+//
+//	priority:high AND due<7d AND NOT done AND list:"Work"
+//
+// Terms are joined with AND, OR and NOT, grouped with parentheses, and
+// terms written next to each other must all match. The fields are
+//
+//   - priority:low, priority:normal or priority:high;
+//   - done, done:true or done:false;
+//   - list:name and tag:name;
+//   - assignee:me or assignee:none;
+//   - due compared with <, <=, :, >= or > to today, tomorrow, yesterday,
+//     a number of days or weeks from today such as 7d or 2w, or a date
+//     such as 2025-03-01; due:overdue and due:none are also accepted.
+//
+// Any other word or quoted text matches the task name. Days are those of
+// today, the current day in the user's time zone.
+func ParseQuery(query string, today Day) (Query, error) {
+	if len(query) > MaxQueryLength {
+		return nil, errors.Join(ErrQueryInvalid, fmt.Errorf("query is longer than %d bytes", MaxQueryLength))
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, errors.Join(ErrQueryInvalid, err)
+	}
+
+	p := &queryParser{tokens: tokens, today: today}
+	if p.peek().kind == queryEnd {
+		return nil, errors.Join(ErrQueryInvalid, errors.New("empty query"))
+	}
+
+	q, err := p.or()
+	if err == nil && p.peek().kind != queryEnd {
+		err = p.unexpected()
+	}
+	if err != nil {
+		return nil, errors.Join(ErrQueryInvalid, err)
+	}
+
+	return q, nil
+}
+
+func tokenizeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	for i := 0; i < len(query); {
+		r, size := utf8.DecodeRuneInString(query[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: queryOpen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: queryClose, text: ")", pos: i})
+			i++
+		case r == ':' || r == '=' || r == '<' || r == '>':
+			text := query[i : i+1]
+			if (r == '<' || r == '>') && i+1 < len(query) && query[i+1] == '=' {
+				text = query[i : i+2]
+			}
+			tokens = append(tokens, queryToken{kind: queryOperator, text: text, pos: i})
+			i += len(text)
+		case r == '"':
+			var text strings.Builder
+			start := i
+			for i++; ; i++ {
+				if i >= len(query) {
+					return nil, fmt.Errorf("unterminated quote at %d", start)
+				}
+				if query[i] == '\\' && i+1 < len(query) {
+					i++
+				} else if query[i] == '"' {
+					i++
+
+					break
+				}
+				text.WriteByte(query[i])
+			}
+			tokens = append(tokens, queryToken{kind: queryWord, text: text.String(), pos: start, quote: true})
+		default:
+			start := i
+			for i < len(query) && !strings.ContainsRune(" \t\r\n()\":=<>", rune(query[i])) {
+				_, size := utf8.DecodeRuneInString(query[i:])
+				i += size
+			}
+			tokens = append(tokens, queryToken{kind: queryWord, text: query[start:i], pos: start})
+		}
+	}
+
+	return append(tokens, queryToken{kind: queryEnd, pos: len(query)}), nil
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	token := p.tokens[p.pos]
+	if token.kind != queryEnd {
+		p.pos++
+	}
+
+	return token
+}
+
+func (p *queryParser) unexpected() error {
+	token := p.peek()
+	if token.kind == queryEnd {
+		return errors.New("unexpected end of query")
+	}
+
+	return fmt.Errorf("unexpected %q at %d", token.text, token.pos)
+}
+
+// keyword reports whether the next token is the operator word, in any case.
+func (p *queryParser) keyword(word string) bool {
+	token := p.peek()
+
+	return token.kind == queryWord && !token.quote && strings.EqualFold(token.text, word)
+}
+
+func (p *queryParser) or() (Query, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		p.next()
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = QueryOr{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) and() (Query, error) {
+	left, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if p.keyword("and") {
+			p.next()
+		} else if token := p.peek(); token.kind == queryEnd || token.kind == queryClose || p.keyword("or") {
+			return left, nil
+		}
+
+		right, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		left = QueryAnd{Left: left, Right: right}
+	}
+}
+
+func (p *queryParser) not() (Query, error) {
+	if p.keyword("not") {
+		p.next()
+		q, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+
+		return QueryNot{Query: q}, nil
+	}
+
+	if p.peek().kind == queryOpen {
+		p.next()
+		q, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != queryClose {
+			return nil, p.unexpected()
+		}
+		p.next()
+
+		return q, nil
+	}
+
+	return p.term()
+}
+
+func (p *queryParser) term() (Query, error) {
+	token := p.peek()
+	if token.kind != queryWord {
+		return nil, p.unexpected()
+	}
+	p.next()
+
+	if p.peek().kind != queryOperator {
+		if !token.quote {
+			switch strings.ToLower(token.text) {
+			case "done":
+				return QueryDone{Done: true}, nil
+			case "overdue":
+				return QueryOverdue{Today: p.today}, nil
+			}
+		}
+
+		return QueryName{Text: token.text}, nil
+	}
+
+	field, op := strings.ToLower(token.text), p.next().text
+	if op == ":" {
+		op = "="
+	}
+	value := p.next()
+	if value.kind != queryWord {
+		return nil, fmt.Errorf("missing value of %s at %d", field, value.pos)
+	}
+	if field != "due" && op != "=" {
+		return nil, fmt.Errorf("%s can't be compared with %s", field, op)
+	}
+
+	switch field {
+	case "priority":
+		priority := strings.ToLower(value.text)
+		if priority != Low && priority != Normal && priority != High {
+			return nil, fmt.Errorf("unknown priority %q", value.text)
+		}
+
+		return QueryPriority{Priority: priority}, nil
+	case "done":
+		done, err := strconv.ParseBool(value.text)
+		if err != nil {
+			return nil, fmt.Errorf("done: %w", err)
+		}
+
+		return QueryDone{Done: done}, nil
+	case "list":
+		return QueryList{Name: value.text}, nil
+	case "tag":
+		return QueryTag{Tag: value.text}, nil
+	case "name":
+		return QueryName{Text: value.text}, nil
+	case "assignee":
+		switch strings.ToLower(value.text) {
+		case "me":
+			return QueryAssignee{}, nil
+		case "none":
+			return QueryAssignee{None: true}, nil
+		}
+
+		return nil, fmt.Errorf("unknown assignee %q", value.text)
+	case "due":
+		return p.due(op, value.text)
+	}
+
+	return nil, fmt.Errorf("unknown field %q at %d", token.text, token.pos)
+}
+
+func (p *queryParser) due(op, value string) (Query, error) {
+	value = strings.ToLower(value)
+	switch value {
+	case "overdue", "none":
+		if op != "=" {
+			return nil, fmt.Errorf("due:%s can't be compared with %s", value, op)
+		}
+		if value == "none" {
+			return QueryNoDeadline{}, nil
+		}
+
+		return QueryOverdue{Today: p.today}, nil
+	case "today":
+		return QueryDue{Op: op, Day: p.today}, nil
+	case "tomorrow":
+		return QueryDue{Op: op, Day: p.today.AddDays(1)}, nil
+	case "yesterday":
+		return QueryDue{Op: op, Day: p.today.AddDays(-1)}, nil
+	}
+
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return QueryDue{Op: op, Day: p.today.AddDays(int(date.Sub(p.today.Date).Hours() / 24))}, nil
+	}
+
+	if len(value) > 1 {
+		count, err := strconv.Atoi(value[:len(value)-1])
+		if err == nil && count > -10000 && count < 10000 {
+			switch value[len(value)-1] {
+			case 'd':
+				return QueryDue{Op: op, Day: p.today.AddDays(count)}, nil
+			case 'w':
+				return QueryDue{Op: op, Day: p.today.AddDays(7 * count)}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unknown due %q", value)
+}
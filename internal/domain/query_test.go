@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	today := domain.DayIn(time.Date(2024, time.March, 9, 14, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		query    string
+		expected domain.Query
+	}{
+		{
+			query: `priority:high AND due<7d AND NOT done AND list:"Work"`,
+			expected: domain.QueryAnd{
+				Left: domain.QueryAnd{
+					Left: domain.QueryAnd{
+						Left:  domain.QueryPriority{Priority: domain.High},
+						Right: domain.QueryDue{Op: "<", Day: today.AddDays(7)},
+					},
+					Right: domain.QueryNot{Query: domain.QueryDone{Done: true}},
+				},
+				Right: domain.QueryList{Name: "Work"},
+			},
+		},
+		{
+			query: `tag:home OR tag:work priority:low`,
+			expected: domain.QueryOr{
+				Left:  domain.QueryTag{Tag: "home"},
+				Right: domain.QueryAnd{Left: domain.QueryTag{Tag: "work"}, Right: domain.QueryPriority{Priority: domain.Low}},
+			},
+		},
+		{
+			query: `(tag:home or tag:work) and not assignee:none`,
+			expected: domain.QueryAnd{
+				Left:  domain.QueryOr{Left: domain.QueryTag{Tag: "home"}, Right: domain.QueryTag{Tag: "work"}},
+				Right: domain.QueryNot{Query: domain.QueryAssignee{None: true}},
+			},
+		},
+		{query: `due:today`, expected: domain.QueryDue{Op: "=", Day: today}},
+		{query: `due>=2024-03-01`, expected: domain.QueryDue{Op: ">=", Day: today.AddDays(-8)}},
+		{query: `due<=-1w`, expected: domain.QueryDue{Op: "<=", Day: today.AddDays(-7)}},
+		{query: `due:none`, expected: domain.QueryNoDeadline{}},
+		{query: `overdue`, expected: domain.QueryOverdue{Today: today}},
+		{query: `done:false`, expected: domain.QueryDone{Done: false}},
+		{query: `assignee:me`, expected: domain.QueryAssignee{}},
+		{query: `"pay rent"`, expected: domain.QueryName{Text: "pay rent"}},
+		{query: `"done"`, expected: domain.QueryName{Text: "done"}},
+		{query: `list:"say \"hi\""`, expected: domain.QueryList{Name: `say "hi"`}},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, err := domain.ParseQuery(test.query, today)
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, query)
+		})
+	}
+}
+
+func TestParseQueryFailed(t *testing.T) {
+	today := domain.DayIn(time.Now(), time.UTC)
+
+	for _, query := range []string{
+		``,
+		`priority:urgent`,
+		`priority<high`,
+		`due:someday`,
+		`due<none`,
+		`owner:me`,
+		`(tag:home`,
+		`tag:home)`,
+		`tag:`,
+		`NOT`,
+		`"unterminated`,
+		strings.Repeat("x", domain.MaxQueryLength+1),
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := domain.ParseQuery(query, today)
+
+			require.ErrorIs(t, err, domain.ErrQueryInvalid)
+		})
+	}
+}
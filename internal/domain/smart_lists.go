@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	_ SmartListInterface = (*SmartListService)(nil)
+)
+
+var (
+	errSmartListService           = errors.New("smart list service error")
+	ErrSmartListServiceCreate     = errors.Join(errSmartListService, errors.New("create failed"))
+	ErrSmartListServiceGetAll     = errors.Join(errSmartListService, errors.New("read all failed"))
+	ErrSmartListServiceUpdate     = errors.Join(errSmartListService, errors.New("update failed"))
+	ErrSmartListServiceDelete     = errors.Join(errSmartListService, errors.New("delete failed"))
+	ErrSmartListServiceGetTasks   = errors.Join(errSmartListService, errors.New("get tasks failed"))
+	ErrSmartListServiceInvalidArg = errors.Join(errSmartListService, errors.New("invalid smart list"))
+)
+
+type SmartListService struct {
+	provider      ConnectionProvider
+	smartListRepo SmartListsRepository
+	taskRepo      TasksRepository
+}
+
+func NewSmartListService(provider ConnectionProvider, smartListRepo SmartListsRepository, taskRepo TasksRepository) *SmartListService {
+	return &SmartListService{
+		provider:      provider,
+		smartListRepo: smartListRepo,
+		taskRepo:      taskRepo,
+	}
+}
+
+// Close implements SmartListInterface.
+func (s *SmartListService) Close() error {
+	return s.provider.Close()
+}
+
+// Create implements SmartListInterface.
+func (s *SmartListService) Create(ctx context.Context, list SmartList) error {
+	list, err := validSmartList(list)
+	if err != nil {
+		return errors.Join(ErrSmartListServiceCreate, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.smartListRepo.Create(ctx, connection, list)
+	})
+	if err != nil {
+		return errors.Join(ErrSmartListServiceCreate, err)
+	}
+
+	return nil
+}
+
+// GetAll implements SmartListInterface.
+func (s *SmartListService) GetAll(ctx context.Context, userID UserID, workspaceID WorkspaceID) ([]SmartList, error) {
+	var lists []SmartList
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		lists, err = s.smartListRepo.ReadAll(ctx, connection, userID, workspaceID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSmartListServiceGetAll, err)
+	}
+
+	return lists, nil
+}
+
+// Update implements SmartListInterface.
+func (s *SmartListService) Update(ctx context.Context, list SmartList) error {
+	list, err := validSmartList(list)
+	if err != nil {
+		return errors.Join(ErrSmartListServiceUpdate, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.smartListRepo.Update(ctx, connection, list)
+	})
+	if err != nil {
+		return errors.Join(ErrSmartListServiceUpdate, err)
+	}
+
+	return nil
+}
+
+// Delete implements SmartListInterface.
+func (s *SmartListService) Delete(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID SmartListID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.smartListRepo.Delete(ctx, connection, userID, workspaceID, listID)
+	})
+	if err != nil {
+		return errors.Join(ErrSmartListServiceDelete, err)
+	}
+
+	return nil
+}
+
+// GetTasks implements SmartListInterface.
+func (s *SmartListService) GetTasks(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID SmartListID, today Day,
+) ([]Task, error) {
+	var tasks []Task
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		list, err := s.smartListRepo.Read(ctx, connection, userID, workspaceID, listID)
+		if err != nil {
+			return err
+		}
+
+		query, err := ParseQuery(list.Query, today)
+		if err != nil {
+			return err
+		}
+
+		tasks, err = s.taskRepo.Find(ctx, connection, userID, workspaceID, TaskFilter{Today: today, Query: query})
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSmartListServiceGetTasks, err)
+	}
+
+	return tasks, nil
+}
+
+// validSmartList trims the name and makes sure the query parses, so that
+// a saved smart list can always be evaluated.
+func validSmartList(list SmartList) (SmartList, error) {
+	list.Name = strings.TrimSpace(list.Name)
+	if list.Name == "" {
+		return list, errors.Join(ErrSmartListServiceInvalidArg, errors.New("empty name"))
+	}
+
+	if _, err := ParseQuery(list.Query, DayIn(time.Now(), time.UTC)); err != nil {
+		return list, errors.Join(ErrSmartListServiceInvalidArg, err)
+	}
+
+	return list, nil
+}
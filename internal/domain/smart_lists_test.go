@@ -0,0 +1,65 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmartListsUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	list := domain.SmartList{ID: domain.SmartListID(uuid.New()), UserID: userID, Name: " Urgent ", Query: "priority:high AND NOT done"}
+
+	t.Run("Create trims the name", func(t *testing.T) {
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		repo := dbMocks.NewMockSmartListsRepository(t)
+		expected := list
+		expected.Name = "Urgent"
+		repo.EXPECT().Create(mock.Anything, mock.Anything, expected).Return(nil).Once()
+
+		err := domain.NewSmartListService(provider, repo, dbMocks.NewMockTasksRepository(t)).Create(context.Background(), list)
+
+		require.NoError(t, err)
+	})
+
+	for name, invalid := range map[string]domain.SmartList{
+		"Empty name":    {ID: list.ID, UserID: userID, Name: " ", Query: list.Query},
+		"Invalid query": {ID: list.ID, UserID: userID, Name: "Broken", Query: "priority:"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			service := domain.NewSmartListService(provider, dbMocks.NewMockSmartListsRepository(t), dbMocks.NewMockTasksRepository(t))
+
+			require.ErrorIs(t, service.Create(context.Background(), invalid), domain.ErrSmartListServiceInvalidArg)
+			require.ErrorIs(t, service.Update(context.Background(), invalid), domain.ErrSmartListServiceInvalidArg)
+		})
+	}
+
+	t.Run("Get tasks evaluates the query", func(t *testing.T) {
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		repo := dbMocks.NewMockSmartListsRepository(t)
+		tasks := dbMocks.NewMockTasksRepository(t)
+		today := domain.DayIn(time.Now(), time.UTC)
+
+		repo.EXPECT().Read(mock.Anything, mock.Anything, userID, workspaceID, list.ID).Return(list, nil).Once()
+		tasks.EXPECT().Find(mock.Anything, mock.Anything, userID, workspaceID, domain.TaskFilter{
+			Today: today,
+			Query: domain.QueryAnd{
+				Left:  domain.QueryPriority{Priority: domain.High},
+				Right: domain.QueryNot{Query: domain.QueryDone{Done: true}},
+			},
+		}).Return([]domain.Task{{Name: "found"}}, nil).Once()
+
+		found, err := domain.NewSmartListService(provider, repo, tasks).GetTasks(context.Background(), userID, workspaceID, list.ID, today)
+
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+	})
+}
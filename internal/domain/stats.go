@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxStatsDays bounds the range of a stats request.
+const MaxStatsDays = 366
+
+var (
+	_ StatsInterface = (*StatsService)(nil)
+)
+
+var (
+	errStatsService           = errors.New("stats service error")
+	ErrStatsServiceGet        = errors.Join(errStatsService, errors.New("get failed"))
+	ErrStatsServiceInvalidArg = errors.Join(errStatsService, errors.New("invalid range"))
+)
+
+type StatsService struct {
+	provider  ConnectionProvider
+	statsRepo StatsRepository
+}
+
+func NewStatsService(provider ConnectionProvider, statsRepo StatsRepository) *StatsService {
+	return &StatsService{
+		provider:  provider,
+		statsRepo: statsRepo,
+	}
+}
+
+// Close implements StatsInterface.
+func (s *StatsService) Close() error {
+	return s.provider.Close()
+}
+
+// Get implements StatsInterface. Periods cover the whole range, including
+// the ones without any tasks.
+func (s *StatsService) Get(ctx context.Context, userID UserID, workspaceID WorkspaceID, statsRange StatsRange) (Stats, error) {
+	if statsRange.Interval != StatsDay && statsRange.Interval != StatsWeek {
+		return Stats{}, errors.Join(ErrStatsServiceInvalidArg, fmt.Errorf("unknown interval %q", statsRange.Interval))
+	}
+	if statsRange.To.Date.Before(statsRange.From.Date) {
+		return Stats{}, errors.Join(ErrStatsServiceInvalidArg, errors.New("range ends before it starts"))
+	}
+	if statsRange.To.Date.Sub(statsRange.From.Date) >= MaxStatsDays*24*time.Hour {
+		return Stats{}, errors.Join(ErrStatsServiceInvalidArg, fmt.Errorf("range is longer than %d days", MaxStatsDays))
+	}
+
+	var periods []StatsPeriod
+	var groups []StatsGroup
+	var summary StatsSummary
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if periods, err = s.statsRepo.ReadPeriods(ctx, connection, userID, workspaceID, statsRange); err != nil {
+			return err
+		}
+		if groups, err = s.statsRepo.ReadGroups(ctx, connection, userID, workspaceID, statsRange); err != nil {
+			return err
+		}
+		summary, err = s.statsRepo.ReadSummary(ctx, connection, userID, workspaceID, statsRange)
+
+		return err
+	})
+	if err != nil {
+		return Stats{}, errors.Join(ErrStatsServiceGet, err)
+	}
+
+	stats := Stats{
+		From:                     statsRange.From.Date,
+		To:                       statsRange.To.Date,
+		Interval:                 statsRange.Interval,
+		Periods:                  fillPeriods(statsRange, periods),
+		Overdue:                  summary.Overdue,
+		AverageCompletionSeconds: summary.AverageCompletionSeconds,
+		ByList:                   []StatsListCount{},
+		ByPriority:               []StatsPriorityCount{},
+	}
+
+	for _, group := range groups {
+		if n := len(stats.ByList); n == 0 || stats.ByList[n-1].ListID != group.ListID {
+			stats.ByList = append(stats.ByList, StatsListCount{ListID: group.ListID, Name: group.ListName})
+		}
+		stats.ByList[len(stats.ByList)-1].Created += group.Created
+		stats.ByList[len(stats.ByList)-1].Completed += group.Completed
+	}
+	for _, priority := range []Priority{High, Normal, Low} {
+		count := StatsPriorityCount{Priority: priority}
+		for _, group := range groups {
+			if group.Priority == priority {
+				count.Created += group.Created
+				count.Completed += group.Completed
+			}
+		}
+		stats.ByPriority = append(stats.ByPriority, count)
+	}
+
+	return stats, nil
+}
+
+// fillPeriods adds empty periods between the ones read. Weeks start on
+// Monday, so the first one may start before the range.
+func fillPeriods(statsRange StatsRange, periods []StatsPeriod) []StatsPeriod {
+	start, step := statsRange.From.Date, 1
+	if statsRange.Interval == StatsWeek {
+		start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+		step = 7
+	}
+
+	filled := []StatsPeriod{}
+	for ; !start.After(statsRange.To.Date); start = start.AddDate(0, 0, step) {
+		period := StatsPeriod{Start: start}
+		for _, read := range periods {
+			if read.Start.Equal(start) {
+				period = read
+			}
+		}
+		filled = append(filled, period)
+	}
+
+	return filled
+}
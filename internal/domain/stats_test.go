@@ -0,0 +1,92 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	work, home := domain.ListID(uuid.New()), domain.ListID(uuid.New())
+	// Wednesday to the Tuesday after next.
+	from := domain.DayIn(time.Date(2024, time.March, 6, 12, 0, 0, 0, time.UTC), time.UTC)
+	to := from.AddDays(13)
+	date := func(day int) time.Time {
+		return time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC)
+	}
+	average := 3600.0
+
+	t.Run("Weeks", func(t *testing.T) {
+		statsRange := domain.StatsRange{From: from, To: to, Interval: domain.StatsWeek, Today: to}
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		repo := dbMocks.NewMockStatsRepository(t)
+		repo.EXPECT().ReadPeriods(mock.Anything, mock.Anything, userID, workspaceID, statsRange).
+			Return([]domain.StatsPeriod{{Start: date(11), Created: 3, Completed: 1}}, nil).Once()
+		repo.EXPECT().ReadGroups(mock.Anything, mock.Anything, userID, workspaceID, statsRange).
+			Return([]domain.StatsGroup{
+				{ListID: home, ListName: "Home", Priority: domain.High, Created: 1},
+				{ListID: home, ListName: "Home", Priority: domain.Low, Created: 1, Completed: 1},
+				{ListID: work, ListName: "Work", Priority: domain.High, Created: 1},
+			}, nil).Once()
+		repo.EXPECT().ReadSummary(mock.Anything, mock.Anything, userID, workspaceID, statsRange).
+			Return(domain.StatsSummary{Overdue: 2, AverageCompletionSeconds: &average}, nil).Once()
+
+		stats, err := domain.NewStatsService(provider, repo).Get(context.Background(), userID, workspaceID, statsRange)
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.StatsPeriod{
+			{Start: date(4)},
+			{Start: date(11), Created: 3, Completed: 1},
+			{Start: date(18)},
+		}, stats.Periods)
+		require.Equal(t, []domain.StatsListCount{
+			{ListID: home, Name: "Home", Created: 2, Completed: 1},
+			{ListID: work, Name: "Work", Created: 1},
+		}, stats.ByList)
+		require.Equal(t, []domain.StatsPriorityCount{
+			{Priority: domain.High, Created: 2},
+			{Priority: domain.Normal},
+			{Priority: domain.Low, Created: 1, Completed: 1},
+		}, stats.ByPriority)
+		require.Equal(t, 2, stats.Overdue)
+		require.Equal(t, &average, stats.AverageCompletionSeconds)
+	})
+
+	t.Run("Days", func(t *testing.T) {
+		statsRange := domain.StatsRange{From: from, To: from.AddDays(2), Interval: domain.StatsDay, Today: to}
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		repo := dbMocks.NewMockStatsRepository(t)
+		repo.EXPECT().ReadPeriods(mock.Anything, mock.Anything, userID, workspaceID, statsRange).Return(nil, nil).Once()
+		repo.EXPECT().ReadGroups(mock.Anything, mock.Anything, userID, workspaceID, statsRange).Return(nil, nil).Once()
+		repo.EXPECT().ReadSummary(mock.Anything, mock.Anything, userID, workspaceID, statsRange).Return(domain.StatsSummary{}, nil).Once()
+
+		stats, err := domain.NewStatsService(provider, repo).Get(context.Background(), userID, workspaceID, statsRange)
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.StatsPeriod{{Start: date(6)}, {Start: date(7)}, {Start: date(8)}}, stats.Periods)
+		require.Empty(t, stats.ByList)
+		require.Nil(t, stats.AverageCompletionSeconds)
+	})
+
+	for name, statsRange := range map[string]domain.StatsRange{
+		"Unknown interval": {From: from, To: to, Interval: "month"},
+		"Reversed":         {From: to, To: from, Interval: domain.StatsDay},
+		"Too long":         {From: from, To: from.AddDays(domain.MaxStatsDays), Interval: domain.StatsDay},
+	} {
+		t.Run(name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+
+			_, err := domain.NewStatsService(provider, dbMocks.NewMockStatsRepository(t)).Get(context.Background(), userID, workspaceID, statsRange)
+
+			require.ErrorIs(t, err, domain.ErrStatsServiceInvalidArg)
+		})
+	}
+}
@@ -2,7 +2,11 @@ package domain
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"slices"
+	"strings"
+	"time"
 )
 
 var (
@@ -10,72 +14,209 @@ var (
 )
 
 var (
-	ErrToDoServiceCreateTask = errors.Join(errToDoService, errors.New("create task failed"))
-	ErrToDoServiceDeleteTask = errors.Join(errToDoService, errors.New("delete task failed"))
-	ErrToDoServiceUpdateTask = errors.Join(errToDoService, errors.New("update task failed"))
+	ErrToDoServiceCreateTask   = errors.Join(errToDoService, errors.New("create task failed"))
+	ErrToDoServiceReadTask     = errors.Join(errToDoService, errors.New("read task failed"))
+	ErrToDoServiceTaskNotFound = errors.Join(ErrToDoServiceReadTask, errors.New("task not found"))
+	ErrToDoServiceFindTasks    = errors.Join(errToDoService, errors.New("find tasks failed"))
+	ErrToDoServiceDeleteTask   = errors.Join(errToDoService, errors.New("delete task failed"))
+	ErrToDoServiceUpdateTask   = errors.Join(errToDoService, errors.New("update task failed"))
+	// ErrToDoServiceTaskForbidden joins the errors of Create, Update and
+	// Delete when the list or the task is missing or not the user's.
+	ErrToDoServiceTaskForbidden = errors.Join(errToDoService, errors.New("list or task not found or access denied"))
 )
 
-type TaskService struct {
-	provider ConnectionProvider
-	taskRepo TasksRepository
-}
+type (
+	TaskService struct {
+		provider ConnectionProvider
+		taskRepo TasksRepository
+		events   TaskEvents
+	}
+
+	TaskServiceOption func(*TaskService)
+
+	nopTaskEvents struct{}
+)
 
-func NewTaskService(provider ConnectionProvider, taskRepo TasksRepository) *TaskService {
-	return &TaskService{
+func NewTaskService(provider ConnectionProvider, taskRepo TasksRepository, options ...TaskServiceOption) *TaskService {
+	s := &TaskService{
 		provider: provider,
 		taskRepo: taskRepo,
+		events:   nopTaskEvents{},
+	}
+
+	for _, o := range options {
+		o(s)
 	}
+
+	return s
 }
 
+// WithTaskEvents lets notification code hook into task changes.
+func WithTaskEvents(events TaskEvents) TaskServiceOption {
+	return func(s *TaskService) {
+		s.events = events
+	}
+}
+
+func (nopTaskEvents) TaskAssigned(context.Context, TaskAssigned) {}
+
 // Close implements TaskInterface.
 func (s *TaskService) Close() error {
 	return s.provider.Close()
 }
 
 // Create implements TaskInterface.
-func (s *TaskService) Create(ctx context.Context, userID UserID, task Task) error {
-	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
-		task := Task{
-			ID:        task.ID,
-			ListID:    task.ListID,
-			Priority:  task.Priority,
-			Deadline:  task.Deadline,
-			Done:      task.Done,
-			Name:      task.Name,
-			UpdatedAT: task.UpdatedAT,
-		}
+func (s *TaskService) Create(ctx context.Context, userID UserID, workspaceID WorkspaceID, task Task) error {
+	task.Assignees = uniqueAssignees(task.Assignees)
+	task.Tags = uniqueTags(task.Tags)
+	normalizeDeadline(&task)
 
-		return s.taskRepo.Create(ctx, connection, userID, task)
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.taskRepo.Create(ctx, connection, userID, workspaceID, task)
 	})
 	if err != nil {
-		return errors.Join(ErrToDoServiceCreateTask, err)
+		return taskError(ErrToDoServiceCreateTask, err)
 	}
 
+	s.notifyAssigned(ctx, userID, task, nil)
+
 	return nil
 }
 
+// Read implements TaskInterface.
+func (s *TaskService) Read(ctx context.Context, userID UserID, workspaceID WorkspaceID, taskID TaskID) (Task, error) {
+	var task Task
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		task, err = s.taskRepo.Read(ctx, connection, userID, workspaceID, taskID)
+
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return Task{}, errors.Join(ErrToDoServiceTaskNotFound, err)
+	}
+	if err != nil {
+		return Task{}, errors.Join(ErrToDoServiceReadTask, err)
+	}
+
+	return task, nil
+}
+
+// Find implements TaskInterface.
+func (s *TaskService) Find(ctx context.Context, userID UserID, workspaceID WorkspaceID, filter TaskFilter) ([]Task, error) {
+	var tasks []Task
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		tasks, err = s.taskRepo.Find(ctx, connection, userID, workspaceID, filter)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrToDoServiceFindTasks, err)
+	}
+
+	return tasks, nil
+}
+
 // Delete implements TaskInterface.
-func (s *TaskService) Delete(ctx context.Context, userID UserID, taskID TaskID) error {
+func (s *TaskService) Delete(ctx context.Context, userID UserID, workspaceID WorkspaceID, taskID TaskID) error {
 	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
 		// listID ckeck for connection to the user? norm?
-		return s.taskRepo.Delete(ctx, connection, userID, taskID)
+		return s.taskRepo.Delete(ctx, connection, userID, workspaceID, taskID)
 	})
 	if err != nil {
-		return errors.Join(ErrToDoServiceDeleteTask, err)
+		return taskError(ErrToDoServiceDeleteTask, err)
 	}
 
 	return nil
 }
 
 // Update implements TaskInterface.
-func (s *TaskService) Update(ctx context.Context, userID UserID, task Task) error {
+func (s *TaskService) Update(ctx context.Context, userID UserID, workspaceID WorkspaceID, task Task) error {
+	task.Assignees = uniqueAssignees(task.Assignees)
+	task.Tags = uniqueTags(task.Tags)
+	normalizeDeadline(&task)
+
+	var previous []UserID
 	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if task.Assignees != nil {
+			current, err := s.taskRepo.Read(ctx, connection, userID, workspaceID, task.ID)
+			if err != nil {
+				return err
+			}
+			previous = current.Assignees
+		}
+
 		// listID ckeck for connection to the user? norm?
-		return s.taskRepo.Update(ctx, connection, userID, task)
+		return s.taskRepo.Update(ctx, connection, userID, workspaceID, task)
 	})
 	if err != nil {
-		return errors.Join(ErrToDoServiceUpdateTask, err)
+		return taskError(ErrToDoServiceUpdateTask, err)
 	}
 
+	s.notifyAssigned(ctx, userID, task, previous)
+
 	return nil
 }
+
+// taskError joins ErrToDoServiceTaskForbidden to the error of op when no
+// row matched.
+func taskError(op error, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Join(op, ErrToDoServiceTaskForbidden, err)
+	}
+
+	return errors.Join(op, err)
+}
+
+// notifyAssigned emits an event for every assignee not in previous.
+func (s *TaskService) notifyAssigned(ctx context.Context, userID UserID, task Task, previous []UserID) {
+	now := time.Now()
+	for _, assigneeID := range task.Assignees {
+		if slices.Contains(previous, assigneeID) {
+			continue
+		}
+
+		s.events.TaskAssigned(ctx, TaskAssigned{
+			TaskID:     task.ID,
+			ListID:     task.ListID,
+			AssigneeID: assigneeID,
+			AssignerID: userID,
+			At:         now,
+		})
+	}
+}
+
+// uniqueAssignees drops duplicates, keeping a nil slice nil.
+func uniqueAssignees(assignees []UserID) []UserID {
+	if assignees == nil {
+		return nil
+	}
+
+	unique := make([]UserID, 0, len(assignees))
+	for _, assigneeID := range assignees {
+		if !slices.Contains(unique, assigneeID) {
+			unique = append(unique, assigneeID)
+		}
+	}
+
+	return unique
+}
+
+// uniqueTags trims tags and drops empty and duplicate ones, keeping a nil
+// slice nil.
+func uniqueTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !slices.Contains(unique, tag) {
+			unique = append(unique, tag)
+		}
+	}
+
+	return unique
+}
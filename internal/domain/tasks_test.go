@@ -0,0 +1,90 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedEvents struct {
+	assigned []domain.TaskAssigned
+}
+
+func (r *recordedEvents) TaskAssigned(_ context.Context, event domain.TaskAssigned) {
+	r.assigned = append(r.assigned, event)
+}
+
+func TestTaskAssignmentEventsUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	kept, added := domain.UserID(uuid.New()), domain.UserID(uuid.New())
+	task := domain.Task{ID: domain.TaskID(uuid.New()), ListID: domain.ListID(uuid.New()), Name: "task"}
+
+	tests := []struct {
+		name         string
+		create       bool
+		assignees    []domain.UserID
+		prepareMocks func(*dbMocks.MockTasksRepository)
+		expected     []domain.UserID
+	}{
+		{
+			name:      "New assignee only",
+			assignees: []domain.UserID{kept, added, added},
+			prepareMocks: func(tasks *dbMocks.MockTasksRepository) {
+				tasks.EXPECT().Read(mock.Anything, mock.Anything, userID, workspaceID, task.ID).
+					Return(domain.Task{Assignees: []domain.UserID{kept}}, nil).Once()
+				tasks.EXPECT().Update(mock.Anything, mock.Anything, userID, workspaceID, mock.MatchedBy(func(updated domain.Task) bool {
+					return len(updated.Assignees) == 2
+				})).Return(nil).Once()
+			},
+			expected: []domain.UserID{added},
+		},
+		{
+			name: "Assignees untouched",
+			prepareMocks: func(tasks *dbMocks.MockTasksRepository) {
+				tasks.EXPECT().Update(mock.Anything, mock.Anything, userID, workspaceID, task).Return(nil).Once()
+			},
+		},
+		{
+			name:      "Create with duplicate assignee",
+			create:    true,
+			assignees: []domain.UserID{kept, added, added},
+			prepareMocks: func(tasks *dbMocks.MockTasksRepository) {
+				tasks.EXPECT().Create(mock.Anything, mock.Anything, userID, workspaceID, mock.MatchedBy(func(created domain.Task) bool {
+					return len(created.Assignees) == 2
+				})).Return(nil).Once()
+			},
+			expected: []domain.UserID{kept, added},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			tasks := dbMocks.NewMockTasksRepository(t)
+			test.prepareMocks(tasks)
+
+			events := &recordedEvents{}
+			service := domain.NewTaskService(provider, tasks, domain.WithTaskEvents(events))
+
+			task := task
+			task.Assignees = test.assignees
+			if test.create {
+				require.NoError(t, service.Create(context.Background(), userID, workspaceID, task))
+			} else {
+				require.NoError(t, service.Update(context.Background(), userID, workspaceID, task))
+			}
+
+			require.Len(t, events.assigned, len(test.expected))
+			for i, assigneeID := range test.expected {
+				require.Equal(t, assigneeID, events.assigned[i].AssigneeID)
+				require.Equal(t, userID, events.assigned[i].AssignerID)
+				require.Equal(t, task.ID, events.assigned[i].TaskID)
+			}
+		})
+	}
+}
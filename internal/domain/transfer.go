@@ -0,0 +1,320 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ TransferInterface = (*TransferService)(nil)
+)
+
+var (
+	errTransferService          = errors.New("transfer service error")
+	ErrTransferServiceExport    = errors.Join(errTransferService, errors.New("export failed"))
+	ErrTransferServiceImport    = errors.Join(errTransferService, errors.New("import failed"))
+	ErrTransferServiceImportArg = errors.Join(ErrTransferServiceImport, errors.New("invalid import data"))
+
+	// errImportDryRun rolls back the import transaction once the report is collected.
+	errImportDryRun = errors.New("import dry run")
+)
+
+type TransferService struct {
+	provider ConnectionProvider
+	listRepo ListsRepository
+	taskRepo TasksRepository
+}
+
+func NewTransferService(provider ConnectionProvider, listRepo ListsRepository, taskRepo TasksRepository) *TransferService {
+	return &TransferService{
+		provider: provider,
+		listRepo: listRepo,
+		taskRepo: taskRepo,
+	}
+}
+
+// Close implements TransferInterface.
+func (s *TransferService) Close() error {
+	return s.provider.Close()
+}
+
+// Export implements TransferInterface. The tasks are read a list at a time,
+// so only the list being written is held in memory.
+func (s *TransferService) Export(ctx context.Context, userID UserID, workspaceID WorkspaceID, write func(List) error) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		lists, err := s.listRepo.ReadAll(ctx, connection, userID, workspaceID)
+		if err != nil {
+			return err
+		}
+
+		for _, list := range lists {
+			// Lists shared with the user belong to their owners' data.
+			if list.UserID != userID {
+				continue
+			}
+			if list.Tasks, err = s.taskRepo.GetAllTasks(ctx, connection, userID, workspaceID, []ListID{list.ID}); err != nil {
+				return err
+			}
+			if err = write(list); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrTransferServiceExport, err)
+	}
+
+	return nil
+}
+
+// Import implements TransferInterface.
+//
+// The whole import runs in one transaction. A dry run performs every write and
+// then rolls back, so the report reflects exactly what a real run would change.
+func (s *TransferService) Import(ctx context.Context, userID UserID, workspaceID WorkspaceID, lists []List, options ImportOptions,
+) (ImportReport, error) {
+	report := ImportReport{DryRun: options.DryRun}
+
+	if options.Mode == "" {
+		options.Mode = ImportMerge
+	}
+	if options.Mode != ImportMerge && options.Mode != ImportReplace {
+		return report, errors.Join(ErrTransferServiceImportArg, fmt.Errorf("unknown mode %q", options.Mode))
+	}
+
+	lists, err := prepareImport(userID, workspaceID, lists, options.RemapIDs, &report)
+	if err != nil {
+		return ImportReport{DryRun: options.DryRun}, errors.Join(ErrTransferServiceImportArg, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		existing, err := s.readAll(ctx, connection, userID, workspaceID)
+		if err != nil {
+			return err
+		}
+
+		if options.Mode == ImportReplace {
+			err = s.replace(ctx, connection, userID, workspaceID, existing, lists, &report)
+		} else {
+			err = s.merge(ctx, connection, userID, workspaceID, existing, lists, &report)
+		}
+		if err != nil {
+			return err
+		}
+
+		if options.DryRun {
+			return errImportDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportDryRun) {
+		return ImportReport{DryRun: options.DryRun}, errors.Join(ErrTransferServiceImport, err)
+	}
+
+	return report, nil
+}
+
+func (s *TransferService) readAll(ctx context.Context, connection Connection, userID UserID, workspaceID WorkspaceID) ([]List, error) {
+	return readOwnedLists(ctx, connection, s.listRepo, s.taskRepo, userID, workspaceID)
+}
+
+// readOwnedLists returns the lists the user owns in the workspace with their
+// tasks. Lists shared with the user belong to their owners' data.
+func readOwnedLists(ctx context.Context, connection Connection, listRepo ListsRepository, taskRepo TasksRepository,
+	userID UserID, workspaceID WorkspaceID,
+) ([]List, error) {
+	lists, err := listRepo.ReadAll(ctx, connection, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	lists = slices.DeleteFunc(lists, func(list List) bool { return list.UserID != userID })
+	if len(lists) == 0 {
+		return lists, nil
+	}
+
+	listIDs := make([]ListID, 0, len(lists))
+	for _, list := range lists {
+		listIDs = append(listIDs, list.ID)
+	}
+
+	tasks, err := taskRepo.GetAllTasks(ctx, connection, userID, workspaceID, listIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byList := make(map[ListID][]Task, len(lists))
+	for _, task := range tasks {
+		byList[task.ListID] = append(byList[task.ListID], task)
+	}
+	for i := range lists {
+		lists[i].Tasks = byList[lists[i].ID]
+	}
+
+	return lists, nil
+}
+
+func (s *TransferService) replace(ctx context.Context, connection Connection, userID UserID, workspaceID WorkspaceID,
+	existing, lists []List, report *ImportReport,
+) error {
+	for _, list := range existing {
+		if err := s.listRepo.Delete(ctx, connection, userID, workspaceID, list.ID); err != nil {
+			return err
+		}
+		report.ListsDeleted++
+		report.TasksDeleted += len(list.Tasks)
+	}
+
+	for _, list := range lists {
+		if err := s.createList(ctx, connection, userID, workspaceID, list, report); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TransferService) merge(ctx context.Context, connection Connection, userID UserID, workspaceID WorkspaceID,
+	existing, lists []List, report *ImportReport,
+) error {
+	existingLists := make(map[ListID]bool, len(existing))
+	existingTasks := make(map[TaskID]Task)
+	for _, list := range existing {
+		existingLists[list.ID] = true
+		for _, task := range list.Tasks {
+			existingTasks[task.ID] = task
+		}
+	}
+
+	for _, list := range lists {
+		if !existingLists[list.ID] {
+			if err := s.createList(ctx, connection, userID, workspaceID, list, report); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		tasks := list.Tasks
+		list.Tasks = nil
+		if err := s.listRepo.Update(ctx, connection, list); err != nil {
+			return err
+		}
+		report.ListsUpdated++
+
+		for _, task := range tasks {
+			current, ok := existingTasks[task.ID]
+			switch {
+			case !ok:
+				if err := s.taskRepo.Create(ctx, connection, userID, workspaceID, task); err != nil {
+					return err
+				}
+				report.TasksCreated++
+			case current.ListID != task.ListID:
+				// Tasks repository can't move a task between lists, so re-create it.
+				if err := s.taskRepo.Delete(ctx, connection, userID, workspaceID, task.ID); err != nil {
+					return err
+				}
+				if err := s.taskRepo.Create(ctx, connection, userID, workspaceID, task); err != nil {
+					return err
+				}
+				report.TasksUpdated++
+			default:
+				if err := s.taskRepo.Update(ctx, connection, userID, workspaceID, task); err != nil {
+					return err
+				}
+				report.TasksUpdated++
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *TransferService) createList(ctx context.Context, connection Connection, userID UserID, workspaceID WorkspaceID, list List,
+	report *ImportReport,
+) error {
+	tasks := list.Tasks
+	list.Tasks = nil
+	if err := s.listRepo.Create(ctx, connection, list); err != nil {
+		return err
+	}
+	report.ListsCreated++
+
+	for _, task := range tasks {
+		if err := s.taskRepo.Create(ctx, connection, userID, workspaceID, task); err != nil {
+			return err
+		}
+		report.TasksCreated++
+	}
+
+	return nil
+}
+
+// prepareImport binds the imported lists to the user and the workspace, links tasks to their
+// parent list and, when requested, replaces every ID with a freshly generated one.
+func prepareImport(userID UserID, workspaceID WorkspaceID, lists []List, remapIDs bool, report *ImportReport) ([]List, error) {
+	if remapIDs {
+		report.IDs = make(map[uuid.UUID]uuid.UUID)
+	}
+
+	seenLists, seenTasks := make(map[ListID]bool), make(map[TaskID]bool)
+	prepared := make([]List, 0, len(lists))
+	for _, list := range lists {
+		if !remapIDs && list.ID == uuid.Nil {
+			return nil, fmt.Errorf("list %q has no id", list.Name)
+		}
+		if seenLists[list.ID] && list.ID != uuid.Nil {
+			return nil, fmt.Errorf("duplicate list id %s", list.ID)
+		}
+		seenLists[list.ID] = true
+
+		if remapIDs {
+			newID := ListID(uuid.New())
+			if list.ID != uuid.Nil {
+				report.IDs[list.ID] = newID
+			}
+			list.ID = newID
+		}
+		list.UserID = userID
+		list.WorkspaceID = workspaceID
+
+		tasks := make([]Task, 0, len(list.Tasks))
+		for _, task := range list.Tasks {
+			if !remapIDs && task.ID == uuid.Nil {
+				return nil, fmt.Errorf("task %q has no id", task.Name)
+			}
+			if seenTasks[task.ID] && task.ID != uuid.Nil {
+				return nil, fmt.Errorf("duplicate task id %s", task.ID)
+			}
+			seenTasks[task.ID] = true
+
+			if remapIDs {
+				newID := TaskID(uuid.New())
+				if task.ID != uuid.Nil {
+					report.IDs[task.ID] = newID
+				}
+				task.ID = newID
+			}
+			task.ListID = list.ID
+			// Assignees refer to accounts, which an import can't vouch for.
+			task.Assignees = nil
+			if task.Priority == "" {
+				task.Priority = Normal
+			}
+			tasks = append(tasks, task)
+		}
+		list.Tasks = tasks
+
+		prepared = append(prepared, list)
+	}
+
+	return prepared, nil
+}
@@ -0,0 +1,206 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferImportUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	existingList := domain.List{ID: domain.ListID(uuid.New()), UserID: userID, Name: "existing"}
+	existingTask := domain.Task{ID: domain.TaskID(uuid.New()), ListID: existingList.ID, Priority: domain.Low, Name: "existing task"}
+	newTask := domain.Task{ID: domain.TaskID(uuid.New()), Name: "new task"}
+
+	importedLists := func() []domain.List {
+		return []domain.List{{
+			ID:    existingList.ID,
+			Name:  "renamed",
+			Tasks: []domain.Task{existingTask, newTask},
+		}}
+	}
+
+	expectExisting := func(lists *dbMocks.MockListsRepository, tasks *dbMocks.MockTasksRepository) {
+		lists.EXPECT().ReadAll(mock.Anything, mock.Anything, userID, workspaceID).
+			Return([]domain.List{existingList}, nil).
+			Once()
+		tasks.EXPECT().GetAllTasks(mock.Anything, mock.Anything, userID, workspaceID, []domain.ListID{existingList.ID}).
+			Return([]domain.Task{existingTask}, nil).
+			Once()
+	}
+
+	tests := []struct {
+		name         string
+		lists        []domain.List
+		options      domain.ImportOptions
+		prepareMocks func(*dbMocks.MockListsRepository, *dbMocks.MockTasksRepository)
+		check        func(*testing.T, domain.ImportReport, error)
+	}{
+		{
+			name:    "Merge",
+			lists:   importedLists(),
+			options: domain.ImportOptions{Mode: domain.ImportMerge},
+			prepareMocks: func(lists *dbMocks.MockListsRepository, tasks *dbMocks.MockTasksRepository) {
+				expectExisting(lists, tasks)
+				lists.EXPECT().Update(mock.Anything, mock.Anything, domain.List{ID: existingList.ID, UserID: userID, WorkspaceID: workspaceID, Name: "renamed"}).
+					Return(nil).
+					Once()
+				tasks.EXPECT().Update(mock.Anything, mock.Anything, userID, workspaceID, existingTask).
+					Return(nil).
+					Once()
+				tasks.EXPECT().Create(mock.Anything, mock.Anything, userID, workspaceID, mock.MatchedBy(func(task domain.Task) bool {
+					return task.ID == newTask.ID && task.ListID == existingList.ID && task.Priority == domain.Normal
+				})).
+					Return(nil).
+					Once()
+			},
+			check: func(t *testing.T, report domain.ImportReport, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, report.ListsUpdated)
+				require.Equal(t, 1, report.TasksUpdated)
+				require.Equal(t, 1, report.TasksCreated)
+			},
+		},
+		{
+			name:    "Replace with remapped IDs",
+			lists:   importedLists(),
+			options: domain.ImportOptions{Mode: domain.ImportReplace, RemapIDs: true},
+			prepareMocks: func(lists *dbMocks.MockListsRepository, tasks *dbMocks.MockTasksRepository) {
+				expectExisting(lists, tasks)
+				lists.EXPECT().Delete(mock.Anything, mock.Anything, userID, workspaceID, existingList.ID).
+					Return(nil).
+					Once()
+				lists.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(list domain.List) bool {
+					return list.ID != existingList.ID && list.UserID == userID
+				})).
+					Return(nil).
+					Once()
+				tasks.EXPECT().Create(mock.Anything, mock.Anything, userID, workspaceID, mock.MatchedBy(func(task domain.Task) bool {
+					return task.ID != existingTask.ID && task.ID != newTask.ID && task.ListID != existingList.ID
+				})).
+					Return(nil).
+					Twice()
+			},
+			check: func(t *testing.T, report domain.ImportReport, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, report.ListsDeleted)
+				require.Equal(t, 1, report.TasksDeleted)
+				require.Equal(t, 1, report.ListsCreated)
+				require.Equal(t, 2, report.TasksCreated)
+				require.Len(t, report.IDs, 3)
+				require.Contains(t, report.IDs, existingList.ID)
+			},
+		},
+		{
+			name:    "Dry run",
+			lists:   []domain.List{{ID: domain.ListID(uuid.New()), Name: "new list"}},
+			options: domain.ImportOptions{DryRun: true},
+			prepareMocks: func(lists *dbMocks.MockListsRepository, tasks *dbMocks.MockTasksRepository) {
+				expectExisting(lists, tasks)
+				lists.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+			},
+			check: func(t *testing.T, report domain.ImportReport, err error) {
+				require.NoError(t, err)
+				require.True(t, report.DryRun)
+				require.Equal(t, 1, report.ListsCreated)
+			},
+		},
+		{
+			name:    "Failed - repository error",
+			lists:   importedLists(),
+			options: domain.ImportOptions{Mode: domain.ImportMerge},
+			prepareMocks: func(lists *dbMocks.MockListsRepository, tasks *dbMocks.MockTasksRepository) {
+				expectExisting(lists, tasks)
+				lists.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.ImportReport, err error) {
+				require.ErrorIs(t, err, domain.ErrTransferServiceImport)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:    "Failed - unknown mode",
+			lists:   importedLists(),
+			options: domain.ImportOptions{Mode: "append"},
+			check: func(t *testing.T, _ domain.ImportReport, err error) {
+				require.ErrorIs(t, err, domain.ErrTransferServiceImportArg)
+			},
+		},
+		{
+			name:    "Failed - missing ID without remapping",
+			lists:   []domain.List{{Name: "no id"}},
+			options: domain.ImportOptions{Mode: domain.ImportMerge},
+			check: func(t *testing.T, _ domain.ImportReport, err error) {
+				require.ErrorIs(t, err, domain.ErrTransferServiceImportArg)
+				require.ErrorContains(t, err, "has no id")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			listRepo, taskRepo := dbMocks.NewMockListsRepository(t), dbMocks.NewMockTasksRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(listRepo, taskRepo)
+			}
+
+			report, err := domain.NewTransferService(provider, listRepo, taskRepo).
+				Import(context.Background(), userID, workspaceID, test.lists, test.options)
+
+			test.check(t, report, err)
+		})
+	}
+}
+
+func TestTransferExportUnit(t *testing.T) {
+	userID, workspaceID := domain.UserID(uuid.New()), domain.WorkspaceID(uuid.New())
+	owned := domain.List{ID: domain.ListID(uuid.New()), UserID: userID, Name: "owned"}
+	shared := domain.List{ID: domain.ListID(uuid.New()), UserID: domain.UserID(uuid.New()), Name: "shared"}
+	task := domain.Task{ID: domain.TaskID(uuid.New()), ListID: owned.ID, Name: "task"}
+
+	provider := newFakeProvider(dbMocks.NewMockConnection(t))
+	listRepo, taskRepo := dbMocks.NewMockListsRepository(t), dbMocks.NewMockTasksRepository(t)
+	listRepo.EXPECT().ReadAll(mock.Anything, mock.Anything, userID, workspaceID).
+		Return([]domain.List{owned, shared}, nil).
+		Once()
+	// The tasks are read for each list on its own, shared lists are skipped.
+	taskRepo.EXPECT().GetAllTasks(mock.Anything, mock.Anything, userID, workspaceID, []domain.ListID{owned.ID}).
+		Return([]domain.Task{task}, nil).
+		Once()
+
+	var written []domain.List
+	err := domain.NewTransferService(provider, listRepo, taskRepo).
+		Export(context.Background(), userID, workspaceID, func(list domain.List) error {
+			written = append(written, list)
+
+			return nil
+		})
+
+	require.NoError(t, err)
+	require.Len(t, written, 1)
+	require.Equal(t, owned.ID, written[0].ID)
+	require.Equal(t, []domain.Task{task}, written[0].Tasks)
+
+	t.Run("Write Error", func(t *testing.T) {
+		listRepo.EXPECT().ReadAll(mock.Anything, mock.Anything, userID, workspaceID).Return([]domain.List{owned}, nil).Once()
+		taskRepo.EXPECT().GetAllTasks(mock.Anything, mock.Anything, userID, workspaceID, []domain.ListID{owned.ID}).Return(nil, nil).Once()
+
+		err := domain.NewTransferService(provider, listRepo, taskRepo).
+			Export(context.Background(), userID, workspaceID, func(domain.List) error { return errors.New("some error") })
+
+		require.ErrorIs(t, err, domain.ErrTransferServiceExport)
+		require.ErrorContains(t, err, "some error")
+	})
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// LoginChallengeTTL is how long the user has to enter the code after
+	// the password.
+	LoginChallengeTTL = 5 * time.Minute
+
+	recoveryCodeCount = 10
+	recoveryCodeSize  = 5
+)
+
+var (
+	_ TwoFactorInterface = (*TwoFactorService)(nil)
+)
+
+var (
+	errTwoFactorService            = errors.New("two-factor service error")
+	ErrTwoFactorServiceEnroll      = errors.Join(errTwoFactorService, errors.New("enroll failed"))
+	ErrTwoFactorServiceConfirm     = errors.Join(errTwoFactorService, errors.New("confirm failed"))
+	ErrTwoFactorServiceDisable     = errors.Join(errTwoFactorService, errors.New("disable failed"))
+	ErrTwoFactorServiceEnabled     = errors.Join(ErrTwoFactorServiceEnroll, errors.New("already enabled"))
+	ErrTwoFactorServiceInvalidCode = errors.Join(errTwoFactorService, errors.New("invalid code"))
+)
+
+type TwoFactorService struct {
+	provider      ConnectionProvider
+	userRepo      UsersRepository
+	twoFactorRepo TwoFactorRepository
+	issuer        string
+}
+
+// NewTwoFactorService names the account after the issuer in authenticator
+// apps.
+func NewTwoFactorService(provider ConnectionProvider, userRepo UsersRepository, twoFactorRepo TwoFactorRepository,
+	issuer string,
+) *TwoFactorService {
+	return &TwoFactorService{
+		provider:      provider,
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		issuer:        issuer,
+	}
+}
+
+// Close implements TwoFactorInterface.
+func (s *TwoFactorService) Close() error {
+	return s.provider.Close()
+}
+
+// Enroll implements TwoFactorInterface.
+func (s *TwoFactorService) Enroll(ctx context.Context, user User) (TwoFactorEnrollment, error) {
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return TwoFactorEnrollment{}, errors.Join(ErrTwoFactorServiceEnroll, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		twoFactor, err := s.twoFactorRepo.Read(ctx, connection, user.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if twoFactor.EnabledAt != nil {
+			return ErrTwoFactorServiceEnabled
+		}
+
+		return s.twoFactorRepo.Create(ctx, connection, TwoFactor{UserID: user.ID, Secret: secret})
+	})
+	if err != nil {
+		return TwoFactorEnrollment{}, errors.Join(ErrTwoFactorServiceEnroll, err)
+	}
+
+	return TwoFactorEnrollment{Secret: secret, URI: totpURI(s.issuer, user.Email, secret)}, nil
+}
+
+// Confirm implements TwoFactorInterface.
+func (s *TwoFactorService) Confirm(ctx context.Context, userID UserID, code string) ([]string, error) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, errors.Join(ErrTwoFactorServiceConfirm, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		twoFactor, err := s.twoFactorRepo.Read(ctx, connection, userID)
+		if err != nil {
+			return err
+		}
+		if twoFactor.EnabledAt != nil {
+			return ErrTwoFactorServiceEnabled
+		}
+
+		counter, ok := matchTOTP(twoFactor.Secret, code, time.Now())
+		if !ok {
+			return ErrTwoFactorServiceInvalidCode
+		}
+
+		if err = s.twoFactorRepo.Enable(ctx, connection, userID, counter); err != nil {
+			return err
+		}
+
+		return s.twoFactorRepo.CreateRecoveryCodes(ctx, connection, userID, hashes)
+	})
+	if err != nil {
+		return nil, errors.Join(ErrTwoFactorServiceConfirm, err)
+	}
+
+	return codes, nil
+}
+
+// Disable implements TwoFactorInterface. It reads the password hash again,
+// users authenticated by a JWT come without one.
+func (s *TwoFactorService) Disable(ctx context.Context, user User, password string, code string) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		stored, err := s.userRepo.ReadByID(ctx, connection, user.ID)
+		if err != nil {
+			return err
+		}
+		if err = bcrypt.CompareHashAndPassword([]byte(stored.PasswordHash), []byte(password)); err != nil {
+			return errors.Join(ErrToDoServiceInvalidPasswordUser, err)
+		}
+
+		if err := checkTwoFactorCode(ctx, connection, s.twoFactorRepo, user.ID, code); err != nil {
+			return err
+		}
+
+		return s.twoFactorRepo.Delete(ctx, connection, user.ID)
+	})
+	if err != nil {
+		return errors.Join(ErrTwoFactorServiceDisable, err)
+	}
+
+	return nil
+}
+
+// checkTwoFactorCode accepts a TOTP code or a recovery code of the user,
+// each only once.
+func checkTwoFactorCode(ctx context.Context, connection Connection, repo TwoFactorRepository, userID UserID, code string) error {
+	twoFactor, err := repo.Read(ctx, connection, userID)
+	if err != nil {
+		return err
+	}
+	if twoFactor.EnabledAt == nil {
+		return errors.Join(ErrTwoFactorServiceInvalidCode, errors.New("two-factor authentication is off"))
+	}
+
+	code = strings.TrimSpace(code)
+	if counter, ok := matchTOTP(twoFactor.Secret, code, time.Now()); ok {
+		if err = repo.UseCounter(ctx, connection, userID, counter); err != nil {
+			return errors.Join(ErrTwoFactorServiceInvalidCode, err)
+		}
+
+		return nil
+	}
+
+	if err = repo.UseRecoveryCode(ctx, connection, userID, hashRecoveryCode(code)); err != nil {
+		return errors.Join(ErrTwoFactorServiceInvalidCode, err)
+	}
+
+	return nil
+}
+
+// createLoginChallenge returns a challenge for LoginTwoFactor when the user
+// has two-factor authentication enabled and an empty one otherwise.
+func createLoginChallenge(ctx context.Context, connection Connection, repo TwoFactorRepository, userID UserID) (string, error) {
+	twoFactor, err := repo.Read(ctx, connection, userID)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && twoFactor.EnabledAt == nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	challenge, err := newChallengeToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = repo.CreateChallenge(ctx, connection, LoginChallenge{
+		TokenHash: hashToken(challenge),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(LoginChallengeTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return challenge, nil
+}
+
+// newRecoveryCodes returns the codes, formatted as 01234-56789, and their
+// hashes.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes, hashes := make([]string, recoveryCodeCount), make([]string, recoveryCodeCount)
+	for i := range codes {
+		code := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(code); err != nil {
+			return nil, nil, err
+		}
+
+		text := hex.EncodeToString(code)
+		codes[i] = text[:recoveryCodeSize] + "-" + text[recoveryCodeSize:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case and dashes, which users tend to get wrong.
+func hashRecoveryCode(code string) string {
+	return hashToken(strings.ToLower(strings.ReplaceAll(code, "-", "")))
+}
+
+func newChallengeToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
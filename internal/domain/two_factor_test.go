@@ -0,0 +1,194 @@
+package domain_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// rfcSecret is the SHA1 key of the RFC 6238 test vectors in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTP(t *testing.T) {
+	// The last six digits of the RFC 6238 SHA1 test vectors.
+	for unix, want := range map[int64]string{59: "287082", 1111111109: "081804", 1234567890: "005924", 2000000000: "279037"} {
+		code, err := domain.TOTPCode(rfcSecret, unix/30)
+		require.NoError(t, err)
+		require.Equal(t, want, code, unix)
+	}
+
+	now := time.Unix(1111111109, 0)
+	counter, ok := domain.MatchTOTP(rfcSecret, "081804", now.Add(30*time.Second))
+	require.True(t, ok)
+	require.Equal(t, int64(1111111109/30), counter)
+
+	_, ok = domain.MatchTOTP(rfcSecret, "081804", now.Add(90*time.Second))
+	require.False(t, ok)
+	_, ok = domain.MatchTOTP(rfcSecret, "81804", now)
+	require.False(t, ok)
+}
+
+func TestTwoFactorUnit(t *testing.T) {
+	ctx := context.Background()
+	passwordHash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "ann@email.foo", PasswordHash: string(passwordHash)}
+	enabledAt := time.Now()
+	currentCode := func(t *testing.T) string {
+		code, err := domain.TOTPCode(rfcSecret, time.Now().Unix()/30)
+		require.NoError(t, err)
+
+		return code
+	}
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *domain.TwoFactorService, *dbMocks.MockTwoFactorRepository, *dbMocks.MockUsersRepository)
+	}{
+		{
+			name: "Enroll",
+			check: func(t *testing.T, service *domain.TwoFactorService, repo *dbMocks.MockTwoFactorRepository, users *dbMocks.MockUsersRepository) {
+				repo.EXPECT().Read(mock.Anything, mock.Anything, user.ID).Return(domain.TwoFactor{}, sql.ErrNoRows).Once()
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(twoFactor domain.TwoFactor) bool {
+					return twoFactor.UserID == user.ID && len(twoFactor.Secret) == 32
+				})).Return(nil).Once()
+
+				enrollment, err := service.Enroll(ctx, user)
+
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/To-do:ann@email.foo?"), enrollment.URI)
+				require.Contains(t, enrollment.URI, "secret="+enrollment.Secret)
+			},
+		},
+		{
+			name: "Enroll Enabled",
+			check: func(t *testing.T, service *domain.TwoFactorService, repo *dbMocks.MockTwoFactorRepository, users *dbMocks.MockUsersRepository) {
+				repo.EXPECT().Read(mock.Anything, mock.Anything, user.ID).
+					Return(domain.TwoFactor{UserID: user.ID, Secret: rfcSecret, EnabledAt: &enabledAt}, nil).Once()
+
+				_, err := service.Enroll(ctx, user)
+
+				require.ErrorIs(t, err, domain.ErrTwoFactorServiceEnabled)
+			},
+		},
+		{
+			name: "Confirm",
+			check: func(t *testing.T, service *domain.TwoFactorService, repo *dbMocks.MockTwoFactorRepository, users *dbMocks.MockUsersRepository) {
+				repo.EXPECT().Read(mock.Anything, mock.Anything, user.ID).
+					Return(domain.TwoFactor{UserID: user.ID, Secret: rfcSecret}, nil).Once()
+				repo.EXPECT().Enable(mock.Anything, mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+				repo.EXPECT().CreateRecoveryCodes(mock.Anything, mock.Anything, user.ID, mock.MatchedBy(func(hashes []string) bool {
+					return len(hashes) == 10
+				})).Return(nil).Once()
+
+				codes, err := service.Confirm(ctx, user.ID, currentCode(t))
+
+				require.NoError(t, err)
+				require.Len(t, codes, 10)
+				require.Regexp(t, `^[0-9a-f]{5}-[0-9a-f]{5}$`, codes[0])
+			},
+		},
+		{
+			name: "Confirm Invalid Code",
+			check: func(t *testing.T, service *domain.TwoFactorService, repo *dbMocks.MockTwoFactorRepository, users *dbMocks.MockUsersRepository) {
+				repo.EXPECT().Read(mock.Anything, mock.Anything, user.ID).
+					Return(domain.TwoFactor{UserID: user.ID, Secret: rfcSecret}, nil).Once()
+
+				_, err := service.Confirm(ctx, user.ID, "not a code")
+
+				require.ErrorIs(t, err, domain.ErrTwoFactorServiceInvalidCode)
+			},
+		},
+		{
+			name: "Disable With Recovery Code",
+			check: func(t *testing.T, service *domain.TwoFactorService, repo *dbMocks.MockTwoFactorRepository, users *dbMocks.MockUsersRepository) {
+				var hashes []string
+				users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Twice()
+				repo.EXPECT().Read(mock.Anything, mock.Anything, user.ID).
+					Return(domain.TwoFactor{UserID: user.ID, Secret: rfcSecret, EnabledAt: &enabledAt}, nil).Twice()
+				repo.EXPECT().UseRecoveryCode(mock.Anything, mock.Anything, user.ID, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, _ domain.UserID, hash string) {
+						hashes = append(hashes, hash)
+					}).Return(nil).Twice()
+				repo.EXPECT().Delete(mock.Anything, mock.Anything, user.ID).Return(nil).Twice()
+
+				// Users authenticated by a JWT come without the password hash.
+				jwtUser := domain.User{ID: user.ID, Email: user.Email}
+				require.NoError(t, service.Disable(ctx, jwtUser, "secret", "ABCDE-01234"))
+				require.NoError(t, service.Disable(ctx, jwtUser, "secret", "abcde01234"))
+
+				require.Len(t, hashes, 2)
+				require.Equal(t, hashes[0], hashes[1])
+			},
+		},
+		{
+			name: "Disable Wrong Password",
+			check: func(t *testing.T, service *domain.TwoFactorService, repo *dbMocks.MockTwoFactorRepository, users *dbMocks.MockUsersRepository) {
+				users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+
+				err := service.Disable(ctx, user, "wrong", currentCode(t))
+
+				require.ErrorIs(t, err, domain.ErrTwoFactorServiceDisable)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+			users, repo := dbMocks.NewMockUsersRepository(t), dbMocks.NewMockTwoFactorRepository(t)
+
+			test.check(t, domain.NewTwoFactorService(provider, users, repo, "To-do"), repo, users)
+		})
+	}
+}
+
+func TestLoginTwoFactorUnit(t *testing.T) {
+	ctx := context.Background()
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "ann@email.foo"}
+	enabledAt := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		users, repo := dbMocks.NewMockUsersRepository(t), dbMocks.NewMockTwoFactorRepository(t)
+		repo.EXPECT().UseChallenge(mock.Anything, mock.Anything, mock.Anything).Return(user.ID, nil).Once()
+		users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		repo.EXPECT().Read(mock.Anything, mock.Anything, user.ID).
+			Return(domain.TwoFactor{UserID: user.ID, Secret: rfcSecret, EnabledAt: &enabledAt}, nil).Once()
+		repo.EXPECT().UseCounter(mock.Anything, mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+
+		code, err := domain.TOTPCode(rfcSecret, time.Now().Unix()/30)
+		require.NoError(t, err)
+
+		loggedIn, err := domain.NewUserService(provider, users, repo, dbMocks.NewMockWorkspacesRepository(t)).LoginTwoFactor(ctx, "challenge", code)
+
+		require.NoError(t, err)
+		require.Equal(t, user, loggedIn)
+	})
+
+	t.Run("Used Code", func(t *testing.T) {
+		provider := newFakeProvider(dbMocks.NewMockConnection(t))
+		users, repo := dbMocks.NewMockUsersRepository(t), dbMocks.NewMockTwoFactorRepository(t)
+		repo.EXPECT().UseChallenge(mock.Anything, mock.Anything, mock.Anything).Return(user.ID, nil).Once()
+		users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+		repo.EXPECT().Read(mock.Anything, mock.Anything, user.ID).
+			Return(domain.TwoFactor{UserID: user.ID, Secret: rfcSecret, EnabledAt: &enabledAt}, nil).Once()
+		repo.EXPECT().UseCounter(mock.Anything, mock.Anything, user.ID, mock.Anything).Return(errors.New("code already used")).Once()
+
+		code, err := domain.TOTPCode(rfcSecret, time.Now().Unix()/30)
+		require.NoError(t, err)
+
+		_, err = domain.NewUserService(provider, users, repo, dbMocks.NewMockWorkspacesRepository(t)).LoginTwoFactor(ctx, "challenge", code)
+
+		require.ErrorIs(t, err, domain.ErrTwoFactorServiceInvalidCode)
+	})
+}
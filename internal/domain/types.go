@@ -17,28 +17,505 @@ type (
 		Email        string
 		PasswordHash string
 		Token        string
+		TimeZone     string
+		Locale       string
+		// VerifiedAt is when the user confirmed owning Email, nil until then.
+		VerifiedAt *time.Time
+		Role       Role
+		// DisabledAt is when an admin disabled the user, nil while the user
+		// may sign in.
+		DisabledAt *time.Time
+	}
+
+	Preferences struct {
+		// TimeZone is an IANA name such as "Europe/Moscow".
+		TimeZone string `json:"time_zone"`
+		Locale   string `json:"locale"`
 	}
 
 	ListID = uuid.UUID
 
 	List struct {
-		ID        ListID    `json:"id"`
-		UserID    UserID    `json:"user_id,omitempty"`
-		Name      string    `json:"name"`
-		UpdatedAt time.Time `json:"updated_at,omitempty"`
-		Tasks     []Task    `json:"tasks,omitempty"`
+		ID          ListID      `json:"id"`
+		UserID      UserID      `json:"user_id,omitempty"`
+		WorkspaceID WorkspaceID `json:"workspace_id,omitempty"`
+		Name        string      `json:"name"`
+		UpdatedAt   time.Time   `json:"updated_at,omitempty"`
+		Tasks       []Task      `json:"tasks,omitempty"`
 	}
 
 	TaskID = uuid.UUID
 
+	// Task with AllDay set has a date-only deadline. Its Deadline is midnight
+	// UTC of the date, which is due in whatever zone the user is in.
 	Task struct {
 		ID        TaskID     `json:"id"`
 		ListID    ListID     `json:"list_id"`
 		Priority  Priority   `json:"priority"`
 		Deadline  *time.Time `json:"deadline"`
+		AllDay    bool       `json:"all_day,omitempty"`
 		Done      bool       `json:"done,omitempty"`
 		Name      string     `json:"name"`
 		UpdatedAT time.Time  `json:"updated_at,omitempty"`
+
+		// CreatedAt and CompletedAt are read-only. CompletedAt is set when
+		// the task is marked done and cleared when it is reopened.
+		CreatedAt   time.Time  `json:"created_at,omitempty"`
+		CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+		// Tags work like Assignees: nil keeps the current ones when saving.
+		Tags []string `json:"tags"`
+
+		// Recurrence is an RFC 5545 RRULE value such as FREQ=WEEKLY;BYDAY=MO.
+		Recurrence string `json:"recurrence,omitempty"`
+
+		// DAVName and ICalUID are the object name and the iCalendar UID a
+		// CalDAV client created the task with, empty for other tasks, which
+		// use the ID for both. Empty ones keep the current ones when saving.
+		DAVName string `json:"-" db:"dav_name"`
+		ICalUID string `json:"-" db:"ical_uid"`
+
+		// Assignees must have access to the list. A nil slice keeps the
+		// current assignees when saving, an empty one removes them all.
+		Assignees []UserID `json:"assignees"`
+
+		// CommentCount is read-only, it is ignored when saving a task.
+		CommentCount int `json:"comment_count"`
+	}
+
+	// Member is a user with access to a list, either its owner or someone
+	// the owner shared it with.
+	Member struct {
+		ID    UserID `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+		Owner bool   `json:"owner"`
+	}
+
+	WorkspaceID = uuid.UUID
+
+	// Workspace owns lists and smart lists, users see them only while
+	// working in it. Lists are private to their owner and the members they
+	// are shared with, except that workspace owners see every list.
+	Workspace struct {
+		ID   WorkspaceID `json:"id"`
+		Name string      `json:"name"`
+		// Personal is the workspace of a single user, see
+		// PersonalWorkspace.
+		Personal bool `json:"personal"`
+		// Role is the role of the user who read the workspace.
+		Role      WorkspaceRole `json:"role"`
+		CreatedAt time.Time     `json:"created_at"`
+	}
+
+	WorkspaceMember struct {
+		ID    UserID        `json:"id"`
+		Name  string        `json:"name"`
+		Email string        `json:"email"`
+		Role  WorkspaceRole `json:"role"`
+	}
+
+	WorkspaceInvitationID = uuid.UUID
+
+	// WorkspaceInvitation lets the user with Email join the workspace once,
+	// until it expires. Only a hash of the mailed token is stored.
+	WorkspaceInvitation struct {
+		ID          WorkspaceInvitationID `json:"id"`
+		WorkspaceID WorkspaceID           `json:"workspace_id"`
+		Email       string                `json:"email"`
+		TokenHash   string                `json:"-"`
+		InvitedBy   *UserID               `json:"invited_by,omitempty"`
+		CreatedAt   time.Time             `json:"created_at"`
+		ExpiresAt   time.Time             `json:"expires_at"`
+	}
+
+	TaskAssigned struct {
+		TaskID     TaskID
+		ListID     ListID
+		AssigneeID UserID
+		AssignerID UserID
+		At         time.Time
+	}
+
+	// TaskEvents receives notifications about tasks after the change is
+	// committed. Implementations must not block for long.
+	TaskEvents interface {
+		TaskAssigned(context.Context, TaskAssigned)
+	}
+
+	CommentID = uuid.UUID
+
+	Comment struct {
+		ID        CommentID `json:"id"`
+		TaskID    TaskID    `json:"task_id"`
+		AuthorID  UserID    `json:"author_id"`
+		Body      string    `json:"body"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	Page struct {
+		Limit  int
+		Offset int
+	}
+
+	TaskFilter struct {
+		ListIDs     []ListID
+		Priorities  []Priority
+		AssigneeIDs []UserID
+		Done        *bool
+		HasDeadline bool
+		// DAVName selects the task a CalDAV client created with the name.
+		DAVName string
+
+		// Due selects tasks by deadline relative to Today.
+		Due   DueView
+		Today Day
+
+		// Query narrows the other criteria down further, see ParseQuery.
+		Query Query
+	}
+
+	// Query is a parsed filter query. Its nodes are the Query* types below.
+	Query interface {
+		query()
+	}
+
+	QueryAnd struct {
+		Left, Right Query
+	}
+
+	QueryOr struct {
+		Left, Right Query
+	}
+
+	QueryNot struct {
+		Query Query
+	}
+
+	QueryPriority struct {
+		Priority Priority
+	}
+
+	QueryDone struct {
+		Done bool
+	}
+
+	// QueryList matches the list by name, ignoring case.
+	QueryList struct {
+		Name string
+	}
+
+	QueryTag struct {
+		Tag string
+	}
+
+	// QueryName matches tasks whose name contains Text, ignoring case.
+	QueryName struct {
+		Text string
+	}
+
+	// QueryAssignee matches tasks assigned to the user running the query,
+	// or tasks without assignees when None is set.
+	QueryAssignee struct {
+		None bool
+	}
+
+	// QueryDue compares deadlines with the day using Op, one of "<", "<=",
+	// "=", ">=" and ">". A task due on the day is equal to it.
+	QueryDue struct {
+		Op  string
+		Day Day
+	}
+
+	QueryOverdue struct {
+		Today Day
+	}
+
+	QueryNoDeadline struct{}
+
+	// StatsRange is a range of whole days in the user's time zone, both
+	// ends included.
+	StatsRange struct {
+		From     Day
+		To       Day
+		Interval StatsInterval
+		// Today is used to count overdue tasks.
+		Today Day
+	}
+
+	Stats struct {
+		From     time.Time     `json:"from"`
+		To       time.Time     `json:"to"`
+		Interval StatsInterval `json:"interval"`
+		Periods  []StatsPeriod `json:"periods"`
+		Overdue  int           `json:"overdue"`
+		// AverageCompletionSeconds is the mean time from creation to
+		// completion of the tasks completed in the range, nil without any.
+		AverageCompletionSeconds *float64             `json:"average_completion_seconds"`
+		ByList                   []StatsListCount     `json:"by_list"`
+		ByPriority               []StatsPriorityCount `json:"by_priority"`
+	}
+
+	// StatsPeriod counts the tasks created and completed in the day or week
+	// starting at Start, a date at midnight UTC.
+	StatsPeriod struct {
+		Start     time.Time `json:"start"`
+		Created   int       `json:"created"`
+		Completed int       `json:"completed"`
+	}
+
+	StatsListCount struct {
+		ListID    ListID `json:"list_id"`
+		Name      string `json:"name"`
+		Created   int    `json:"created"`
+		Completed int    `json:"completed"`
+	}
+
+	StatsPriorityCount struct {
+		Priority  Priority `json:"priority"`
+		Created   int      `json:"created"`
+		Completed int      `json:"completed"`
+	}
+
+	// StatsGroup counts the tasks of a list with a priority, it is what
+	// ByList and ByPriority are summed from.
+	StatsGroup struct {
+		ListID    ListID
+		ListName  string
+		Priority  Priority
+		Created   int
+		Completed int
+	}
+
+	StatsSummary struct {
+		Overdue                  int
+		AverageCompletionSeconds *float64
+	}
+
+	SmartListID = uuid.UUID
+
+	// SmartList is a saved query, its tasks are found anew on every read.
+	SmartList struct {
+		ID          SmartListID `json:"id"`
+		UserID      UserID      `json:"user_id,omitempty"`
+		WorkspaceID WorkspaceID `json:"workspace_id,omitempty"`
+		Name        string      `json:"name"`
+		Query       string      `json:"query"`
+		UpdatedAt   time.Time   `json:"updated_at,omitempty"`
+	}
+
+	// Day is the current day as seen in some time zone.
+	Day struct {
+		// Date is the calendar date at midnight UTC, comparable with
+		// all-day deadlines.
+		Date time.Time
+		// Start and End are the instants the day begins and ends.
+		Start time.Time
+		End   time.Time
+		Now   time.Time
+	}
+
+	FeedTokenID = uuid.UUID
+
+	// FeedToken shows the tasks of the workspace it was created in.
+	FeedToken struct {
+		ID          FeedTokenID `json:"id"`
+		UserID      UserID      `json:"-"`
+		WorkspaceID WorkspaceID `json:"workspace_id"`
+		TokenHash   string      `json:"-"`
+		CreatedAt   time.Time   `json:"created_at"`
+		RevokedAt   *time.Time  `json:"revoked_at,omitempty"`
+	}
+
+	AccessTokenID = uuid.UUID
+
+	// AccessToken is a personal access token for scripts. Unlike the login
+	// token it stays the same until it expires or is revoked, and it only
+	// grants its scopes.
+	AccessToken struct {
+		ID        AccessTokenID `json:"id"`
+		UserID    UserID        `json:"-"`
+		Name      string        `json:"name"`
+		TokenHash string        `json:"-"`
+		Scopes    []Scope       `json:"scopes"`
+		CreatedAt time.Time     `json:"created_at"`
+		// ExpiresAt is nil for tokens that don't expire.
+		ExpiresAt *time.Time `json:"expires_at,omitempty"`
+		RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	}
+
+	// AccountDeletion is a deletion the user asked for. Until DeleteAt the
+	// user may cancel it, then the account and its data are removed.
+	AccountDeletion struct {
+		UserID      UserID    `json:"-"`
+		RequestedAt time.Time `json:"requested_at"`
+		DeleteAt    time.Time `json:"delete_at"`
+	}
+
+	DataExportID = uuid.UUID
+
+	DataExportStatus = string
+
+	// DataExport is a ZIP archive of the user's data. It is built in the
+	// background and downloaded through a mailed link until ExpiresAt, only a
+	// hash of the link's token is stored.
+	DataExport struct {
+		ID         DataExportID     `json:"id"`
+		UserID     UserID           `json:"-"`
+		Status     DataExportStatus `json:"status"`
+		StorageKey string           `json:"-"`
+		Size       int64            `json:"size,omitempty"`
+		TokenHash  string           `json:"-"`
+		CreatedAt  time.Time        `json:"created_at"`
+		ExpiresAt  *time.Time       `json:"expires_at,omitempty"`
+	}
+
+	PasswordResetID = uuid.UUID
+
+	// PasswordReset lets the user who requested it set a new password once,
+	// until it expires. Only a hash of the mailed token is stored.
+	PasswordReset struct {
+		ID        PasswordResetID
+		UserID    UserID
+		TokenHash string
+		CreatedAt time.Time
+		ExpiresAt time.Time
+		UsedAt    *time.Time
+	}
+
+	// TwoFactor holds the TOTP secret of a user. It protects logins once
+	// enabled, which happens when the user confirms a code.
+	TwoFactor struct {
+		UserID    UserID
+		Secret    string
+		EnabledAt *time.Time
+		// LastCounter is the time step of the last accepted code, so that a
+		// code can't be used twice.
+		LastCounter int64
+	}
+
+	// LoginChallenge is the single-use proof that the user passed the
+	// password step of a login. Only a hash of its token is stored.
+	LoginChallenge struct {
+		TokenHash string
+		UserID    UserID
+		ExpiresAt time.Time
+	}
+
+	// TwoFactorEnrollment is what an authenticator app needs to generate
+	// codes.
+	TwoFactorEnrollment struct {
+		Secret string `json:"secret"`
+		URI    string `json:"uri"`
+	}
+
+	// Identity is what an OpenID Connect provider asserts about the user
+	// who signed in. Issuer and Subject identify the user for good, the
+	// email may change.
+	Identity struct {
+		Issuer        string
+		Subject       string
+		Email         string
+		EmailVerified bool
+		Name          string
+	}
+
+	// OIDCLogin is a sign-in started with the provider, found again by the
+	// state the provider sends back.
+	OIDCLogin struct {
+		StateHash string
+		Verifier  string
+		Nonce     string
+		ExpiresAt time.Time
+	}
+
+	// IdentityProvider signs users in with the authorization code flow and
+	// PKCE.
+	IdentityProvider interface {
+		// AuthCodeURL is where the browser signs in, challenge is the S256
+		// hash of the PKCE verifier.
+		AuthCodeURL(state, nonce, challenge string) string
+		// Exchange redeems the code and returns the identity of the
+		// validated ID token, which must carry the nonce.
+		Exchange(ctx context.Context, code, verifier, nonce string) (Identity, error)
+	}
+
+	AttachmentID = uuid.UUID
+
+	Attachment struct {
+		ID          AttachmentID `json:"id"`
+		TaskID      TaskID       `json:"task_id"`
+		UserID      UserID       `json:"-"`
+		Name        string       `json:"name"`
+		ContentType string       `json:"content_type"`
+		Size        int64        `json:"size"`
+		StorageKey  string       `json:"-"`
+		CreatedAt   time.Time    `json:"created_at"`
+	}
+
+	BlobStore interface {
+		Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+		Get(ctx context.Context, key string) (io.ReadCloser, error)
+		Delete(ctx context.Context, key string) error
+	}
+
+	Mail struct {
+		To      string
+		Subject string
+		Body    string
+	}
+
+	Mailer interface {
+		Send(context.Context, Mail) error
+	}
+
+	// JWTKeyRing signs short-lived access tokens that are verified without
+	// reading the user, with keys it rotates.
+	JWTKeyRing interface {
+		// Issue signs a token for the user and returns it with its expiry.
+		Issue(ctx context.Context, user User) (token string, expiresAt time.Time, err error)
+		// Verify checks the signature, the expiry and the denylist and
+		// returns the user as it was when the token was issued.
+		Verify(ctx context.Context, token string) (User, error)
+		// Revoke denies the token until it expires.
+		Revoke(ctx context.Context, token string) error
+		// RevokeUser denies every token issued to the user so far.
+		RevokeUser(ctx context.Context, userID UserID) error
+		// JWKS returns the public keys as a JSON Web Key Set.
+		JWKS() ([]byte, error)
+	}
+
+	// RateLimit is a token bucket holding up to Burst tokens, refilled at
+	// Rate tokens a second. Every request takes a token.
+	RateLimit struct {
+		Rate  float64
+		Burst int
+	}
+
+	// Lockout locks a key out for Delay once it failed Threshold times in a
+	// row, doubling the delay with every further failure up to MaxDelay.
+	// Failures are forgotten after Reset without any.
+	Lockout struct {
+		Threshold int
+		Delay     time.Duration
+		MaxDelay  time.Duration
+		Reset     time.Duration
+	}
+
+	RateLimitStore interface {
+		// Take takes a token from the bucket of the key. When there is none
+		// it returns how long until there is.
+		Take(ctx context.Context, key string, limit RateLimit) (time.Duration, error)
+		// Fail counts a failure of the key and returns how long the key is
+		// locked out for now.
+		Fail(ctx context.Context, key string, lockout Lockout) (time.Duration, error)
+		// Locked returns how long the key is still locked out for.
+		Locked(ctx context.Context, key string) (time.Duration, error)
+		// Clear forgets the failures of the key.
+		Clear(ctx context.Context, key string) error
+		// Sweep forgets the keys unused since before.
+		Sweep(ctx context.Context, before time.Time) (int, error)
 	}
 
 	Connection interface {
@@ -47,6 +524,8 @@ type (
 		ExecContext(context.Context, string, ...any) (int64, error)
 	}
 
+	// ConnectionProvider runs the receivers on behalf of the user of the
+	// context, see WithAuthUser.
 	ConnectionProvider interface {
 		Execute(context.Context, func(context.Context, Connection) error) error
 		ExecuteTx(context.Context, func(context.Context, Connection) error) error
@@ -56,28 +535,356 @@ type (
 	UserInterface interface {
 		RegisterUser(ctx context.Context, name, email, passwordHash, token string) error
 		Authenticate(ctx context.Context, token string) (User, error)
-		Login(ctx context.Context, email, password string) error
+		// AuthenticatePassword checks the credentials without issuing a new
+		// token, for clients such as CalDAV that send them with every
+		// request. It refuses users with two-factor authentication enabled.
+		AuthenticatePassword(ctx context.Context, email, password string) (User, error)
+		// Login checks the password. With two-factor authentication enabled
+		// it returns a challenge for LoginTwoFactor and the user isn't logged
+		// in yet.
+		Login(ctx context.Context, email, password string) (challenge string, err error)
+		// LoginTwoFactor completes the login the challenge was issued for with
+		// a TOTP or recovery code.
+		LoginTwoFactor(ctx context.Context, challenge, code string) (User, error)
+		// ChallengeEmail returns the email of the user the challenge was
+		// issued to without using it up, so failed codes can be counted
+		// against the account before LoginTwoFactor checks one.
+		ChallengeEmail(ctx context.Context, challenge string) (string, error)
 		UpdateToken(ctx context.Context, email, token string) error
+		UpdatePreferences(context.Context, UserID, Preferences) error
+
+		io.Closer
+	}
+
+	PasswordResetInterface interface {
+		// Forgot mails a link with the token to the user with the email.
+		// Unknown emails are ignored, so callers can't probe for accounts.
+		Forgot(ctx context.Context, email, token string) error
+		// Reset sets the password and replaces the user's token, signing out
+		// every client.
+		Reset(ctx context.Context, token, passwordHash, newToken string) error
+
+		io.Closer
+	}
+
+	TwoFactorInterface interface {
+		// Enroll makes a new secret for the user, replacing any unconfirmed
+		// one.
+		Enroll(ctx context.Context, user User) (TwoFactorEnrollment, error)
+		// Confirm enables two-factor authentication when the code matches
+		// the secret and returns the recovery codes, which are shown once.
+		Confirm(ctx context.Context, userID UserID, code string) ([]string, error)
+		// Disable takes the password and a TOTP or recovery code.
+		Disable(ctx context.Context, user User, password, code string) error
+
+		io.Closer
+	}
+
+	OIDCInterface interface {
+		// Start begins a sign-in and returns the provider URL to send the
+		// browser to. The binding is a secret of the browser, Callback
+		// only accepts the state with the same binding.
+		Start(ctx context.Context, binding string) (authURL string, err error)
+		// Callback finishes the sign-in, linking the identity to a user by
+		// its verified email or registering a new one. Like Login it
+		// returns a challenge for LoginTwoFactor instead of setting the
+		// token when the user has two-factor authentication enabled.
+		Callback(ctx context.Context, state, code, binding, token string) (challenge string, err error)
+
+		io.Closer
+	}
+
+	EmailVerificationInterface interface {
+		// Send mails a signed verification link to the user with the email.
+		Send(ctx context.Context, email string) error
+		// Verify marks the email in the token verified.
+		Verify(ctx context.Context, token string) error
+
+		io.Closer
+	}
+
+	AccountDeletionInterface interface {
+		// Schedule checks the password, and the two-factor code when that is
+		// enabled, and schedules the deletion. Every client is signed out and
+		// the feed and access tokens are revoked.
+		Schedule(ctx context.Context, userID UserID, password, code, newToken string) (AccountDeletion, error)
+		Get(context.Context, UserID) (AccountDeletion, error)
+		Cancel(context.Context, UserID) error
+		// Purge deletes the accounts whose grace period is over and returns
+		// how many.
+		Purge(context.Context) (int, error)
+
+		io.Closer
+	}
+
+	DataExportInterface interface {
+		// Request queues an export, one at a time per user.
+		Request(context.Context, UserID) (DataExport, error)
+		GetAll(context.Context, UserID) ([]DataExport, error)
+		// Download opens the archive of the export with the mailed token.
+		Download(ctx context.Context, token string) (DataExport, io.ReadCloser, error)
+		// Process builds the queued exports and returns how many.
+		Process(context.Context) (int, error)
+		// Sweep removes the expired exports and returns how many.
+		Sweep(context.Context) (int, error)
+
+		io.Closer
+	}
+
+	AuditEntryID = uuid.UUID
+
+	// AuditEntry records an action of an admin. Entries outlive the users
+	// they mention.
+	AuditEntry struct {
+		ID      AuditEntryID `json:"id"`
+		AdminID UserID       `json:"admin_id"`
+		Action  AuditAction  `json:"action"`
+		// TargetID is the user acted on, nil for actions such as searches.
+		TargetID  *UserID   `json:"target_id"`
+		Details   string    `json:"details"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	// UserUsage is what a user stores.
+	UserUsage struct {
+		Lists           int   `json:"lists"`
+		Tasks           int   `json:"tasks"`
+		Comments        int   `json:"comments"`
+		Attachments     int   `json:"attachments"`
+		AttachmentBytes int64 `json:"attachment_bytes"`
+		AccessTokens    int   `json:"access_tokens"`
+	}
+
+	// AdminInterface operates the service on behalf of its users. Every
+	// method takes the acting admin and audits the action.
+	AdminInterface interface {
+		// SearchUsers finds users whose email or name contains query.
+		SearchUsers(ctx context.Context, adminID UserID, query string, page Page) ([]User, error)
+		GetUser(ctx context.Context, adminID, userID UserID) (User, error)
+		Usage(ctx context.Context, adminID, userID UserID) (UserUsage, error)
+		// Disable signs the user out, revokes their feed tokens and keeps
+		// them from signing in again.
+		Disable(ctx context.Context, adminID, userID UserID, newToken string) error
+		Enable(ctx context.Context, adminID, userID UserID) error
+		// Logout signs out every client of the user and revokes their
+		// access tokens.
+		Logout(ctx context.Context, adminID, userID UserID, newToken string) error
+		// ResetPassword mails the user a password reset link, the admin
+		// never learns the password.
+		ResetPassword(ctx context.Context, adminID, userID UserID, token string) error
+		SetRole(ctx context.Context, adminID, userID UserID, role Role) error
+		AuditLog(ctx context.Context, adminID UserID, page Page) ([]AuditEntry, error)
+
+		io.Closer
+	}
+
+	AccountInterface interface {
+		// Profile reads the user, the details in a JWT may be stale.
+		Profile(ctx context.Context, userID UserID) (User, error)
+		UpdateName(ctx context.Context, userID UserID, name string) error
+		// ChangePassword checks the current password and replaces the
+		// password and the token, signing out the other clients.
+		ChangePassword(ctx context.Context, userID UserID, password, passwordHash, token string) error
+		// RequestEmailChange checks the password and mails a signed link to
+		// the new email.
+		RequestEmailChange(ctx context.Context, userID UserID, password, email string) error
+		// ConfirmEmailChange sets the email in the token and replaces the
+		// user's token, signing out every client.
+		ConfirmEmailChange(ctx context.Context, token, newToken string) error
+
+		io.Closer
+	}
+
+	WorkspaceInterface interface {
+		// Create makes a workspace owned by the user.
+		Create(ctx context.Context, userID UserID, name string) (Workspace, error)
+		GetAll(context.Context, UserID) ([]Workspace, error)
+		// Get returns the workspace with the role of the user in it, or
+		// sql.ErrNoRows unless they are a member.
+		Get(context.Context, UserID, WorkspaceID) (Workspace, error)
+		Rename(ctx context.Context, userID UserID, workspaceID WorkspaceID, name string) error
+		// Delete removes the workspace with its lists, personal workspaces
+		// stay.
+		Delete(context.Context, UserID, WorkspaceID) error
+		GetMembers(context.Context, UserID, WorkspaceID) ([]WorkspaceMember, error)
+		// RemoveMember lets owners remove members and members leave, their
+		// lists stay in the workspace.
+		RemoveMember(ctx context.Context, userID UserID, workspaceID WorkspaceID, memberID UserID) error
+		// Invite mails the email a link with the token to join the
+		// workspace.
+		Invite(ctx context.Context, userID UserID, workspaceID WorkspaceID, email, token string) (WorkspaceInvitation, error)
+		GetInvitations(context.Context, UserID, WorkspaceID) ([]WorkspaceInvitation, error)
+		RevokeInvitation(ctx context.Context, userID UserID, workspaceID WorkspaceID, invitationID WorkspaceInvitationID) error
+		// Accept joins the user to the workspace of the invitation, which
+		// must have been sent to their email.
+		Accept(ctx context.Context, userID UserID, token string) (Workspace, error)
 
 		io.Closer
 	}
 
 	ListInterface interface {
 		Create(context.Context, List) error
-		GetAll(context.Context, UserID) ([]List, error)
+		GetAll(context.Context, UserID, WorkspaceID) ([]List, error)
 		Update(context.Context, List) error
-		Delete(context.Context, UserID, ListID) error
+		Delete(context.Context, UserID, WorkspaceID, ListID) error
+		GetMembers(context.Context, UserID, WorkspaceID, ListID) ([]Member, error)
+		AddMember(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID ListID, email string) error
+		RemoveMember(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID ListID, memberID UserID) error
 
 		io.Closer
 	}
 
 	TaskInterface interface {
-		Create(context.Context, UserID, Task) error
-		Update(context.Context, UserID, Task) error
-		Delete(context.Context, UserID, TaskID) error
+		Create(context.Context, UserID, WorkspaceID, Task) error
+		Read(context.Context, UserID, WorkspaceID, TaskID) (Task, error)
+		Find(context.Context, UserID, WorkspaceID, TaskFilter) ([]Task, error)
+		Update(context.Context, UserID, WorkspaceID, Task) error
+		Delete(context.Context, UserID, WorkspaceID, TaskID) error
+
+		io.Closer
+	}
+
+	CommentInterface interface {
+		Create(context.Context, UserID, WorkspaceID, Comment) (Comment, error)
+		GetAll(context.Context, UserID, WorkspaceID, TaskID, Page) ([]Comment, error)
+		Update(context.Context, UserID, WorkspaceID, Comment) (Comment, error)
+		Delete(context.Context, UserID, WorkspaceID, CommentID) error
+
+		io.Closer
+	}
+
+	SmartListInterface interface {
+		Create(context.Context, SmartList) error
+		GetAll(context.Context, UserID, WorkspaceID) ([]SmartList, error)
+		Update(context.Context, SmartList) error
+		Delete(context.Context, UserID, WorkspaceID, SmartListID) error
+		// GetTasks evaluates the smart list's query for the day.
+		GetTasks(ctx context.Context, userID UserID, workspaceID WorkspaceID, listID SmartListID, today Day) ([]Task, error)
+
+		io.Closer
+	}
+
+	StatsInterface interface {
+		Get(context.Context, UserID, WorkspaceID, StatsRange) (Stats, error)
 
 		io.Closer
 	}
+
+	ImportOptions struct {
+		Mode     ImportMode
+		RemapIDs bool
+		DryRun   bool
+	}
+
+	ImportReport struct {
+		DryRun       bool                    `json:"dry_run"`
+		ListsCreated int                     `json:"lists_created"`
+		ListsUpdated int                     `json:"lists_updated"`
+		ListsDeleted int                     `json:"lists_deleted"`
+		TasksCreated int                     `json:"tasks_created"`
+		TasksUpdated int                     `json:"tasks_updated"`
+		TasksDeleted int                     `json:"tasks_deleted"`
+		IDs          map[uuid.UUID]uuid.UUID `json:"ids,omitempty"`
+	}
+
+	FeedInterface interface {
+		// CreateToken makes a token for the tasks of the workspace.
+		CreateToken(ctx context.Context, userID UserID, workspaceID WorkspaceID, token string) (FeedToken, error)
+		// GetTokens and RevokeToken only see the tokens of the workspace.
+		GetTokens(context.Context, UserID, WorkspaceID) ([]FeedToken, error)
+		RevokeToken(context.Context, UserID, WorkspaceID, FeedTokenID) error
+		Tasks(ctx context.Context, token string, filter TaskFilter) ([]Task, error)
+
+		io.Closer
+	}
+
+	AccessTokenInterface interface {
+		// CreateToken stores the token with the user, name, scopes and
+		// expiry of accessToken.
+		CreateToken(ctx context.Context, accessToken AccessToken, token string) (AccessToken, error)
+		GetTokens(context.Context, UserID) ([]AccessToken, error)
+		RevokeToken(context.Context, UserID, AccessTokenID) error
+		// Authenticate returns the user of an unexpired, unrevoked token
+		// and the scopes it grants.
+		Authenticate(ctx context.Context, token string) (User, []Scope, error)
+
+		io.Closer
+	}
+
+	AttachmentInterface interface {
+		Upload(ctx context.Context, userID UserID, workspaceID WorkspaceID, attachment Attachment, r io.Reader) (Attachment, error)
+		GetAll(context.Context, UserID, WorkspaceID, TaskID) ([]Attachment, error)
+		Download(context.Context, UserID, WorkspaceID, AttachmentID) (Attachment, io.ReadCloser, error)
+		Delete(context.Context, UserID, WorkspaceID, AttachmentID) error
+		Sweep(context.Context) (int, error)
+
+		io.Closer
+	}
+
+	TransferInterface interface {
+		// Export passes the lists the user owns in the workspace to write
+		// one at a time, with their tasks. An error of write stops it.
+		Export(ctx context.Context, userID UserID, workspaceID WorkspaceID, write func(List) error) error
+		Import(context.Context, UserID, WorkspaceID, []List, ImportOptions) (ImportReport, error)
+
+		io.Closer
+	}
+
+	// HealthCheck is the outcome of one readiness check, Error is empty when
+	// it passed.
+	HealthCheck struct {
+		Name     string
+		Duration time.Duration
+		Error    string
+	}
+
+	HealthInterface interface {
+		// Check acquires a connection, runs a query and compares the
+		// schema version with SchemaVersion. The checks after a failed one
+		// are skipped and fail too.
+		Check(context.Context) []HealthCheck
+
+		io.Closer
+	}
+)
+
+// Statuses of a DataExport.
+const (
+	DataExportPending DataExportStatus = "pending"
+	DataExportRunning DataExportStatus = "running"
+	DataExportReady   DataExportStatus = "ready"
+	DataExportFailed  DataExportStatus = "failed"
+)
+
+// Role decides what a user may do beyond their own data.
+type Role = string
+
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+// AuditAction names what an admin did.
+type AuditAction = string
+
+const (
+	AuditSearchUsers   AuditAction = "search_users"
+	AuditGetUser       AuditAction = "get_user"
+	AuditUsage         AuditAction = "usage"
+	AuditDisable       AuditAction = "disable"
+	AuditEnable        AuditAction = "enable"
+	AuditLogout        AuditAction = "logout"
+	AuditResetPassword AuditAction = "reset_password"
+	AuditSetRole       AuditAction = "set_role"
+	AuditReadAuditLog  AuditAction = "read_audit_log"
+)
+
+// WorkspaceRole decides what a member may do in a workspace.
+type WorkspaceRole = string
+
+const (
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+	WorkspaceRoleMember WorkspaceRole = "member"
 )
 
 type Priority = string
@@ -87,3 +894,35 @@ const (
 	Normal Priority = "normal"
 	High   Priority = "high"
 )
+
+// Scope is what an access token may do.
+type Scope = string
+
+const (
+	ScopeListsRead  Scope = "lists:read"
+	ScopeListsWrite Scope = "lists:write"
+	ScopeTasksRead  Scope = "tasks:read"
+	ScopeTasksWrite Scope = "tasks:write"
+	ScopeFeedsAdmin Scope = "feeds:admin"
+)
+
+type StatsInterval = string
+
+const (
+	StatsDay  StatsInterval = "day"
+	StatsWeek StatsInterval = "week"
+)
+
+type DueView = string
+
+const (
+	DueToday   DueView = "today"
+	DueOverdue DueView = "overdue"
+)
+
+type ImportMode = string
+
+const (
+	ImportMerge   ImportMode = "merge"
+	ImportReplace ImportMode = "replace"
+)
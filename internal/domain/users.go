@@ -2,7 +2,11 @@ package domain
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -19,17 +23,28 @@ var (
 	ErrToDoServiceLoginUser           = errors.Join(errToDoService, errors.New("login user failed"))
 	ErrToDoServiceInvalidPasswordUser = errors.Join(ErrToDoServiceLoginUser, errors.New("invalid password email"))
 	ErrToDoServiceUpdateToken         = errors.Join(errToDoService, errors.New("update token failed"))
+	ErrUserServiceUpdatePreferences   = errors.Join(errToDoService, errors.New("update preferences failed"))
+	ErrUserServiceInvalidPreferences  = errors.Join(ErrUserServiceUpdatePreferences, errors.New("invalid preferences"))
+	ErrUserServiceLoginTwoFactor      = errors.Join(errToDoService, errors.New("two-factor login failed"))
+	ErrUserServiceDisabled            = errors.Join(errToDoService, errors.New("user disabled"))
+	ErrUserServiceTwoFactorRequired   = errors.Join(ErrToDoServiceLoginUser, errors.New("two-factor authentication enabled"))
 )
 
 type UserService struct {
-	provider ConnectionProvider
-	userRepo UsersRepository
+	provider      ConnectionProvider
+	userRepo      UsersRepository
+	twoFactorRepo TwoFactorRepository
+	workspaceRepo WorkspacesRepository
 }
 
-func NewUserService(provider ConnectionProvider, userRepo UsersRepository) *UserService {
+func NewUserService(provider ConnectionProvider, userRepo UsersRepository, twoFactorRepo TwoFactorRepository,
+	workspaceRepo WorkspacesRepository,
+) *UserService {
 	return &UserService{
-		provider: provider,
-		userRepo: userRepo,
+		provider:      provider,
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		workspaceRepo: workspaceRepo,
 	}
 }
 
@@ -37,9 +52,11 @@ func (s *UserService) Authenticate(ctx context.Context, token string) (User, err
 	var user User
 	var err error
 	err = s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
-		user, err = s.userRepo.ReadByToken(ctx, connection, token)
+		if user, err = s.userRepo.ReadByToken(ctx, connection, token); err != nil {
+			return err
+		}
 
-		return err
+		return checkEnabled(user)
 	})
 	if err != nil {
 		err = errors.Join(ErrToDoServiceAuthenticate, err)
@@ -56,9 +73,15 @@ func (s *UserService) RegisterUser(ctx context.Context, name string, email strin
 			Email:        email,
 			PasswordHash: passwordHash,
 			Token:        token,
+			TimeZone:     DefaultTimeZone,
+			Locale:       DefaultLocale,
 		}
 
-		return s.userRepo.Create(ctx, connection, user)
+		if err := s.userRepo.Create(ctx, connection, user); err != nil {
+			return err
+		}
+
+		return createPersonalWorkspace(ctx, connection, s.workspaceRepo, user)
 	})
 	if err != nil {
 		return errors.Join(ErrToDoServiceRegisterUser, err)
@@ -67,7 +90,106 @@ func (s *UserService) RegisterUser(ctx context.Context, name string, email strin
 	return nil
 }
 
-func (s *UserService) Login(ctx context.Context, email string, password string) error {
+// Login implements UserInterface.
+func (s *UserService) Login(ctx context.Context, email string, password string) (string, error) {
+	user, err := s.checkPassword(ctx, email, password)
+	if err != nil {
+		return "", err
+	}
+
+	var challenge string
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		challenge, err = createLoginChallenge(ctx, connection, s.twoFactorRepo, user.ID)
+
+		return err
+	})
+	if err != nil {
+		return "", errors.Join(ErrToDoServiceLoginUser, err)
+	}
+
+	return challenge, nil
+}
+
+// LoginTwoFactor implements UserInterface. The challenge is used up even by
+// a wrong code, so every guess costs a password check.
+func (s *UserService) LoginTwoFactor(ctx context.Context, challenge string, code string) (User, error) {
+	var user User
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		userID, err := s.twoFactorRepo.UseChallenge(ctx, connection, hashToken(challenge))
+		if err != nil {
+			return err
+		}
+
+		if user, err = s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return checkEnabled(user)
+	})
+	if err != nil {
+		return User{}, errors.Join(ErrUserServiceLoginTwoFactor, err)
+	}
+
+	// A transaction of its own, so a wrong code doesn't roll back using up
+	// the challenge.
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return checkTwoFactorCode(ctx, connection, s.twoFactorRepo, user.ID, code)
+	})
+	if err != nil {
+		return User{}, errors.Join(ErrUserServiceLoginTwoFactor, err)
+	}
+
+	return user, nil
+}
+
+// ChallengeEmail implements UserInterface.
+func (s *UserService) ChallengeEmail(ctx context.Context, challenge string) (string, error) {
+	var user User
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		userID, err := s.twoFactorRepo.ReadChallenge(ctx, connection, hashToken(challenge))
+		if err != nil {
+			return err
+		}
+
+		user, err = s.userRepo.ReadByID(ctx, connection, userID)
+
+		return err
+	})
+	if err != nil {
+		return "", errors.Join(ErrUserServiceLoginTwoFactor, err)
+	}
+
+	return user.Email, nil
+}
+
+// AuthenticatePassword implements UserInterface. A password alone would skip
+// the second factor, users with one enabled are refused.
+func (s *UserService) AuthenticatePassword(ctx context.Context, email string, password string) (User, error) {
+	user, err := s.checkPassword(ctx, email, password)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		twoFactor, err := s.twoFactorRepo.Read(ctx, connection, user.ID)
+		if errors.Is(err, sql.ErrNoRows) || err == nil && twoFactor.EnabledAt == nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		return ErrUserServiceTwoFactorRequired
+	})
+	if err != nil {
+		return User{}, errors.Join(ErrToDoServiceLoginUser, err)
+	}
+
+	return user, nil
+}
+
+// checkPassword checks the credentials of an enabled user.
+func (s *UserService) checkPassword(ctx context.Context, email string, password string) (User, error) {
 	var user User
 	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
 		var err error
@@ -76,14 +198,18 @@ func (s *UserService) Login(ctx context.Context, email string, password string)
 		return err
 	})
 	if err != nil {
-		return errors.Join(ErrToDoServiceLoginUser, err)
+		return User{}, errors.Join(ErrToDoServiceLoginUser, err)
 	}
 
 	if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
-		return errors.Join(ErrToDoServiceInvalidPasswordUser, err)
+		return User{}, errors.Join(ErrToDoServiceInvalidPasswordUser, err)
+	}
+	// Checked after the password, so it tells nothing to others.
+	if err = checkEnabled(user); err != nil {
+		return User{}, errors.Join(ErrToDoServiceLoginUser, err)
 	}
 
-	return nil
+	return user, nil
 }
 
 func (s *UserService) UpdateToken(ctx context.Context, email string, token string) error {
@@ -98,6 +224,36 @@ func (s *UserService) UpdateToken(ctx context.Context, email string, token strin
 
 }
 
+// UpdatePreferences implements UserInterface.
+func (s *UserService) UpdatePreferences(ctx context.Context, userID UserID, preferences Preferences) error {
+	// "Local" would silently mean the server's zone.
+	if _, err := time.LoadLocation(preferences.TimeZone); err != nil || preferences.TimeZone == "" || preferences.TimeZone == "Local" {
+		return errors.Join(ErrUserServiceInvalidPreferences, fmt.Errorf("unknown time zone %q", preferences.TimeZone), err)
+	}
+	if !slices.Contains(SupportedLocales, preferences.Locale) {
+		return errors.Join(ErrUserServiceInvalidPreferences, fmt.Errorf("unsupported locale %q", preferences.Locale))
+	}
+
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		return s.userRepo.UpdatePreferences(ctx, connection, userID, preferences)
+	})
+	if err != nil {
+		return errors.Join(ErrUserServiceUpdatePreferences, err)
+	}
+
+	return nil
+}
+
 func (s *UserService) Close() error {
 	return s.provider.Close()
 }
+
+// checkEnabled keeps users an admin disabled from signing in, whatever the
+// way.
+func checkEnabled(user User) error {
+	if user.DisabledAt != nil {
+		return ErrUserServiceDisabled
+	}
+
+	return nil
+}
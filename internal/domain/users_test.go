@@ -2,8 +2,10 @@ package domain_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"todo_list/internal/domain"
 	dbMocks "todo_list/mocks/todo_list/src/domain"
@@ -26,6 +28,7 @@ func TestUsersUnit(t *testing.T) {
 		Email:        validEmail,
 		PasswordHash: string(hashedPassword),
 	}
+	disabledAt := time.Now()
 
 	tests := []struct {
 		name            string
@@ -74,6 +77,21 @@ func TestUsersUnit(t *testing.T) {
 				require.ErrorIs(t, err, domain.ErrToDoServiceInvalidPasswordUser)
 			},
 		},
+		{
+			name:     "Failed - disabled",
+			email:    validEmail,
+			password: validPassword,
+			prepareMocks: func(repo *dbMocks.MockUsersRepository) {
+				disabledUser := validUser
+				disabledUser.DisabledAt = &disabledAt
+				repo.EXPECT().ReadByEmail(mock.Anything, mock.Anything, validEmail).
+					Return(disabledUser, nil).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrUserServiceDisabled)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -84,7 +102,11 @@ func TestUsersUnit(t *testing.T) {
 				test.prepareMocks(repository)
 			}
 
-			err := domain.NewUserService(provider, repository).Login(context.Background(), test.email, test.password)
+			twoFactor := dbMocks.NewMockTwoFactorRepository(t)
+			twoFactor.EXPECT().Read(mock.Anything, mock.Anything, validUser.ID).Return(domain.TwoFactor{}, sql.ErrNoRows).Maybe()
+
+			challenge, err := domain.NewUserService(provider, repository, twoFactor, dbMocks.NewMockWorkspacesRepository(t)).Login(context.Background(), test.email, test.password)
+			require.Empty(t, challenge)
 
 			test.check(t, err)
 		})
@@ -92,8 +114,79 @@ func TestUsersUnit(t *testing.T) {
 
 }
 
+func TestUsersUnitAuthenticatePassword(t *testing.T) {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("correct password"), bcrypt.MinCost)
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "some@email.ru", PasswordHash: string(hashedPassword)}
+	enabledAt := time.Now()
+
+	tests := []struct {
+		name      string
+		twoFactor domain.TwoFactor
+		readErr   error
+		check     func(*testing.T, domain.User, error)
+	}{
+		{
+			name:    "Without two-factor",
+			readErr: sql.ErrNoRows,
+			check: func(t *testing.T, got domain.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.ID, got.ID)
+			},
+		},
+		{
+			name:      "Unconfirmed two-factor",
+			twoFactor: domain.TwoFactor{UserID: user.ID},
+			check: func(t *testing.T, got domain.User, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "Two-factor enabled",
+			twoFactor: domain.TwoFactor{UserID: user.ID, EnabledAt: &enabledAt},
+			check: func(t *testing.T, got domain.User, err error) {
+				require.ErrorIs(t, err, domain.ErrUserServiceTwoFactorRequired)
+				require.Empty(t, got)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			users, twoFactor := dbMocks.NewMockUsersRepository(t), dbMocks.NewMockTwoFactorRepository(t)
+			users.EXPECT().ReadByEmail(mock.Anything, mock.Anything, user.Email).Return(user, nil).Once()
+			twoFactor.EXPECT().Read(mock.Anything, mock.Anything, user.ID).Return(test.twoFactor, test.readErr).Once()
+
+			service := domain.NewUserService(newFakeProvider(dbMocks.NewMockConnection(t)), users, twoFactor, dbMocks.NewMockWorkspacesRepository(t))
+			got, err := service.AuthenticatePassword(context.Background(), user.Email, "correct password")
+
+			test.check(t, got, err)
+		})
+	}
+}
+
+func TestUsersUnitChallengeEmail(t *testing.T) {
+	user := domain.User{ID: domain.UserID(uuid.New()), Email: "some@email.ru"}
+
+	users, twoFactor := dbMocks.NewMockUsersRepository(t), dbMocks.NewMockTwoFactorRepository(t)
+	notRaw := mock.MatchedBy(func(hash string) bool { return hash != "challenge" })
+	twoFactor.EXPECT().ReadChallenge(mock.Anything, mock.Anything, notRaw).Return(user.ID, nil).Once()
+	users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+	twoFactor.EXPECT().ReadChallenge(mock.Anything, mock.Anything, notRaw).Return(domain.UserID{}, sql.ErrNoRows).Once()
+
+	service := domain.NewUserService(newFakeProvider(dbMocks.NewMockConnection(t)), users, twoFactor, dbMocks.NewMockWorkspacesRepository(t))
+
+	email, err := service.ChallengeEmail(context.Background(), "challenge")
+	require.NoError(t, err)
+	require.Equal(t, user.Email, email)
+
+	_, err = service.ChallengeEmail(context.Background(), "challenge")
+	require.ErrorIs(t, err, domain.ErrUserServiceLoginTwoFactor)
+}
+
 type fakeProvider struct {
 	connection domain.Connection
+	// commitErr fails ExecuteTx once the receiver succeeded, like a failed
+	// commit.
+	commitErr error
 }
 
 func newFakeProvider(connection domain.Connection) *fakeProvider {
@@ -109,7 +202,11 @@ func (f *fakeProvider) Execute(ctx context.Context, receiver func(context.Contex
 }
 
 func (f *fakeProvider) ExecuteTx(ctx context.Context, receiver func(context.Context, domain.Connection) error) error {
-	return receiver(ctx, f.connection)
+	if err := receiver(ctx, f.connection); err != nil {
+		return err
+	}
+
+	return f.commitErr
 }
 
 var _ domain.ConnectionProvider = (*fakeProvider)(nil)
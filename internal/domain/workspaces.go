@@ -0,0 +1,390 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceInvitationTTL is how long a mailed invitation works.
+const WorkspaceInvitationTTL = 7 * 24 * time.Hour
+
+var (
+	_ WorkspaceInterface = (*WorkspaceService)(nil)
+)
+
+var (
+	errWorkspaceService                  = errors.New("workspace service error")
+	ErrWorkspaceServiceCreate            = errors.Join(errWorkspaceService, errors.New("create failed"))
+	ErrWorkspaceServiceGetAll            = errors.Join(errWorkspaceService, errors.New("read all failed"))
+	ErrWorkspaceServiceGet               = errors.Join(errWorkspaceService, errors.New("read failed"))
+	ErrWorkspaceServiceNotFound          = errors.Join(ErrWorkspaceServiceGet, errors.New("workspace not found"))
+	ErrWorkspaceServiceRename            = errors.Join(errWorkspaceService, errors.New("rename failed"))
+	ErrWorkspaceServiceDelete            = errors.Join(errWorkspaceService, errors.New("delete failed"))
+	ErrWorkspaceServiceGetMembers        = errors.Join(errWorkspaceService, errors.New("get members failed"))
+	ErrWorkspaceServiceRemoveMember      = errors.Join(errWorkspaceService, errors.New("remove member failed"))
+	ErrWorkspaceServiceInvite            = errors.Join(errWorkspaceService, errors.New("invite failed"))
+	ErrWorkspaceServiceGetInvitations    = errors.Join(errWorkspaceService, errors.New("get invitations failed"))
+	ErrWorkspaceServiceRevokeInvitation  = errors.Join(errWorkspaceService, errors.New("revoke invitation failed"))
+	ErrWorkspaceServiceAccept            = errors.Join(errWorkspaceService, errors.New("accept failed"))
+	ErrWorkspaceServiceInvalidInvitation = errors.Join(ErrWorkspaceServiceAccept, errors.New("invalid or expired invitation"))
+	ErrWorkspaceServiceInvalidArg        = errors.Join(errWorkspaceService, errors.New("invalid workspace"))
+	ErrWorkspaceServiceNotOwner          = errors.Join(errWorkspaceService, errors.New("only owners may manage the workspace"))
+	ErrWorkspaceServicePersonal          = errors.Join(errWorkspaceService, errors.New("personal workspaces can't be shared"))
+)
+
+// WorkspaceService keeps the data of separate groups of users apart. Members
+// work with the lists of a workspace, owners also manage who is a member.
+type WorkspaceService struct {
+	provider      ConnectionProvider
+	workspaceRepo WorkspacesRepository
+	userRepo      UsersRepository
+	mailer        Mailer
+	inviteURL     string
+}
+
+// NewWorkspaceService mails invitations to inviteURL with the token added as
+// the token query parameter.
+func NewWorkspaceService(provider ConnectionProvider, workspaceRepo WorkspacesRepository, userRepo UsersRepository, mailer Mailer,
+	inviteURL string,
+) *WorkspaceService {
+	return &WorkspaceService{
+		provider:      provider,
+		workspaceRepo: workspaceRepo,
+		userRepo:      userRepo,
+		mailer:        mailer,
+		inviteURL:     inviteURL,
+	}
+}
+
+// Close implements WorkspaceInterface.
+func (s *WorkspaceService) Close() error {
+	return s.provider.Close()
+}
+
+// Create implements WorkspaceInterface.
+func (s *WorkspaceService) Create(ctx context.Context, userID UserID, name string) (Workspace, error) {
+	name, err := workspaceName(name)
+	if err != nil {
+		return Workspace{}, err
+	}
+
+	workspace := Workspace{
+		ID:        WorkspaceID(uuid.New()),
+		Name:      name,
+		Role:      WorkspaceRoleOwner,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		return s.workspaceRepo.Create(ctx, connection, workspace, userID)
+	})
+	if err != nil {
+		return Workspace{}, errors.Join(ErrWorkspaceServiceCreate, err)
+	}
+
+	return workspace, nil
+}
+
+// GetAll implements WorkspaceInterface.
+func (s *WorkspaceService) GetAll(ctx context.Context, userID UserID) ([]Workspace, error) {
+	var workspaces []Workspace
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		workspaces, err = s.workspaceRepo.ReadAll(ctx, connection, userID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWorkspaceServiceGetAll, err)
+	}
+
+	return workspaces, nil
+}
+
+// Get implements WorkspaceInterface.
+func (s *WorkspaceService) Get(ctx context.Context, userID UserID, workspaceID WorkspaceID) (Workspace, error) {
+	var workspace Workspace
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		workspace, err = s.workspaceRepo.Read(ctx, connection, userID, workspaceID)
+
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return Workspace{}, errors.Join(ErrWorkspaceServiceNotFound, err)
+	}
+	if err != nil {
+		return Workspace{}, errors.Join(ErrWorkspaceServiceGet, err)
+	}
+
+	return workspace, nil
+}
+
+// Rename implements WorkspaceInterface.
+func (s *WorkspaceService) Rename(ctx context.Context, userID UserID, workspaceID WorkspaceID, name string) error {
+	name, err := workspaceName(name)
+	if err != nil {
+		return err
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if _, err := s.readOwned(ctx, connection, userID, workspaceID); err != nil {
+			return err
+		}
+
+		return s.workspaceRepo.Rename(ctx, connection, workspaceID, name)
+	})
+	if err != nil {
+		return errors.Join(ErrWorkspaceServiceRename, err)
+	}
+
+	return nil
+}
+
+// Delete implements WorkspaceInterface.
+func (s *WorkspaceService) Delete(ctx context.Context, userID UserID, workspaceID WorkspaceID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		workspace, err := s.readOwned(ctx, connection, userID, workspaceID)
+		if err != nil {
+			return err
+		}
+		if workspace.Personal {
+			return ErrWorkspaceServicePersonal
+		}
+
+		return s.workspaceRepo.Delete(ctx, connection, workspaceID)
+	})
+	if err != nil {
+		return errors.Join(ErrWorkspaceServiceDelete, err)
+	}
+
+	return nil
+}
+
+// GetMembers implements WorkspaceInterface.
+func (s *WorkspaceService) GetMembers(ctx context.Context, userID UserID, workspaceID WorkspaceID) ([]WorkspaceMember, error) {
+	var members []WorkspaceMember
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		if _, err := s.workspaceRepo.Read(ctx, connection, userID, workspaceID); err != nil {
+			return err
+		}
+
+		var err error
+		members, err = s.workspaceRepo.ReadMembers(ctx, connection, workspaceID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWorkspaceServiceGetMembers, err)
+	}
+
+	return members, nil
+}
+
+// RemoveMember implements WorkspaceInterface.
+func (s *WorkspaceService) RemoveMember(ctx context.Context, userID UserID, workspaceID WorkspaceID, memberID UserID) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if memberID == userID {
+			_, err = s.workspaceRepo.Read(ctx, connection, userID, workspaceID)
+		} else {
+			_, err = s.readOwned(ctx, connection, userID, workspaceID)
+		}
+		if err != nil {
+			return err
+		}
+
+		return s.workspaceRepo.RemoveMember(ctx, connection, workspaceID, memberID)
+	})
+	if err != nil {
+		return errors.Join(ErrWorkspaceServiceRemoveMember, err)
+	}
+
+	return nil
+}
+
+// Invite implements WorkspaceInterface. Only a hash of the token is stored,
+// the invited user gets the token itself by mail.
+func (s *WorkspaceService) Invite(ctx context.Context, userID UserID, workspaceID WorkspaceID, email, token string,
+) (WorkspaceInvitation, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return WorkspaceInvitation{}, errors.Join(ErrWorkspaceServiceInvalidArg, errors.New("empty email"))
+	}
+
+	now := time.Now()
+	invitation := WorkspaceInvitation{
+		ID:          WorkspaceInvitationID(uuid.New()),
+		WorkspaceID: workspaceID,
+		Email:       email,
+		TokenHash:   hashToken(token),
+		InvitedBy:   &userID,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(WorkspaceInvitationTTL),
+	}
+
+	var workspace Workspace
+	var inviter User
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		var err error
+		if workspace, err = s.readOwned(ctx, connection, userID, workspaceID); err != nil {
+			return err
+		}
+		if workspace.Personal {
+			return ErrWorkspaceServicePersonal
+		}
+		if inviter, err = s.userRepo.ReadByID(ctx, connection, userID); err != nil {
+			return err
+		}
+
+		return s.workspaceRepo.CreateInvitation(ctx, connection, invitation)
+	})
+	if err != nil {
+		return WorkspaceInvitation{}, errors.Join(ErrWorkspaceServiceInvite, err)
+	}
+
+	link, err := url.Parse(s.inviteURL)
+	if err != nil {
+		return WorkspaceInvitation{}, errors.Join(ErrWorkspaceServiceInvite, err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	err = s.mailer.Send(ctx, Mail{
+		To:      email,
+		Subject: fmt.Sprintf("Join %s", workspace.Name),
+		Body: fmt.Sprintf("Hello,\n\n"+
+			"%s invited you to the workspace %s. To join it, sign in with this email and open\n\n"+
+			"%s\n\n"+
+			"The link works for %s. If you don't expect the invitation, ignore this message.\n",
+			inviter.Name, workspace.Name, link, WorkspaceInvitationTTL),
+	})
+	if err != nil {
+		return WorkspaceInvitation{}, errors.Join(ErrWorkspaceServiceInvite, err)
+	}
+
+	return invitation, nil
+}
+
+// GetInvitations implements WorkspaceInterface.
+func (s *WorkspaceService) GetInvitations(ctx context.Context, userID UserID, workspaceID WorkspaceID) ([]WorkspaceInvitation, error) {
+	var invitations []WorkspaceInvitation
+	err := s.provider.Execute(ctx, func(ctx context.Context, connection Connection) error {
+		if _, err := s.readOwned(ctx, connection, userID, workspaceID); err != nil {
+			return err
+		}
+
+		var err error
+		invitations, err = s.workspaceRepo.ReadInvitations(ctx, connection, workspaceID)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWorkspaceServiceGetInvitations, err)
+	}
+
+	return invitations, nil
+}
+
+// RevokeInvitation implements WorkspaceInterface.
+func (s *WorkspaceService) RevokeInvitation(ctx context.Context, userID UserID, workspaceID WorkspaceID,
+	invitationID WorkspaceInvitationID,
+) error {
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		if _, err := s.readOwned(ctx, connection, userID, workspaceID); err != nil {
+			return err
+		}
+
+		return s.workspaceRepo.DeleteInvitation(ctx, connection, workspaceID, invitationID)
+	})
+	if err != nil {
+		return errors.Join(ErrWorkspaceServiceRevokeInvitation, err)
+	}
+
+	return nil
+}
+
+// Accept implements WorkspaceInterface. An invitation sent to another email
+// stays usable by its addressee.
+func (s *WorkspaceService) Accept(ctx context.Context, userID UserID, token string) (Workspace, error) {
+	var workspace Workspace
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, connection Connection) error {
+		invitation, err := s.workspaceRepo.UseInvitation(ctx, connection, hashToken(token))
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Join(ErrWorkspaceServiceInvalidInvitation, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		user, err := s.userRepo.ReadByID(ctx, connection, userID)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(user.Email, invitation.Email) {
+			return errors.Join(ErrWorkspaceServiceInvalidInvitation, errors.New("invitation sent to another email"))
+		}
+
+		if err = s.workspaceRepo.AddMember(ctx, connection, invitation.WorkspaceID, userID, WorkspaceRoleMember); err != nil {
+			return err
+		}
+
+		workspace, err = s.workspaceRepo.Read(ctx, connection, userID, invitation.WorkspaceID)
+
+		return err
+	})
+	if err != nil {
+		return Workspace{}, errors.Join(ErrWorkspaceServiceAccept, err)
+	}
+
+	return workspace, nil
+}
+
+// readOwned returns the workspace unless the user doesn't own it.
+func (s *WorkspaceService) readOwned(ctx context.Context, connection Connection, userID UserID, workspaceID WorkspaceID,
+) (Workspace, error) {
+	workspace, err := s.workspaceRepo.Read(ctx, connection, userID, workspaceID)
+	if err != nil {
+		return Workspace{}, err
+	}
+	if workspace.Role != WorkspaceRoleOwner {
+		return Workspace{}, ErrWorkspaceServiceNotOwner
+	}
+
+	return workspace, nil
+}
+
+// PersonalWorkspace returns the workspace every user gets on registration,
+// it has the ID of the user.
+func PersonalWorkspace(userID UserID) WorkspaceID {
+	return WorkspaceID(userID)
+}
+
+// createPersonalWorkspace makes the personal workspace of a new user.
+func createPersonalWorkspace(ctx context.Context, connection Connection, workspaceRepo WorkspacesRepository, user User) error {
+	workspace := Workspace{
+		ID:        PersonalWorkspace(user.ID),
+		Name:      user.Name,
+		Personal:  true,
+		CreatedAt: time.Now(),
+	}
+
+	return workspaceRepo.Create(ctx, connection, workspace, user.ID)
+}
+
+func workspaceName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.Join(ErrWorkspaceServiceInvalidArg, errors.New("empty name"))
+	}
+
+	return name, nil
+}
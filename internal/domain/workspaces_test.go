@@ -0,0 +1,190 @@
+package domain_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"todo_list/internal/domain"
+	dbMocks "todo_list/mocks/todo_list/src/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspacesUnit(t *testing.T) {
+	ctx := context.Background()
+	user := domain.User{ID: domain.UserID(uuid.New()), Name: "Ann", Email: "ann@email.foo"}
+	owned := domain.Workspace{ID: domain.WorkspaceID(uuid.New()), Name: "Sales", Role: domain.WorkspaceRoleOwner}
+	joined := domain.Workspace{ID: domain.WorkspaceID(uuid.New()), Name: "Support", Role: domain.WorkspaceRoleMember}
+	personal := domain.Workspace{ID: domain.PersonalWorkspace(user.ID), Name: "Ann", Personal: true, Role: domain.WorkspaceRoleOwner}
+	const inviteURL = "https://todo.example/join?lang=en"
+
+	type mocks struct {
+		workspaces *dbMocks.MockWorkspacesRepository
+		users      *dbMocks.MockUsersRepository
+		mailer     *dbMocks.MockMailer
+	}
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *domain.WorkspaceService, mocks)
+	}{
+		{
+			name: "Create",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Create(mock.Anything, mock.Anything, mock.MatchedBy(func(workspace domain.Workspace) bool {
+					return workspace.Name == "Sales" && !workspace.Personal
+				}), user.ID).Return(nil).Once()
+
+				workspace, err := service.Create(ctx, user.ID, "  Sales ")
+
+				require.NoError(t, err)
+				require.Equal(t, domain.WorkspaceRoleOwner, workspace.Role)
+			},
+		},
+		{
+			name: "Create Empty Name",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				_, err := service.Create(ctx, user.ID, " ")
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServiceInvalidArg)
+			},
+		},
+		{
+			name: "Get Not Member",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, owned.ID).
+					Return(domain.Workspace{}, sql.ErrNoRows).Once()
+
+				_, err := service.Get(ctx, user.ID, owned.ID)
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServiceNotFound)
+			},
+		},
+		{
+			name: "Rename Not Owner",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, joined.ID).Return(joined, nil).Once()
+
+				err := service.Rename(ctx, user.ID, joined.ID, "Mine")
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServiceNotOwner)
+			},
+		},
+		{
+			name: "Delete Personal",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, personal.ID).Return(personal, nil).Once()
+
+				err := service.Delete(ctx, user.ID, personal.ID)
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServicePersonal)
+			},
+		},
+		{
+			name: "Leave",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, joined.ID).Return(joined, nil).Once()
+				m.workspaces.EXPECT().RemoveMember(mock.Anything, mock.Anything, joined.ID, user.ID).Return(nil).Once()
+
+				require.NoError(t, service.RemoveMember(ctx, user.ID, joined.ID, user.ID))
+			},
+		},
+		{
+			name: "Remove Member Not Owner",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, joined.ID).Return(joined, nil).Once()
+
+				err := service.RemoveMember(ctx, user.ID, joined.ID, domain.UserID(uuid.New()))
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServiceNotOwner)
+			},
+		},
+		{
+			name: "Invite",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, owned.ID).Return(owned, nil).Once()
+				m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				m.workspaces.EXPECT().CreateInvitation(mock.Anything, mock.Anything, mock.MatchedBy(func(invitation domain.WorkspaceInvitation) bool {
+					return invitation.WorkspaceID == owned.ID && invitation.Email == "bob@email.foo" &&
+						invitation.TokenHash != "" && invitation.TokenHash != "secret" &&
+						invitation.ExpiresAt.Sub(invitation.CreatedAt) == domain.WorkspaceInvitationTTL
+				})).Return(nil).Once()
+				m.mailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(mail domain.Mail) bool {
+					require.Equal(t, "bob@email.foo", mail.To)
+					require.Contains(t, mail.Body, "Ann invited you to the workspace Sales")
+					require.Contains(t, mail.Body, "https://todo.example/join?lang=en&token=secret")
+
+					return true
+				})).Return(nil).Once()
+
+				_, err := service.Invite(ctx, user.ID, owned.ID, " bob@email.foo ", "secret")
+
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Invite Personal",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, personal.ID).Return(personal, nil).Once()
+
+				_, err := service.Invite(ctx, user.ID, personal.ID, "bob@email.foo", "secret")
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServicePersonal)
+			},
+		},
+		{
+			name: "Accept",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().UseInvitation(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.WorkspaceInvitation{WorkspaceID: joined.ID, Email: "ANN@email.foo"}, nil).Once()
+				m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+				m.workspaces.EXPECT().AddMember(mock.Anything, mock.Anything, joined.ID, user.ID, domain.WorkspaceRoleMember).
+					Return(nil).Once()
+				m.workspaces.EXPECT().Read(mock.Anything, mock.Anything, user.ID, joined.ID).Return(joined, nil).Once()
+
+				workspace, err := service.Accept(ctx, user.ID, "secret")
+
+				require.NoError(t, err)
+				require.Equal(t, joined, workspace)
+			},
+		},
+		{
+			name: "Accept Another Email",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().UseInvitation(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.WorkspaceInvitation{WorkspaceID: joined.ID, Email: "bob@email.foo"}, nil).Once()
+				m.users.EXPECT().ReadByID(mock.Anything, mock.Anything, user.ID).Return(user, nil).Once()
+
+				_, err := service.Accept(ctx, user.ID, "secret")
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServiceInvalidInvitation)
+			},
+		},
+		{
+			name: "Accept Invalid Token",
+			check: func(t *testing.T, service *domain.WorkspaceService, m mocks) {
+				m.workspaces.EXPECT().UseInvitation(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.WorkspaceInvitation{}, sql.ErrNoRows).Once()
+
+				_, err := service.Accept(ctx, user.ID, "used")
+
+				require.ErrorIs(t, err, domain.ErrWorkspaceServiceInvalidInvitation)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := mocks{
+				workspaces: dbMocks.NewMockWorkspacesRepository(t),
+				users:      dbMocks.NewMockUsersRepository(t),
+				mailer:     dbMocks.NewMockMailer(t),
+			}
+			provider := newFakeProvider(dbMocks.NewMockConnection(t))
+
+			test.check(t, domain.NewWorkspaceService(provider, m.workspaces, m.users, m.mailer, inviteURL), m)
+		})
+	}
+}
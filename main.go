@@ -4,12 +4,22 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"todo_list/internal/adapter/blob"
+	"todo_list/internal/adapter/config"
 	"todo_list/internal/adapter/controller"
 	"todo_list/internal/adapter/database"
+	"todo_list/internal/adapter/jwt"
+	"todo_list/internal/adapter/lifecycle"
 	"todo_list/internal/adapter/logger"
+	"todo_list/internal/adapter/mailer"
+	"todo_list/internal/adapter/oidc"
+	"todo_list/internal/adapter/ratelimit"
 	"todo_list/internal/adapter/repository"
 	"todo_list/internal/domain"
 
@@ -19,64 +29,584 @@ import (
 	"github.com/joho/godotenv"
 )
 
+// loginLockout locks an account out for a minute after 5 failed logins in a
+// row, doubling up to an hour with every further one.
+var loginLockout = domain.Lockout{Threshold: 5, Delay: time.Minute, MaxDelay: time.Hour, Reset: 24 * time.Hour}
+
 func main() {
 	godotenv.Load()
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	go func() {
+		// Restores the default handling once the shutdown began, so a second
+		// signal kills the process if draining hangs.
+		<-ctx.Done()
+		stop()
+	}()
 
 	slog.SetLogLoggerLevel(slog.LevelDebug)
 
-	users, lists, tasks, authMiddleware, err := createControllers()
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		slog.ErrorContext(ctx, "Load config failed.", logger.ErrAttr(err))
+		os.Exit(1)
+	}
+
+	ctl, err := createControllers(cfg)
 	if err != nil {
 		slog.ErrorContext(ctx, "Create application service failed.", logger.ErrAttr(err))
 		os.Exit(1)
 	}
-	defer func() { _ = users.Close() }()
-	defer func() { _ = lists.Close() }()
-	defer func() { _ = tasks.Close() }()
+
+	server := &http.Server{
+		Addr:         cfg.Server.Address,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
+	}
+	// On SIGTERM the server reports itself not ready for a delay, drains the
+	// requests in flight, the workers stop and the pool shared by every
+	// service is closed last.
+	app := lifecycle.New(server, cfg.Server.ShutdownDelay, cfg.Server.ShutdownTimeout)
+	health := controller.NewHealth(ctl.healthService, app)
 
 	router := gin.Default()
+	if err = router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		slog.ErrorContext(ctx, "Set trusted proxies failed.", logger.ErrAttr(err))
+		os.Exit(1)
+	}
 
 	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{os.Getenv("CORS_ALLOWED_ORIGIN")},
-		AllowMethods:     []string{"POST", "GET"},
-		AllowHeaders:     []string{"Origin", "Content-Type"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowOrigins:     []string{cfg.Server.CORSAllowedOrigin},
+		AllowMethods:     []string{"POST", "GET", "PATCH", "PUT", "DELETE"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", controller.WorkspaceHeader},
+		ExposeHeaders:    []string{"Content-Length", "Retry-After"},
 		AllowCredentials: true,
 		MaxAge:           time.Minute,
 	}))
 
-	router.POST("register", users.Register)
-	router.POST("login", users.Login)
+	// Probes are neither authenticated nor rate limited.
+	router.GET("healthz", health.Live)
+	router.GET("readyz", health.Ready)
+
+	public := router.Group("/")
+	public.Use(ctl.limiter.Limit("public", ctl.publicLimit, controller.ClientIP))
+	{
+		public.POST("register", ctl.users.Register)
+		public.POST("login", ctl.limiter.Limit("login", ctl.accountLimit, controller.BodyEmail),
+			ctl.limiter.Lockout("login", loginLockout, controller.BodyEmail), ctl.users.Login)
+		// The second factor shares the limits and the lockout of the
+		// password, keyed by the user the challenge was issued to.
+		public.POST("login/2fa", ctl.limiter.Limit("login", ctl.accountLimit, ctl.users.ChallengeEmail),
+			ctl.limiter.Lockout("login", loginLockout, ctl.users.ChallengeEmail), ctl.users.LoginTwoFactor)
+		public.POST("password/forgot", ctl.limiter.Limit("forgot", ctl.accountLimit, controller.BodyEmail), ctl.passwords.Forgot)
+		public.POST("password/reset", ctl.passwords.Reset)
+		public.POST("email/verify", ctl.verification.Verify)
+		public.POST("email/change/confirm", ctl.account.ConfirmEmailChange)
+		public.GET("export/download", ctl.dataExport.Download)
+		public.GET("feed/:token", ctl.feeds.Calendar)
+		// Without OIDC_ISSUER users sign in with passwords only.
+		if ctl.oidc != nil {
+			public.POST("oidc/start", ctl.oidc.Start)
+			public.POST("oidc/callback", ctl.oidc.Callback)
+		}
+	}
+	if ctl.jwt != nil {
+		router.GET(".well-known/jwks.json", ctl.jwt.JWKS)
+	}
+
+	router.GET(".well-known/caldav", ctl.dav.WellKnown)
+	router.Handle("PROPFIND", ".well-known/caldav", ctl.dav.WellKnown)
+	router.OPTIONS("dav/*path", ctl.dav.Options)
 
+	// Basic authentication is a login too, failures lock the account out.
+	dav := router.Group("/dav")
+	dav.Use(ctl.limiter.Limit("dav", ctl.apiLimit, controller.ClientIP),
+		ctl.limiter.Lockout("login", loginLockout, controller.BasicAuthEmail), ctl.dav.Auth)
+	{
+		read, write := controller.RequireScope(domain.ScopeListsRead, domain.ScopeTasksRead), controller.RequireScope(domain.ScopeTasksWrite)
+
+		dav.Handle("PROPFIND", "/*path", read, ctl.dav.Propfind)
+		dav.Handle("REPORT", "/*path", read, ctl.dav.Report)
+		dav.GET("/*path", read, ctl.dav.Get)
+		dav.PUT("/*path", write, ctl.dav.Put)
+		dav.DELETE("/*path", write, ctl.dav.Delete)
+	}
+
+	// Access tokens only reach the routes with their scopes, and never the
+	// account settings.
 	authRequired := router.Group("/v1")
-	authRequired.Use(authMiddleware)
+	authRequired.Use(ctl.authMiddleware, ctl.limiter.Limit("api", ctl.apiLimit, controller.CurrentUser))
+	{
+		authRequired.GET("me", controller.SessionOnly, ctl.account.GetMe)
+		authRequired.PATCH("me", controller.SessionOnly, ctl.account.UpdateMe)
+		authRequired.POST("password", controller.SessionOnly, ctl.account.ChangePassword)
+		authRequired.POST("email", controller.SessionOnly, ctl.account.RequestEmailChange)
+		authRequired.DELETE("me", controller.SessionOnly, ctl.accountDeletion.DeleteMe)
+		authRequired.GET("me/deletion", controller.SessionOnly, ctl.accountDeletion.GetDeletion)
+		authRequired.DELETE("me/deletion", controller.SessionOnly, ctl.accountDeletion.CancelDeletion)
+		authRequired.GET("me/export", controller.SessionOnly, ctl.dataExport.GetExports)
+		authRequired.POST("me/export", controller.SessionOnly, ctl.dataExport.RequestExport)
+		authRequired.GET("preferences", controller.SessionOnly, ctl.users.GetPreferences)
+		authRequired.PUT("preferences", controller.SessionOnly, ctl.users.UpdatePreferences)
+		authRequired.POST("email/verify/resend", controller.SessionOnly, ctl.verification.Resend)
+		authRequired.POST("2fa/enroll", controller.SessionOnly, ctl.twoFactor.Enroll)
+		authRequired.POST("2fa/confirm", controller.SessionOnly, ctl.twoFactor.Confirm)
+		authRequired.POST("2fa/disable", controller.SessionOnly, ctl.twoFactor.Disable)
+
+		// With AUTH_MODE=jwt clients trade the login token for short-lived
+		// JWTs.
+		if ctl.jwt != nil {
+			authRequired.POST("jwt", controller.SessionOnly, ctl.jwt.Issue)
+			authRequired.POST("logout", controller.SessionOnly, ctl.jwt.Logout)
+		}
+
+		authRequired.GET("tokens", controller.SessionOnly, ctl.accessTokens.GetTokens)
+		authRequired.POST("tokens", controller.SessionOnly, ctl.accessTokens.CreateToken)
+		authRequired.DELETE("tokens", controller.SessionOnly, ctl.accessTokens.DeleteToken)
+
+		authRequired.GET("workspaces", controller.SessionOnly, ctl.workspaces.GetWorkspaces)
+		authRequired.POST("workspaces", controller.SessionOnly, ctl.workspaces.CreateWorkspace)
+		authRequired.PUT("workspaces/:id", controller.SessionOnly, ctl.workspaces.RenameWorkspace)
+		authRequired.DELETE("workspaces/:id", controller.SessionOnly, ctl.workspaces.DeleteWorkspace)
+		authRequired.GET("workspaces/:id/members", controller.SessionOnly, ctl.workspaces.GetMembers)
+		authRequired.DELETE("workspaces/:id/members/:user", controller.SessionOnly, ctl.workspaces.RemoveMember)
+		authRequired.GET("workspaces/:id/invitations", controller.SessionOnly, ctl.workspaces.GetInvitations)
+		authRequired.POST("workspaces/:id/invitations", controller.SessionOnly,
+			ctl.restrictions.Verified(controller.FeatureSharing), ctl.workspaces.Invite)
+		authRequired.DELETE("workspaces/:id/invitations/:invitation", controller.SessionOnly, ctl.workspaces.RevokeInvitation)
+		authRequired.POST("workspaces/invitations/accept", controller.SessionOnly, ctl.workspaces.Accept)
+
+		// The data routes work in the workspace of the path segment or the
+		// header, the personal one without either.
+		registerWorkspaceRoutes(authRequired.Group("", ctl.workspaces.Select), ctl)
+		registerWorkspaceRoutes(authRequired.Group("w/:workspace", ctl.workspaces.Select), ctl)
+	}
+
+	// Only admins reach /admin, and only with a login, every action there
+	// is audited.
+	admin := router.Group("/admin")
+	admin.Use(ctl.authMiddleware, ctl.limiter.Limit("api", ctl.apiLimit, controller.CurrentUser), controller.SessionOnly,
+		controller.RequireRole(domain.RoleAdmin))
 	{
-		authRequired.GET("list", lists.GetUserListsAndTasks)
-		authRequired.POST("list", lists.CreateList)
-		authRequired.PUT("list", lists.UpdateList)
-		authRequired.DELETE("list", lists.DeleteList)
+		admin.GET("users", ctl.admin.SearchUsers)
+		admin.GET("users/:id", ctl.admin.GetUser)
+		admin.GET("users/:id/usage", ctl.admin.GetUsage)
+		admin.POST("users/:id/disable", ctl.admin.DisableUser)
+		admin.POST("users/:id/enable", ctl.admin.EnableUser)
+		admin.POST("users/:id/logout", ctl.admin.LogoutUser)
+		admin.POST("users/:id/password-reset", ctl.admin.ResetPassword)
+		admin.PUT("users/:id/role", ctl.admin.SetRole)
+		admin.GET("audit", ctl.admin.GetAuditLog)
+	}
 
-		authRequired.POST("task", tasks.CreateTask)
-		authRequired.PUT("task", tasks.UpdateTask)
-		authRequired.DELETE("task", tasks.DeleteTask)
+	server.Handler = router
+	app.Go("attachments", func(ctx context.Context) { sweepAttachments(ctx, ctl.attachmentService) })
+	app.Go("rate limits", func(ctx context.Context) { sweepRateLimits(ctx, ctl.rateLimitStore) })
+	app.Go("data exports", func(ctx context.Context) { processDataExports(ctx, ctl.dataExportService) })
+	app.Go("account deletions", func(ctx context.Context) { purgeAccounts(ctx, ctl.accountDeletionService) })
+	if ctl.keyRing != nil {
+		app.Go("signing keys", func(ctx context.Context) { rotateSigningKeys(ctx, ctl.keyRing) })
 	}
+	app.OnClose("database", ctl.provider.Close)
 
-	router.Run(os.Getenv("SERVER_ADDRESS"))
+	if err = app.Run(ctx); err != nil {
+		slog.ErrorContext(ctx, "Run failed.", logger.ErrAttr(err))
+		os.Exit(1)
+	}
 }
 
-func createControllers() (*controller.Users, *controller.Lists, *controller.Tasks, gin.HandlerFunc, error) {
-	pool, err := pgxpool.New(context.Background(), os.Getenv("DB_CONNECTION"))
+// registerWorkspaceRoutes adds the routes of the data inside workspaces.
+func registerWorkspaceRoutes(group *gin.RouterGroup, ctl controllers) {
+	listsRead, listsWrite := controller.RequireScope(domain.ScopeListsRead), controller.RequireScope(domain.ScopeListsWrite)
+	tasksRead, tasksWrite := controller.RequireScope(domain.ScopeTasksRead), controller.RequireScope(domain.ScopeTasksWrite)
+	feedsAdmin := controller.RequireScope(domain.ScopeFeedsAdmin)
+
+	group.GET("list", listsRead, tasksRead, ctl.lists.GetUserListsAndTasks)
+	group.POST("list", listsWrite, ctl.lists.CreateList)
+	group.PUT("list", listsWrite, ctl.lists.UpdateList)
+	group.DELETE("list", listsWrite, ctl.lists.DeleteList)
+
+	group.GET("list/:id/members", listsRead, ctl.lists.GetMembers)
+	group.POST("list/:id/members", listsWrite, ctl.restrictions.Verified(controller.FeatureSharing), ctl.lists.AddMember)
+	group.DELETE("list/:id/members/:user", listsWrite, ctl.lists.RemoveMember)
+
+	group.GET("smart", listsRead, ctl.smartLists.GetSmartLists)
+	group.POST("smart", listsWrite, ctl.smartLists.CreateSmartList)
+	group.PUT("smart", listsWrite, ctl.smartLists.UpdateSmartList)
+	group.DELETE("smart", listsWrite, ctl.smartLists.DeleteSmartList)
+	group.GET("smart/:id/tasks", listsRead, tasksRead, ctl.smartLists.GetTasks)
+
+	group.GET("task", tasksRead, ctl.tasks.FindTasks)
+	group.POST("task", tasksWrite, ctl.tasks.CreateTask)
+	group.POST("task/quick", tasksWrite, ctl.quickAdd.Parse)
+	group.PUT("task", tasksWrite, ctl.tasks.UpdateTask)
+	group.DELETE("task", tasksWrite, ctl.tasks.DeleteTask)
+	group.GET("assigned", tasksRead, ctl.tasks.GetAssigned)
+
+	group.GET("stats", tasksRead, ctl.stats.GetStats)
+
+	group.GET("export", listsRead, tasksRead, ctl.transfer.Export)
+	group.POST("import", listsWrite, tasksWrite, ctl.restrictions.Verified(controller.FeatureImport), ctl.transfer.Import)
+
+	group.GET("feed", feedsAdmin, ctl.feeds.GetFeeds)
+	group.POST("feed", feedsAdmin, tasksRead, ctl.restrictions.Verified(controller.FeatureFeeds), ctl.feeds.CreateFeed)
+	group.DELETE("feed", feedsAdmin, ctl.feeds.DeleteFeed)
+
+	group.GET("task/:id/attachments", tasksRead, ctl.attachments.GetAttachments)
+	group.POST("task/:id/attachments", tasksWrite, ctl.restrictions.Verified(controller.FeatureAttachments), ctl.attachments.Upload)
+	group.GET("attachment/:id", tasksRead, ctl.attachments.Download)
+	group.DELETE("attachment/:id", tasksWrite, ctl.attachments.DeleteAttachment)
+
+	group.GET("task/:id/comments", tasksRead, ctl.comments.GetComments)
+	group.POST("task/:id/comments", tasksWrite, ctl.comments.CreateComment)
+	group.PUT("comment/:id", tasksWrite, ctl.comments.UpdateComment)
+	group.DELETE("comment/:id", tasksWrite, ctl.comments.DeleteComment)
+}
+
+type controllers struct {
+	users           *controller.Users
+	passwords       *controller.Passwords
+	verification    *controller.Verification
+	account         *controller.Account
+	accountDeletion *controller.AccountDeletion
+	dataExport      *controller.DataExport
+	twoFactor       *controller.TwoFactor
+	oidc            *controller.OIDC
+	accessTokens    *controller.AccessTokens
+	jwt             *controller.JWT
+	workspaces      *controller.Workspaces
+	lists           *controller.Lists
+	tasks           *controller.Tasks
+	transfer        *controller.Transfer
+	feeds           *controller.Feeds
+	dav             *controller.DAV
+	attachments     *controller.Attachments
+	comments        *controller.Comments
+	quickAdd        *controller.QuickAdd
+	smartLists      *controller.SmartLists
+	stats           *controller.Stats
+	admin           *controller.Admin
+	authMiddleware  gin.HandlerFunc
+	restrictions    controller.Restrictions
+	limiter         *controller.RateLimiter
+	publicLimit     domain.RateLimit
+	accountLimit    domain.RateLimit
+	apiLimit        domain.RateLimit
+
+	provider               *database.PostgresProvider
+	healthService          domain.HealthInterface
+	attachmentService      domain.AttachmentInterface
+	dataExportService      domain.DataExportInterface
+	accountDeletionService domain.AccountDeletionInterface
+	rateLimitStore         domain.RateLimitStore
+	keyRing                *jwt.KeyRing
+}
+
+func createControllers(cfg config.Config) (controllers, error) {
+	poolConfig, err := cfg.Database.PoolConfig()
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("parse database config failed"), err)
+	}
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("create database pool failed"), err)
+	}
+
+	store, err := createBlobStore(cfg.Storage)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("create blob store failed"), err)
+	}
+
+	mail, err := createMailer(cfg.Mail)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("create mailer failed"), err)
+	}
+
+	secret := cfg.Security.EmailVerificationSecret
+
+	restrictions, err := controller.ParseRestrictions(cfg.Security.UnverifiedRestrictions)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("parse unverified restrictions failed"), err)
+	}
+
+	publicLimit, err := controller.ParseRateLimit(cfg.RateLimit.Public)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("parse public rate limit failed"), err)
+	}
+	accountLimit, err := controller.ParseRateLimit(cfg.RateLimit.Account)
 	if err != nil {
-		return nil, nil, nil, nil, errors.Join(errors.New("create database pool failed"), err)
+		return controllers{}, errors.Join(errors.New("parse account rate limit failed"), err)
+	}
+	apiLimit, err := controller.ParseRateLimit(cfg.RateLimit.API)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("parse api rate limit failed"), err)
 	}
 
 	provider := database.NewPostgresProvider(pool)
-	userService := domain.NewUserService(provider, repository.NewUsers())
+
+	rateLimitStore, err := createRateLimitStore(provider, cfg.RateLimit.Store)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("create rate limit store failed"), err)
+	}
+
+	secrets := controller.Secrets{PasswordCost: cfg.Security.BCryptCost, TokenBytes: cfg.Security.TokenBytes}
+	userService := domain.NewUserService(provider, repository.NewUsers(), repository.NewTwoFactor(), repository.NewWorkspaces())
+	keyRing, err := createKeyRing(provider, cfg.Auth)
+	if err != nil {
+		return controllers{}, errors.Join(errors.New("create jwt key ring failed"), err)
+	}
+	// A nil *jwt.KeyRing would make a non-nil interface.
+	var jwtController *controller.JWT
+	var verifier domain.JWTKeyRing
+	if keyRing != nil {
+		jwtController, verifier = controller.NewJWT(userService, keyRing, secrets), keyRing
+	}
+	twoFactorService := domain.NewTwoFactorService(provider, repository.NewUsers(), repository.NewTwoFactor(), cfg.Security.TOTPIssuer)
+	passwordResetService := domain.NewPasswordResetService(provider, repository.NewUsers(), repository.NewPasswordResets(),
+		repository.NewFeedTokens(), repository.NewAccessTokens(), verifier, mail, cfg.URLs.PasswordReset)
+	verificationService := domain.NewEmailVerificationService(provider, repository.NewUsers(), mail, []byte(secret),
+		cfg.URLs.EmailVerify)
+	accountService := domain.NewAccountService(provider, repository.NewUsers(), repository.NewPasswordResets(),
+		repository.NewAccessTokens(), repository.NewFeedTokens(), verifier, mail, []byte(secret), cfg.URLs.EmailChange)
+	accountDeletionService := domain.NewAccountDeletionService(provider, repository.NewUsers(),
+		repository.NewAccountDeletions(), repository.NewTwoFactor(), repository.NewFeedTokens(), repository.NewAccessTokens(),
+		verifier, mail, cfg.Security.AccountDeletionGrace)
+	dataExportService := domain.NewDataExportService(provider, repository.NewUsers(), repository.NewWorkspaces(), repository.NewDataExports(),
+		repository.NewLists(), repository.NewTasks(), repository.NewSmartLists(), repository.NewComments(),
+		repository.NewAttachments(), repository.NewFeedTokens(), repository.NewAccessTokens(), store, mail,
+		cfg.URLs.DataExport)
+	var oidcController *controller.OIDC
+	if cfg.OIDC.Issuer != "" {
+		idp, err := oidc.New(context.Background(), cfg.OIDC.Issuer, cfg.OIDC.ClientID, cfg.OIDC.ClientSecret,
+			cfg.OIDC.RedirectURL)
+		if err != nil {
+			return controllers{}, errors.Join(errors.New("create identity provider failed"), err)
+		}
+		oidcController = controller.NewOIDC(domain.NewOIDCService(provider, repository.NewUsers(), repository.NewOIDC(),
+			repository.NewTwoFactor(), repository.NewWorkspaces(), idp), secrets)
+	}
+	accessTokenService := domain.NewAccessTokenService(provider, repository.NewAccessTokens(), repository.NewUsers())
+	workspaceService := domain.NewWorkspaceService(provider, repository.NewWorkspaces(), repository.NewUsers(), mail,
+		cfg.URLs.WorkspaceInvitation)
 	listService := domain.NewListService(provider, repository.NewLists())
 	taskService := domain.NewTaskService(provider, repository.NewTasks())
-	authMiddlware := controller.NewAuthMiddleware(userService).Auth
+	transferService := domain.NewTransferService(provider, repository.NewLists(), repository.NewTasks())
+	feedService := domain.NewFeedService(provider, repository.NewFeedTokens(), repository.NewTasks())
+	commentService := domain.NewCommentService(provider, repository.NewComments())
+	smartListService := domain.NewSmartListService(provider, repository.NewSmartLists(), repository.NewTasks())
+	statsService := domain.NewStatsService(provider, repository.NewStats())
+	adminService := domain.NewAdminService(provider, repository.NewUsers(), repository.NewAuditLog(), repository.NewUsage(),
+		repository.NewAccessTokens(), repository.NewFeedTokens(), repository.NewPasswordResets(), verifier, mail, cfg.URLs.PasswordReset)
+	attachmentService := domain.NewAttachmentService(provider, repository.NewAttachments(), repository.NewTasks(), store, cfg.Storage.QuotaBytes)
+
+	return controllers{
+		users:           controller.NewUsers(userService, verificationService, secrets),
+		passwords:       controller.NewPasswords(passwordResetService, secrets),
+		verification:    controller.NewVerification(verificationService),
+		account:         controller.NewAccount(accountService, secrets),
+		accountDeletion: controller.NewAccountDeletion(accountDeletionService, secrets),
+		dataExport:      controller.NewDataExport(dataExportService),
+		twoFactor:       controller.NewTwoFactor(twoFactorService),
+		oidc:            oidcController,
+		accessTokens:    controller.NewAccessTokens(accessTokenService, secrets),
+		jwt:             jwtController,
+		workspaces:      controller.NewWorkspaces(workspaceService, secrets),
+		lists:           controller.NewLists(listService),
+		tasks:           controller.NewTasks(taskService),
+		transfer:        controller.NewTransfer(transferService),
+		feeds:           controller.NewFeeds(feedService, secrets),
+		dav:             controller.NewDAV(userService, accessTokenService, listService, taskService),
+		attachments:     controller.NewAttachments(attachmentService),
+		comments:        controller.NewComments(commentService),
+		quickAdd:        controller.NewQuickAdd(listService),
+		smartLists:      controller.NewSmartLists(smartListService),
+		stats:           controller.NewStats(statsService),
+		admin:           controller.NewAdmin(adminService, secrets),
+		authMiddleware:  controller.NewAuthMiddleware(userService, accessTokenService, verifier).Auth,
+		restrictions:    restrictions,
+		limiter:         controller.NewRateLimiter(rateLimitStore),
+		publicLimit:     publicLimit,
+		accountLimit:    accountLimit,
+		apiLimit:        apiLimit,
+
+		provider:               provider,
+		healthService:          domain.NewHealthService(provider, repository.NewHealth()),
+		attachmentService:      attachmentService,
+		dataExportService:      dataExportService,
+		accountDeletionService: accountDeletionService,
+		rateLimitStore:         rateLimitStore,
+		keyRing:                keyRing,
+	}, nil
+}
+
+func createBlobStore(cfg config.Storage) (domain.BlobStore, error) {
+	switch kind := cfg.BlobStore; kind {
+	case "local":
+		return blob.NewLocal(cfg.LocalDir)
+	case "s3":
+		return blob.NewS3(cfg.S3Endpoint, cfg.S3Bucket, cfg.S3Region, cfg.S3AccessKey, cfg.S3SecretKey)
+	default:
+		return nil, errors.New("unknown blob store " + kind)
+	}
+}
+
+func createMailer(cfg config.Mail) (domain.Mailer, error) {
+	switch kind := cfg.Mailer; kind {
+	case "log":
+		return mailer.NewLog(), nil
+	case "file":
+		return mailer.NewFile(cfg.File, cfg.From)
+	case "smtp":
+		return mailer.NewSMTP(cfg.SMTPAddress, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From)
+	default:
+		return nil, errors.New("unknown mailer " + kind)
+	}
+}
+
+func createRateLimitStore(provider domain.ConnectionProvider, kind string) (domain.RateLimitStore, error) {
+	switch kind {
+	case "memory":
+		return ratelimit.NewMemory(), nil
+	case "postgres":
+		return ratelimit.NewPostgres(provider), nil
+	default:
+		return nil, errors.New("unknown rate limit store " + kind)
+	}
+}
+
+// createKeyRing returns nil unless AUTH_MODE is jwt. The keys are loaded,
+// and the first one created, before the server starts.
+func createKeyRing(provider domain.ConnectionProvider, cfg config.Auth) (*jwt.KeyRing, error) {
+	switch mode := cfg.Mode; mode {
+	case "token":
+		return nil, nil
+	case "jwt":
+	default:
+		return nil, errors.New("unknown auth mode " + mode)
+	}
 
-	return controller.NewUsers(userService), controller.NewLists(listService), controller.NewTasks(taskService), authMiddlware, nil
+	keyRing, err := jwt.NewKeyRing(provider, cfg.JWTAlgorithm, cfg.JWTKeySecret, cfg.JWTTTL, cfg.JWTRotation)
+	if err != nil {
+		return nil, err
+	}
+	if err = keyRing.Sync(context.Background()); err != nil {
+		return nil, err
+	}
+	if err = keyRing.Rotate(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return keyRing, nil
+}
+
+// sweepAttachments removes the blobs of deleted tasks until ctx is done.
+func sweepAttachments(ctx context.Context, service domain.AttachmentInterface) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			swept, err := service.Sweep(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "Sweep attachments failed.", logger.ErrAttr(err))
+			}
+			if swept > 0 {
+				slog.InfoContext(ctx, "Swept attachments.", slog.Int("count", swept))
+			}
+		}
+	}
+}
+
+// processDataExports builds the queued data exports and removes the expired
+// ones until ctx is done.
+func processDataExports(ctx context.Context, service domain.DataExportInterface) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			processed, err := service.Process(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "Process data exports failed.", logger.ErrAttr(err))
+			}
+			if processed > 0 {
+				slog.InfoContext(ctx, "Processed data exports.", slog.Int("count", processed))
+			}
+
+			if _, err = service.Sweep(ctx); err != nil {
+				slog.ErrorContext(ctx, "Sweep data exports failed.", logger.ErrAttr(err))
+			}
+		}
+	}
+}
+
+// purgeAccounts deletes the accounts whose grace period is over until ctx is
+// done.
+func purgeAccounts(ctx context.Context, service domain.AccountDeletionInterface) {
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := service.Purge(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "Purge accounts failed.", logger.ErrAttr(err))
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "Purged accounts.", slog.Int("count", purged))
+			}
+		}
+	}
+}
+
+// sweepRateLimits forgets the clients idle for longer than a lockout lasts
+// until ctx is done.
+func sweepRateLimits(ctx context.Context, store domain.RateLimitStore) {
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := store.Sweep(ctx, time.Now().Add(-loginLockout.Reset)); err != nil {
+				slog.ErrorContext(ctx, "Sweep rate limits failed.", logger.ErrAttr(err))
+			}
+		}
+	}
+}
+
+// rotateSigningKeys keeps the keys and the denylist in step with the other
+// instances and rotates the signing key when it is due, until ctx is done.
+func rotateSigningKeys(ctx context.Context, keyRing *jwt.KeyRing) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := keyRing.Sync(ctx); err != nil {
+				slog.ErrorContext(ctx, "Sync signing keys failed.", logger.ErrAttr(err))
+
+				continue
+			}
+			if err := keyRing.Rotate(ctx); err != nil {
+				slog.ErrorContext(ctx, "Rotate signing keys failed.", logger.ErrAttr(err))
+			}
+		}
+	}
 }
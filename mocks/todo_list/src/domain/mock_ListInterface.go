@@ -22,6 +22,56 @@ func (_m *MockListInterface) EXPECT() *MockListInterface_Expecter {
 	return &MockListInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddMember provides a mock function with given fields: ctx, userID, workspaceID, listID, email
+func (_m *MockListInterface) AddMember(ctx context.Context, userID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, email string) error {
+	ret := _m.Called(ctx, userID, workspaceID, listID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID, string) error); ok {
+		r0 = rf(ctx, userID, workspaceID, listID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListInterface_AddMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMember'
+type MockListInterface_AddMember_Call struct {
+	*mock.Call
+}
+
+// AddMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID domain.UserID
+//   - workspaceID domain.WorkspaceID
+//   - listID domain.ListID
+//   - email string
+func (_e *MockListInterface_Expecter) AddMember(ctx interface{}, userID interface{}, workspaceID interface{}, listID interface{}, email interface{}) *MockListInterface_AddMember_Call {
+	return &MockListInterface_AddMember_Call{Call: _e.mock.On("AddMember", ctx, userID, workspaceID, listID, email)}
+}
+
+func (_c *MockListInterface_AddMember_Call) Run(run func(ctx context.Context, userID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, email string)) *MockListInterface_AddMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.ListID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockListInterface_AddMember_Call) Return(_a0 error) *MockListInterface_AddMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListInterface_AddMember_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID, string) error) *MockListInterface_AddMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockListInterface) Close() error {
 	ret := _m.Called()
@@ -114,17 +164,17 @@ func (_c *MockListInterface_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// Delete provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockListInterface) Delete(_a0 context.Context, _a1 domain.UserID, _a2 domain.ListID) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// Delete provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockListInterface) Delete(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.ListID) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.ListID) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -140,14 +190,15 @@ type MockListInterface_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 domain.UserID
-//   - _a2 domain.ListID
-func (_e *MockListInterface_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockListInterface_Delete_Call {
-	return &MockListInterface_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2)}
+//   - _a2 domain.WorkspaceID
+//   - _a3 domain.ListID
+func (_e *MockListInterface_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockListInterface_Delete_Call {
+	return &MockListInterface_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *MockListInterface_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.ListID)) *MockListInterface_Delete_Call {
+func (_c *MockListInterface_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.ListID)) *MockListInterface_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.ListID))
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.ListID))
 	})
 	return _c
 }
@@ -157,14 +208,14 @@ func (_c *MockListInterface_Delete_Call) Return(_a0 error) *MockListInterface_De
 	return _c
 }
 
-func (_c *MockListInterface_Delete_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.ListID) error) *MockListInterface_Delete_Call {
+func (_c *MockListInterface_Delete_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID) error) *MockListInterface_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function with given fields: _a0, _a1
-func (_m *MockListInterface) GetAll(_a0 context.Context, _a1 domain.UserID) ([]domain.List, error) {
-	ret := _m.Called(_a0, _a1)
+// GetAll provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockListInterface) GetAll(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID) ([]domain.List, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
@@ -172,19 +223,19 @@ func (_m *MockListInterface) GetAll(_a0 context.Context, _a1 domain.UserID) ([]d
 
 	var r0 []domain.List
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID) ([]domain.List, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID) ([]domain.List, error)); ok {
+		return rf(_a0, _a1, _a2)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID) []domain.List); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID) []domain.List); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.List)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserID, domain.WorkspaceID) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -200,13 +251,14 @@ type MockListInterface_GetAll_Call struct {
 // GetAll is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 domain.UserID
-func (_e *MockListInterface_Expecter) GetAll(_a0 interface{}, _a1 interface{}) *MockListInterface_GetAll_Call {
-	return &MockListInterface_GetAll_Call{Call: _e.mock.On("GetAll", _a0, _a1)}
+//   - _a2 domain.WorkspaceID
+func (_e *MockListInterface_Expecter) GetAll(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockListInterface_GetAll_Call {
+	return &MockListInterface_GetAll_Call{Call: _e.mock.On("GetAll", _a0, _a1, _a2)}
 }
 
-func (_c *MockListInterface_GetAll_Call) Run(run func(_a0 context.Context, _a1 domain.UserID)) *MockListInterface_GetAll_Call {
+func (_c *MockListInterface_GetAll_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID)) *MockListInterface_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.UserID))
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID))
 	})
 	return _c
 }
@@ -216,7 +268,118 @@ func (_c *MockListInterface_GetAll_Call) Return(_a0 []domain.List, _a1 error) *M
 	return _c
 }
 
-func (_c *MockListInterface_GetAll_Call) RunAndReturn(run func(context.Context, domain.UserID) ([]domain.List, error)) *MockListInterface_GetAll_Call {
+func (_c *MockListInterface_GetAll_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID) ([]domain.List, error)) *MockListInterface_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMembers provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockListInterface) GetMembers(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.ListID) ([]domain.Member, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembers")
+	}
+
+	var r0 []domain.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID) ([]domain.Member, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID) []domain.Member); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListInterface_GetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembers'
+type MockListInterface_GetMembers_Call struct {
+	*mock.Call
+}
+
+// GetMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.UserID
+//   - _a2 domain.WorkspaceID
+//   - _a3 domain.ListID
+func (_e *MockListInterface_Expecter) GetMembers(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockListInterface_GetMembers_Call {
+	return &MockListInterface_GetMembers_Call{Call: _e.mock.On("GetMembers", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockListInterface_GetMembers_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.ListID)) *MockListInterface_GetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.ListID))
+	})
+	return _c
+}
+
+func (_c *MockListInterface_GetMembers_Call) Return(_a0 []domain.Member, _a1 error) *MockListInterface_GetMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListInterface_GetMembers_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID) ([]domain.Member, error)) *MockListInterface_GetMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, userID, workspaceID, listID, memberID
+func (_m *MockListInterface) RemoveMember(ctx context.Context, userID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, memberID domain.UserID) error {
+	ret := _m.Called(ctx, userID, workspaceID, listID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID, domain.UserID) error); ok {
+		r0 = rf(ctx, userID, workspaceID, listID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListInterface_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockListInterface_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID domain.UserID
+//   - workspaceID domain.WorkspaceID
+//   - listID domain.ListID
+//   - memberID domain.UserID
+func (_e *MockListInterface_Expecter) RemoveMember(ctx interface{}, userID interface{}, workspaceID interface{}, listID interface{}, memberID interface{}) *MockListInterface_RemoveMember_Call {
+	return &MockListInterface_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, userID, workspaceID, listID, memberID)}
+}
+
+func (_c *MockListInterface_RemoveMember_Call) Run(run func(ctx context.Context, userID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, memberID domain.UserID)) *MockListInterface_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.ListID), args[4].(domain.UserID))
+	})
+	return _c
+}
+
+func (_c *MockListInterface_RemoveMember_Call) Return(_a0 error) *MockListInterface_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListInterface_RemoveMember_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.ListID, domain.UserID) error) *MockListInterface_RemoveMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
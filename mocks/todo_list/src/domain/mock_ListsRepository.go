@@ -22,6 +22,57 @@ func (_m *MockListsRepository) EXPECT() *MockListsRepository_Expecter {
 	return &MockListsRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddMember provides a mock function with given fields: ctx, connection, ownerID, workspaceID, listID, email
+func (_m *MockListsRepository) AddMember(ctx context.Context, connection domain.Connection, ownerID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, email string) error {
+	ret := _m.Called(ctx, connection, ownerID, workspaceID, listID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID, string) error); ok {
+		r0 = rf(ctx, connection, ownerID, workspaceID, listID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListsRepository_AddMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMember'
+type MockListsRepository_AddMember_Call struct {
+	*mock.Call
+}
+
+// AddMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - ownerID domain.UserID
+//   - workspaceID domain.WorkspaceID
+//   - listID domain.ListID
+//   - email string
+func (_e *MockListsRepository_Expecter) AddMember(ctx interface{}, connection interface{}, ownerID interface{}, workspaceID interface{}, listID interface{}, email interface{}) *MockListsRepository_AddMember_Call {
+	return &MockListsRepository_AddMember_Call{Call: _e.mock.On("AddMember", ctx, connection, ownerID, workspaceID, listID, email)}
+}
+
+func (_c *MockListsRepository_AddMember_Call) Run(run func(ctx context.Context, connection domain.Connection, ownerID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, email string)) *MockListsRepository_AddMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.ListID), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockListsRepository_AddMember_Call) Return(_a0 error) *MockListsRepository_AddMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListsRepository_AddMember_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID, string) error) *MockListsRepository_AddMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockListsRepository) Create(_a0 context.Context, _a1 domain.Connection, _a2 domain.List) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -70,17 +121,17 @@ func (_c *MockListsRepository_Create_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
-// Delete provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockListsRepository) Delete(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.ListID) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// Delete provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockListsRepository) Delete(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.ListID) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ListID) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -97,14 +148,15 @@ type MockListsRepository_Delete_Call struct {
 //   - _a0 context.Context
 //   - _a1 domain.Connection
 //   - _a2 domain.UserID
-//   - _a3 domain.ListID
-func (_e *MockListsRepository_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockListsRepository_Delete_Call {
-	return &MockListsRepository_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2, _a3)}
+//   - _a3 domain.WorkspaceID
+//   - _a4 domain.ListID
+func (_e *MockListsRepository_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockListsRepository_Delete_Call {
+	return &MockListsRepository_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2, _a3, _a4)}
 }
 
-func (_c *MockListsRepository_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.ListID)) *MockListsRepository_Delete_Call {
+func (_c *MockListsRepository_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.ListID)) *MockListsRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.ListID))
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.ListID))
 	})
 	return _c
 }
@@ -114,14 +166,14 @@ func (_c *MockListsRepository_Delete_Call) Return(_a0 error) *MockListsRepositor
 	return _c
 }
 
-func (_c *MockListsRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.ListID) error) *MockListsRepository_Delete_Call {
+func (_c *MockListsRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID) error) *MockListsRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadAll provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockListsRepository) ReadAll(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID) ([]domain.List, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// ReadAll provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockListsRepository) ReadAll(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID) ([]domain.List, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadAll")
@@ -129,19 +181,19 @@ func (_m *MockListsRepository) ReadAll(_a0 context.Context, _a1 domain.Connectio
 
 	var r0 []domain.List
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) ([]domain.List, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID) ([]domain.List, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) []domain.List); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID) []domain.List); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.List)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -158,13 +210,14 @@ type MockListsRepository_ReadAll_Call struct {
 //   - _a0 context.Context
 //   - _a1 domain.Connection
 //   - _a2 domain.UserID
-func (_e *MockListsRepository_Expecter) ReadAll(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockListsRepository_ReadAll_Call {
-	return &MockListsRepository_ReadAll_Call{Call: _e.mock.On("ReadAll", _a0, _a1, _a2)}
+//   - _a3 domain.WorkspaceID
+func (_e *MockListsRepository_Expecter) ReadAll(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockListsRepository_ReadAll_Call {
+	return &MockListsRepository_ReadAll_Call{Call: _e.mock.On("ReadAll", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *MockListsRepository_ReadAll_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID)) *MockListsRepository_ReadAll_Call {
+func (_c *MockListsRepository_ReadAll_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID)) *MockListsRepository_ReadAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID))
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID))
 	})
 	return _c
 }
@@ -174,7 +227,120 @@ func (_c *MockListsRepository_ReadAll_Call) Return(_a0 []domain.List, _a1 error)
 	return _c
 }
 
-func (_c *MockListsRepository_ReadAll_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID) ([]domain.List, error)) *MockListsRepository_ReadAll_Call {
+func (_c *MockListsRepository_ReadAll_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID) ([]domain.List, error)) *MockListsRepository_ReadAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMembers provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockListsRepository) ReadMembers(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.ListID) ([]domain.Member, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMembers")
+	}
+
+	var r0 []domain.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID) ([]domain.Member, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID) []domain.Member); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListsRepository_ReadMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMembers'
+type MockListsRepository_ReadMembers_Call struct {
+	*mock.Call
+}
+
+// ReadMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Connection
+//   - _a2 domain.UserID
+//   - _a3 domain.WorkspaceID
+//   - _a4 domain.ListID
+func (_e *MockListsRepository_Expecter) ReadMembers(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockListsRepository_ReadMembers_Call {
+	return &MockListsRepository_ReadMembers_Call{Call: _e.mock.On("ReadMembers", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockListsRepository_ReadMembers_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.ListID)) *MockListsRepository_ReadMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.ListID))
+	})
+	return _c
+}
+
+func (_c *MockListsRepository_ReadMembers_Call) Return(_a0 []domain.Member, _a1 error) *MockListsRepository_ReadMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListsRepository_ReadMembers_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID) ([]domain.Member, error)) *MockListsRepository_ReadMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, connection, userID, workspaceID, listID, memberID
+func (_m *MockListsRepository) RemoveMember(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, memberID domain.UserID) error {
+	ret := _m.Called(ctx, connection, userID, workspaceID, listID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID, domain.UserID) error); ok {
+		r0 = rf(ctx, connection, userID, workspaceID, listID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListsRepository_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockListsRepository_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - userID domain.UserID
+//   - workspaceID domain.WorkspaceID
+//   - listID domain.ListID
+//   - memberID domain.UserID
+func (_e *MockListsRepository_Expecter) RemoveMember(ctx interface{}, connection interface{}, userID interface{}, workspaceID interface{}, listID interface{}, memberID interface{}) *MockListsRepository_RemoveMember_Call {
+	return &MockListsRepository_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, connection, userID, workspaceID, listID, memberID)}
+}
+
+func (_c *MockListsRepository_RemoveMember_Call) Run(run func(ctx context.Context, connection domain.Connection, userID domain.UserID, workspaceID domain.WorkspaceID, listID domain.ListID, memberID domain.UserID)) *MockListsRepository_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.ListID), args[5].(domain.UserID))
+	})
+	return _c
+}
+
+func (_c *MockListsRepository_RemoveMember_Call) Return(_a0 error) *MockListsRepository_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListsRepository_RemoveMember_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.ListID, domain.UserID) error) *MockListsRepository_RemoveMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -67,17 +67,17 @@ func (_c *MockTaskInterface_Close_Call) RunAndReturn(run func() error) *MockTask
 	return _c
 }
 
-// Create provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockTaskInterface) Create(_a0 context.Context, _a1 domain.UserID, _a2 domain.Task) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// Create provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockTaskInterface) Create(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.Task) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.Task) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.Task) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -93,14 +93,15 @@ type MockTaskInterface_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 domain.UserID
-//   - _a2 domain.Task
-func (_e *MockTaskInterface_Expecter) Create(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockTaskInterface_Create_Call {
-	return &MockTaskInterface_Create_Call{Call: _e.mock.On("Create", _a0, _a1, _a2)}
+//   - _a2 domain.WorkspaceID
+//   - _a3 domain.Task
+func (_e *MockTaskInterface_Expecter) Create(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTaskInterface_Create_Call {
+	return &MockTaskInterface_Create_Call{Call: _e.mock.On("Create", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *MockTaskInterface_Create_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.Task)) *MockTaskInterface_Create_Call {
+func (_c *MockTaskInterface_Create_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.Task)) *MockTaskInterface_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.Task))
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.Task))
 	})
 	return _c
 }
@@ -110,22 +111,22 @@ func (_c *MockTaskInterface_Create_Call) Return(_a0 error) *MockTaskInterface_Cr
 	return _c
 }
 
-func (_c *MockTaskInterface_Create_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.Task) error) *MockTaskInterface_Create_Call {
+func (_c *MockTaskInterface_Create_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.Task) error) *MockTaskInterface_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockTaskInterface) Delete(_a0 context.Context, _a1 domain.UserID, _a2 domain.TaskID) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// Delete provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockTaskInterface) Delete(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.TaskID) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.TaskID) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -141,14 +142,15 @@ type MockTaskInterface_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 domain.UserID
-//   - _a2 domain.TaskID
-func (_e *MockTaskInterface_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockTaskInterface_Delete_Call {
-	return &MockTaskInterface_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2)}
+//   - _a2 domain.WorkspaceID
+//   - _a3 domain.TaskID
+func (_e *MockTaskInterface_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTaskInterface_Delete_Call {
+	return &MockTaskInterface_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *MockTaskInterface_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.TaskID)) *MockTaskInterface_Delete_Call {
+func (_c *MockTaskInterface_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.TaskID)) *MockTaskInterface_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.TaskID))
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.TaskID))
 	})
 	return _c
 }
@@ -158,22 +160,142 @@ func (_c *MockTaskInterface_Delete_Call) Return(_a0 error) *MockTaskInterface_De
 	return _c
 }
 
-func (_c *MockTaskInterface_Delete_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.TaskID) error) *MockTaskInterface_Delete_Call {
+func (_c *MockTaskInterface_Delete_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) error) *MockTaskInterface_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockTaskInterface) Update(_a0 context.Context, _a1 domain.UserID, _a2 domain.Task) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// Find provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockTaskInterface) Find(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.TaskFilter) ([]domain.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskFilter) ([]domain.Task, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskFilter) []domain.Task); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskFilter) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskInterface_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockTaskInterface_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.UserID
+//   - _a2 domain.WorkspaceID
+//   - _a3 domain.TaskFilter
+func (_e *MockTaskInterface_Expecter) Find(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTaskInterface_Find_Call {
+	return &MockTaskInterface_Find_Call{Call: _e.mock.On("Find", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockTaskInterface_Find_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.TaskFilter)) *MockTaskInterface_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.TaskFilter))
+	})
+	return _c
+}
+
+func (_c *MockTaskInterface_Find_Call) Return(_a0 []domain.Task, _a1 error) *MockTaskInterface_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskInterface_Find_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskFilter) ([]domain.Task, error)) *MockTaskInterface_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockTaskInterface) Read(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.TaskID) (domain.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) (domain.Task, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) domain.Task); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(domain.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskInterface_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockTaskInterface_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.UserID
+//   - _a2 domain.WorkspaceID
+//   - _a3 domain.TaskID
+func (_e *MockTaskInterface_Expecter) Read(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTaskInterface_Read_Call {
+	return &MockTaskInterface_Read_Call{Call: _e.mock.On("Read", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockTaskInterface_Read_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.TaskID)) *MockTaskInterface_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.TaskID))
+	})
+	return _c
+}
+
+func (_c *MockTaskInterface_Read_Call) Return(_a0 domain.Task, _a1 error) *MockTaskInterface_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskInterface_Read_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.TaskID) (domain.Task, error)) *MockTaskInterface_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockTaskInterface) Update(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.Task) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.Task) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.WorkspaceID, domain.Task) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -189,14 +311,15 @@ type MockTaskInterface_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 domain.UserID
-//   - _a2 domain.Task
-func (_e *MockTaskInterface_Expecter) Update(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockTaskInterface_Update_Call {
-	return &MockTaskInterface_Update_Call{Call: _e.mock.On("Update", _a0, _a1, _a2)}
+//   - _a2 domain.WorkspaceID
+//   - _a3 domain.Task
+func (_e *MockTaskInterface_Expecter) Update(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTaskInterface_Update_Call {
+	return &MockTaskInterface_Update_Call{Call: _e.mock.On("Update", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *MockTaskInterface_Update_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.Task)) *MockTaskInterface_Update_Call {
+func (_c *MockTaskInterface_Update_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.WorkspaceID, _a3 domain.Task)) *MockTaskInterface_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.Task))
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.WorkspaceID), args[3].(domain.Task))
 	})
 	return _c
 }
@@ -206,7 +329,7 @@ func (_c *MockTaskInterface_Update_Call) Return(_a0 error) *MockTaskInterface_Up
 	return _c
 }
 
-func (_c *MockTaskInterface_Update_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.Task) error) *MockTaskInterface_Update_Call {
+func (_c *MockTaskInterface_Update_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.WorkspaceID, domain.Task) error) *MockTaskInterface_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -22,17 +22,17 @@ func (_m *MockTasksRepository) EXPECT() *MockTasksRepository_Expecter {
 	return &MockTasksRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockTasksRepository) Create(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Task) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// Create provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockTasksRepository) Create(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.Task) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.Task) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.Task) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,14 +49,15 @@ type MockTasksRepository_Create_Call struct {
 //   - _a0 context.Context
 //   - _a1 domain.Connection
 //   - _a2 domain.UserID
-//   - _a3 domain.Task
-func (_e *MockTasksRepository_Expecter) Create(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTasksRepository_Create_Call {
-	return &MockTasksRepository_Create_Call{Call: _e.mock.On("Create", _a0, _a1, _a2, _a3)}
+//   - _a3 domain.WorkspaceID
+//   - _a4 domain.Task
+func (_e *MockTasksRepository_Expecter) Create(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockTasksRepository_Create_Call {
+	return &MockTasksRepository_Create_Call{Call: _e.mock.On("Create", _a0, _a1, _a2, _a3, _a4)}
 }
 
-func (_c *MockTasksRepository_Create_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Task)) *MockTasksRepository_Create_Call {
+func (_c *MockTasksRepository_Create_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.Task)) *MockTasksRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.Task))
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.Task))
 	})
 	return _c
 }
@@ -66,22 +67,22 @@ func (_c *MockTasksRepository_Create_Call) Return(_a0 error) *MockTasksRepositor
 	return _c
 }
 
-func (_c *MockTasksRepository_Create_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.Task) error) *MockTasksRepository_Create_Call {
+func (_c *MockTasksRepository_Create_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.Task) error) *MockTasksRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockTasksRepository) Delete(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.TaskID) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// Delete provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockTasksRepository) Delete(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.TaskID) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.TaskID) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskID) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -98,14 +99,15 @@ type MockTasksRepository_Delete_Call struct {
 //   - _a0 context.Context
 //   - _a1 domain.Connection
 //   - _a2 domain.UserID
-//   - _a3 domain.TaskID
-func (_e *MockTasksRepository_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTasksRepository_Delete_Call {
-	return &MockTasksRepository_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2, _a3)}
+//   - _a3 domain.WorkspaceID
+//   - _a4 domain.TaskID
+func (_e *MockTasksRepository_Expecter) Delete(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockTasksRepository_Delete_Call {
+	return &MockTasksRepository_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1, _a2, _a3, _a4)}
 }
 
-func (_c *MockTasksRepository_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.TaskID)) *MockTasksRepository_Delete_Call {
+func (_c *MockTasksRepository_Delete_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.TaskID)) *MockTasksRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.TaskID))
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.TaskID))
 	})
 	return _c
 }
@@ -115,14 +117,76 @@ func (_c *MockTasksRepository_Delete_Call) Return(_a0 error) *MockTasksRepositor
 	return _c
 }
 
-func (_c *MockTasksRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.TaskID) error) *MockTasksRepository_Delete_Call {
+func (_c *MockTasksRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskID) error) *MockTasksRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllTasks provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockTasksRepository) GetAllTasks(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 []domain.ListID) ([]domain.Task, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// Find provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockTasksRepository) Find(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.TaskFilter) ([]domain.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskFilter) ([]domain.Task, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskFilter) []domain.Task); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskFilter) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTasksRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockTasksRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Connection
+//   - _a2 domain.UserID
+//   - _a3 domain.WorkspaceID
+//   - _a4 domain.TaskFilter
+func (_e *MockTasksRepository_Expecter) Find(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockTasksRepository_Find_Call {
+	return &MockTasksRepository_Find_Call{Call: _e.mock.On("Find", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockTasksRepository_Find_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.TaskFilter)) *MockTasksRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.TaskFilter))
+	})
+	return _c
+}
+
+func (_c *MockTasksRepository_Find_Call) Return(_a0 []domain.Task, _a1 error) *MockTasksRepository_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTasksRepository_Find_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskFilter) ([]domain.Task, error)) *MockTasksRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllTasks provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockTasksRepository) GetAllTasks(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 []domain.ListID) ([]domain.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllTasks")
@@ -130,19 +194,19 @@ func (_m *MockTasksRepository) GetAllTasks(_a0 context.Context, _a1 domain.Conne
 
 	var r0 []domain.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, []domain.ListID) ([]domain.Task, error)); ok {
-		return rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, []domain.ListID) ([]domain.Task, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, []domain.ListID) []domain.Task); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, []domain.ListID) []domain.Task); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, []domain.ListID) error); ok {
-		r1 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, []domain.ListID) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -159,14 +223,15 @@ type MockTasksRepository_GetAllTasks_Call struct {
 //   - _a0 context.Context
 //   - _a1 domain.Connection
 //   - _a2 domain.UserID
-//   - _a3 []domain.ListID
-func (_e *MockTasksRepository_Expecter) GetAllTasks(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTasksRepository_GetAllTasks_Call {
-	return &MockTasksRepository_GetAllTasks_Call{Call: _e.mock.On("GetAllTasks", _a0, _a1, _a2, _a3)}
+//   - _a3 domain.WorkspaceID
+//   - _a4 []domain.ListID
+func (_e *MockTasksRepository_Expecter) GetAllTasks(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockTasksRepository_GetAllTasks_Call {
+	return &MockTasksRepository_GetAllTasks_Call{Call: _e.mock.On("GetAllTasks", _a0, _a1, _a2, _a3, _a4)}
 }
 
-func (_c *MockTasksRepository_GetAllTasks_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 []domain.ListID)) *MockTasksRepository_GetAllTasks_Call {
+func (_c *MockTasksRepository_GetAllTasks_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 []domain.ListID)) *MockTasksRepository_GetAllTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].([]domain.ListID))
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].([]domain.ListID))
 	})
 	return _c
 }
@@ -176,14 +241,14 @@ func (_c *MockTasksRepository_GetAllTasks_Call) Return(_a0 []domain.Task, _a1 er
 	return _c
 }
 
-func (_c *MockTasksRepository_GetAllTasks_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, []domain.ListID) ([]domain.Task, error)) *MockTasksRepository_GetAllTasks_Call {
+func (_c *MockTasksRepository_GetAllTasks_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, []domain.ListID) ([]domain.Task, error)) *MockTasksRepository_GetAllTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Read provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockTasksRepository) Read(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.TaskID) (domain.Task, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// Read provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockTasksRepository) Read(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.TaskID) (domain.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Read")
@@ -191,17 +256,17 @@ func (_m *MockTasksRepository) Read(_a0 context.Context, _a1 domain.Connection,
 
 	var r0 domain.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.TaskID) (domain.Task, error)); ok {
-		return rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskID) (domain.Task, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.TaskID) domain.Task); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskID) domain.Task); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Get(0).(domain.Task)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.TaskID) error); ok {
-		r1 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskID) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -218,14 +283,15 @@ type MockTasksRepository_Read_Call struct {
 //   - _a0 context.Context
 //   - _a1 domain.Connection
 //   - _a2 domain.UserID
-//   - _a3 domain.TaskID
-func (_e *MockTasksRepository_Expecter) Read(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTasksRepository_Read_Call {
-	return &MockTasksRepository_Read_Call{Call: _e.mock.On("Read", _a0, _a1, _a2, _a3)}
+//   - _a3 domain.WorkspaceID
+//   - _a4 domain.TaskID
+func (_e *MockTasksRepository_Expecter) Read(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockTasksRepository_Read_Call {
+	return &MockTasksRepository_Read_Call{Call: _e.mock.On("Read", _a0, _a1, _a2, _a3, _a4)}
 }
 
-func (_c *MockTasksRepository_Read_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.TaskID)) *MockTasksRepository_Read_Call {
+func (_c *MockTasksRepository_Read_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.TaskID)) *MockTasksRepository_Read_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.TaskID))
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.TaskID))
 	})
 	return _c
 }
@@ -235,22 +301,22 @@ func (_c *MockTasksRepository_Read_Call) Return(_a0 domain.Task, _a1 error) *Moc
 	return _c
 }
 
-func (_c *MockTasksRepository_Read_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.TaskID) (domain.Task, error)) *MockTasksRepository_Read_Call {
+func (_c *MockTasksRepository_Read_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.TaskID) (domain.Task, error)) *MockTasksRepository_Read_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockTasksRepository) Update(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Task) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// Update provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockTasksRepository) Update(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.Task) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.Task) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.Task) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -267,14 +333,15 @@ type MockTasksRepository_Update_Call struct {
 //   - _a0 context.Context
 //   - _a1 domain.Connection
 //   - _a2 domain.UserID
-//   - _a3 domain.Task
-func (_e *MockTasksRepository_Expecter) Update(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockTasksRepository_Update_Call {
-	return &MockTasksRepository_Update_Call{Call: _e.mock.On("Update", _a0, _a1, _a2, _a3)}
+//   - _a3 domain.WorkspaceID
+//   - _a4 domain.Task
+func (_e *MockTasksRepository_Expecter) Update(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockTasksRepository_Update_Call {
+	return &MockTasksRepository_Update_Call{Call: _e.mock.On("Update", _a0, _a1, _a2, _a3, _a4)}
 }
 
-func (_c *MockTasksRepository_Update_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Task)) *MockTasksRepository_Update_Call {
+func (_c *MockTasksRepository_Update_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.WorkspaceID, _a4 domain.Task)) *MockTasksRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.Task))
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.WorkspaceID), args[4].(domain.Task))
 	})
 	return _c
 }
@@ -284,7 +351,7 @@ func (_c *MockTasksRepository_Update_Call) Return(_a0 error) *MockTasksRepositor
 	return _c
 }
 
-func (_c *MockTasksRepository_Update_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.Task) error) *MockTasksRepository_Update_Call {
+func (_c *MockTasksRepository_Update_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.WorkspaceID, domain.Task) error) *MockTasksRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -79,6 +79,121 @@ func (_c *MockUserInterface_Authenticate_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// AuthenticatePassword provides a mock function with given fields: ctx, email, password
+func (_m *MockUserInterface) AuthenticatePassword(ctx context.Context, email string, password string) (domain.User, error) {
+	ret := _m.Called(ctx, email, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticatePassword")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.User, error)); ok {
+		return rf(ctx, email, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.User); ok {
+		r0 = rf(ctx, email, password)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserInterface_AuthenticatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticatePassword'
+type MockUserInterface_AuthenticatePassword_Call struct {
+	*mock.Call
+}
+
+// AuthenticatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - password string
+func (_e *MockUserInterface_Expecter) AuthenticatePassword(ctx interface{}, email interface{}, password interface{}) *MockUserInterface_AuthenticatePassword_Call {
+	return &MockUserInterface_AuthenticatePassword_Call{Call: _e.mock.On("AuthenticatePassword", ctx, email, password)}
+}
+
+func (_c *MockUserInterface_AuthenticatePassword_Call) Run(run func(ctx context.Context, email string, password string)) *MockUserInterface_AuthenticatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserInterface_AuthenticatePassword_Call) Return(_a0 domain.User, _a1 error) *MockUserInterface_AuthenticatePassword_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserInterface_AuthenticatePassword_Call) RunAndReturn(run func(context.Context, string, string) (domain.User, error)) *MockUserInterface_AuthenticatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChallengeEmail provides a mock function with given fields: ctx, challenge
+func (_m *MockUserInterface) ChallengeEmail(ctx context.Context, challenge string) (string, error) {
+	ret := _m.Called(ctx, challenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChallengeEmail")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, challenge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, challenge)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, challenge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserInterface_ChallengeEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChallengeEmail'
+type MockUserInterface_ChallengeEmail_Call struct {
+	*mock.Call
+}
+
+// ChallengeEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challenge string
+func (_e *MockUserInterface_Expecter) ChallengeEmail(ctx interface{}, challenge interface{}) *MockUserInterface_ChallengeEmail_Call {
+	return &MockUserInterface_ChallengeEmail_Call{Call: _e.mock.On("ChallengeEmail", ctx, challenge)}
+}
+
+func (_c *MockUserInterface_ChallengeEmail_Call) Run(run func(ctx context.Context, challenge string)) *MockUserInterface_ChallengeEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserInterface_ChallengeEmail_Call) Return(_a0 string, _a1 error) *MockUserInterface_ChallengeEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserInterface_ChallengeEmail_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockUserInterface_ChallengeEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockUserInterface) Close() error {
 	ret := _m.Called()
@@ -125,21 +240,31 @@ func (_c *MockUserInterface_Close_Call) RunAndReturn(run func() error) *MockUser
 }
 
 // Login provides a mock function with given fields: ctx, email, password
-func (_m *MockUserInterface) Login(ctx context.Context, email string, password string) error {
+func (_m *MockUserInterface) Login(ctx context.Context, email string, password string) (string, error) {
 	ret := _m.Called(ctx, email, password)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, email, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
 		r0 = rf(ctx, email, password)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockUserInterface_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
@@ -162,12 +287,70 @@ func (_c *MockUserInterface_Login_Call) Run(run func(ctx context.Context, email
 	return _c
 }
 
-func (_c *MockUserInterface_Login_Call) Return(_a0 error) *MockUserInterface_Login_Call {
-	_c.Call.Return(_a0)
+func (_c *MockUserInterface_Login_Call) Return(challenge string, err error) *MockUserInterface_Login_Call {
+	_c.Call.Return(challenge, err)
 	return _c
 }
 
-func (_c *MockUserInterface_Login_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserInterface_Login_Call {
+func (_c *MockUserInterface_Login_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *MockUserInterface_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginTwoFactor provides a mock function with given fields: ctx, challenge, code
+func (_m *MockUserInterface) LoginTwoFactor(ctx context.Context, challenge string, code string) (domain.User, error) {
+	ret := _m.Called(ctx, challenge, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginTwoFactor")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.User, error)); ok {
+		return rf(ctx, challenge, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.User); ok {
+		r0 = rf(ctx, challenge, code)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, challenge, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserInterface_LoginTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginTwoFactor'
+type MockUserInterface_LoginTwoFactor_Call struct {
+	*mock.Call
+}
+
+// LoginTwoFactor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challenge string
+//   - code string
+func (_e *MockUserInterface_Expecter) LoginTwoFactor(ctx interface{}, challenge interface{}, code interface{}) *MockUserInterface_LoginTwoFactor_Call {
+	return &MockUserInterface_LoginTwoFactor_Call{Call: _e.mock.On("LoginTwoFactor", ctx, challenge, code)}
+}
+
+func (_c *MockUserInterface_LoginTwoFactor_Call) Run(run func(ctx context.Context, challenge string, code string)) *MockUserInterface_LoginTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserInterface_LoginTwoFactor_Call) Return(_a0 domain.User, _a1 error) *MockUserInterface_LoginTwoFactor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserInterface_LoginTwoFactor_Call) RunAndReturn(run func(context.Context, string, string) (domain.User, error)) *MockUserInterface_LoginTwoFactor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -222,6 +405,54 @@ func (_c *MockUserInterface_RegisterUser_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UpdatePreferences provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUserInterface) UpdatePreferences(_a0 context.Context, _a1 domain.UserID, _a2 domain.Preferences) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.Preferences) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserInterface_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockUserInterface_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.UserID
+//   - _a2 domain.Preferences
+func (_e *MockUserInterface_Expecter) UpdatePreferences(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUserInterface_UpdatePreferences_Call {
+	return &MockUserInterface_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", _a0, _a1, _a2)}
+}
+
+func (_c *MockUserInterface_UpdatePreferences_Call) Run(run func(_a0 context.Context, _a1 domain.UserID, _a2 domain.Preferences)) *MockUserInterface_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.Preferences))
+	})
+	return _c
+}
+
+func (_c *MockUserInterface_UpdatePreferences_Call) Return(_a0 error) *MockUserInterface_UpdatePreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserInterface_UpdatePreferences_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.Preferences) error) *MockUserInterface_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateToken provides a mock function with given fields: ctx, email, token
 func (_m *MockUserInterface) UpdateToken(ctx context.Context, email string, token string) error {
 	ret := _m.Called(ctx, email, token)
@@ -7,6 +7,8 @@ import (
 	domain "todo_list/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockUsersRepository is an autogenerated mock type for the UsersRepository type
@@ -176,6 +178,64 @@ func (_c *MockUsersRepository_ReadByEmail_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ReadByID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUsersRepository) ReadByID(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID) (domain.User, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadByID")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) (domain.User, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) domain.User); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsersRepository_ReadByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadByID'
+type MockUsersRepository_ReadByID_Call struct {
+	*mock.Call
+}
+
+// ReadByID is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Connection
+//   - _a2 domain.UserID
+func (_e *MockUsersRepository_Expecter) ReadByID(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUsersRepository_ReadByID_Call {
+	return &MockUsersRepository_ReadByID_Call{Call: _e.mock.On("ReadByID", _a0, _a1, _a2)}
+}
+
+func (_c *MockUsersRepository_ReadByID_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID)) *MockUsersRepository_ReadByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_ReadByID_Call) Return(_a0 domain.User, _a1 error) *MockUsersRepository_ReadByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsersRepository_ReadByID_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID) (domain.User, error)) *MockUsersRepository_ReadByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadByToken provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockUsersRepository) ReadByToken(_a0 context.Context, _a1 domain.Connection, _a2 string) (domain.User, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -234,6 +294,116 @@ func (_c *MockUsersRepository_ReadByToken_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// Search provides a mock function with given fields: ctx, connection, query, page
+func (_m *MockUsersRepository) Search(ctx context.Context, connection domain.Connection, query string, page domain.Page) ([]domain.User, error) {
+	ret := _m.Called(ctx, connection, query, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, string, domain.Page) ([]domain.User, error)); ok {
+		return rf(ctx, connection, query, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, string, domain.Page) []domain.User); ok {
+		r0 = rf(ctx, connection, query, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Connection, string, domain.Page) error); ok {
+		r1 = rf(ctx, connection, query, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsersRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockUsersRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - query string
+//   - page domain.Page
+func (_e *MockUsersRepository_Expecter) Search(ctx interface{}, connection interface{}, query interface{}, page interface{}) *MockUsersRepository_Search_Call {
+	return &MockUsersRepository_Search_Call{Call: _e.mock.On("Search", ctx, connection, query, page)}
+}
+
+func (_c *MockUsersRepository_Search_Call) Run(run func(ctx context.Context, connection domain.Connection, query string, page domain.Page)) *MockUsersRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(string), args[3].(domain.Page))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_Search_Call) Return(_a0 []domain.User, _a1 error) *MockUsersRepository_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsersRepository_Search_Call) RunAndReturn(run func(context.Context, domain.Connection, string, domain.Page) ([]domain.User, error)) *MockUsersRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDisabled provides a mock function with given fields: ctx, connection, userID, disabledAt
+func (_m *MockUsersRepository) SetDisabled(ctx context.Context, connection domain.Connection, userID domain.UserID, disabledAt *time.Time) error {
+	ret := _m.Called(ctx, connection, userID, disabledAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDisabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, *time.Time) error); ok {
+		r0 = rf(ctx, connection, userID, disabledAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUsersRepository_SetDisabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDisabled'
+type MockUsersRepository_SetDisabled_Call struct {
+	*mock.Call
+}
+
+// SetDisabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - userID domain.UserID
+//   - disabledAt *time.Time
+func (_e *MockUsersRepository_Expecter) SetDisabled(ctx interface{}, connection interface{}, userID interface{}, disabledAt interface{}) *MockUsersRepository_SetDisabled_Call {
+	return &MockUsersRepository_SetDisabled_Call{Call: _e.mock.On("SetDisabled", ctx, connection, userID, disabledAt)}
+}
+
+func (_c *MockUsersRepository_SetDisabled_Call) Run(run func(ctx context.Context, connection domain.Connection, userID domain.UserID, disabledAt *time.Time)) *MockUsersRepository_SetDisabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_SetDisabled_Call) Return(_a0 error) *MockUsersRepository_SetDisabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsersRepository_SetDisabled_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, *time.Time) error) *MockUsersRepository_SetDisabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockUsersRepository) Update(_a0 context.Context, _a1 domain.Connection, _a2 domain.User) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -282,6 +452,203 @@ func (_c *MockUsersRepository_Update_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// UpdateName provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUsersRepository) UpdateName(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUsersRepository_UpdateName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateName'
+type MockUsersRepository_UpdateName_Call struct {
+	*mock.Call
+}
+
+// UpdateName is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Connection
+//   - _a2 domain.UserID
+//   - _a3 string
+func (_e *MockUsersRepository_Expecter) UpdateName(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUsersRepository_UpdateName_Call {
+	return &MockUsersRepository_UpdateName_Call{Call: _e.mock.On("UpdateName", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUsersRepository_UpdateName_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 string)) *MockUsersRepository_UpdateName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdateName_Call) Return(_a0 error) *MockUsersRepository_UpdateName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdateName_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, string) error) *MockUsersRepository_UpdateName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, connection, userID, passwordHash, token
+func (_m *MockUsersRepository) UpdatePassword(ctx context.Context, connection domain.Connection, userID domain.UserID, passwordHash string, token string) error {
+	ret := _m.Called(ctx, connection, userID, passwordHash, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, string, string) error); ok {
+		r0 = rf(ctx, connection, userID, passwordHash, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUsersRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUsersRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - userID domain.UserID
+//   - passwordHash string
+//   - token string
+func (_e *MockUsersRepository_Expecter) UpdatePassword(ctx interface{}, connection interface{}, userID interface{}, passwordHash interface{}, token interface{}) *MockUsersRepository_UpdatePassword_Call {
+	return &MockUsersRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, connection, userID, passwordHash, token)}
+}
+
+func (_c *MockUsersRepository_UpdatePassword_Call) Run(run func(ctx context.Context, connection domain.Connection, userID domain.UserID, passwordHash string, token string)) *MockUsersRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdatePassword_Call) Return(_a0 error) *MockUsersRepository_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdatePassword_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, string, string) error) *MockUsersRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUsersRepository) UpdatePreferences(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Preferences) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.Preferences) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUsersRepository_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockUsersRepository_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Connection
+//   - _a2 domain.UserID
+//   - _a3 domain.Preferences
+func (_e *MockUsersRepository_Expecter) UpdatePreferences(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUsersRepository_UpdatePreferences_Call {
+	return &MockUsersRepository_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUsersRepository_UpdatePreferences_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Preferences)) *MockUsersRepository_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.Preferences))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdatePreferences_Call) Return(_a0 error) *MockUsersRepository_UpdatePreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdatePreferences_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.Preferences) error) *MockUsersRepository_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUsersRepository) UpdateRole(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Role) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.Role) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUsersRepository_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockUsersRepository_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Connection
+//   - _a2 domain.UserID
+//   - _a3 domain.Role
+func (_e *MockUsersRepository_Expecter) UpdateRole(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUsersRepository_UpdateRole_Call {
+	return &MockUsersRepository_UpdateRole_Call{Call: _e.mock.On("UpdateRole", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUsersRepository_UpdateRole_Call) Run(run func(_a0 context.Context, _a1 domain.Connection, _a2 domain.UserID, _a3 domain.Role)) *MockUsersRepository_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdateRole_Call) Return(_a0 error) *MockUsersRepository_UpdateRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsersRepository_UpdateRole_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, domain.Role) error) *MockUsersRepository_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTokenByEmail provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *MockUsersRepository) UpdateTokenByEmail(_a0 context.Context, _a1 domain.Connection, _a2 string, _a3 string) error {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
@@ -331,6 +698,55 @@ func (_c *MockUsersRepository_UpdateTokenByEmail_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// Verify provides a mock function with given fields: ctx, connection, userID, email
+func (_m *MockUsersRepository) Verify(ctx context.Context, connection domain.Connection, userID domain.UserID, email string) error {
+	ret := _m.Called(ctx, connection, userID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, string) error); ok {
+		r0 = rf(ctx, connection, userID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUsersRepository_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockUsersRepository_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - userID domain.UserID
+//   - email string
+func (_e *MockUsersRepository_Expecter) Verify(ctx interface{}, connection interface{}, userID interface{}, email interface{}) *MockUsersRepository_Verify_Call {
+	return &MockUsersRepository_Verify_Call{Call: _e.mock.On("Verify", ctx, connection, userID, email)}
+}
+
+func (_c *MockUsersRepository_Verify_Call) Run(run func(ctx context.Context, connection domain.Connection, userID domain.UserID, email string)) *MockUsersRepository_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Connection), args[2].(domain.UserID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_Verify_Call) Return(_a0 error) *MockUsersRepository_Verify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsersRepository_Verify_Call) RunAndReturn(run func(context.Context, domain.Connection, domain.UserID, string) error) *MockUsersRepository_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUsersRepository creates a new instance of MockUsersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUsersRepository(t interface {